@@ -106,6 +106,14 @@ const (
 	BPFConntrackModeBPFProgram BPFConntrackMode = "BPFProgram"
 )
 
+// +kubebuilder:validation:Enum=Disabled;DoubleIfFull
+type BPFConntrackMapScaling string
+
+const (
+	BPFConntrackMapScalingDisabled     BPFConntrackMapScaling = "Disabled"
+	BPFConntrackMapScalingDoubleIfFull BPFConntrackMapScaling = "DoubleIfFull"
+)
+
 // +kubebuilder:validation:Enum=Enabled;Disabled
 type WindowsManageFirewallRulesMode string
 
@@ -293,6 +301,49 @@ type FelixConfigurationSpec struct {
 	// +kubebuilder:validation:Pattern=`^(?i)(Drop|Reject)?$`
 	IptablesFilterDenyAction string `json:"iptablesFilterDenyAction,omitempty" validate:"omitempty,dropReject"`
 
+	// PolicyRejectRateLimit is the number of TCP resets or ICMP errors per second that Felix's dataplane sends
+	// for packets that match a policy rule with the Reject action.  Packets over the limit are dropped without a
+	// reply, so that Reject rules cannot be used to amplify traffic.  The iptables and nftables dataplanes apply
+	// the limit to each rule, the eBPF dataplane to each source IP.  0 means that rejected packets are always
+	// dropped without a reply.  [Default: 100]
+	// +kubebuilder:validation:Minimum=0
+	PolicyRejectRateLimit *int `json:"policyRejectRateLimit,omitempty" validate:"omitempty,gte=0"`
+
+	// DNSTrustedServers is the list of DNS servers that Felix trusts to resolve the domain names used in
+	// egress policy rules and network sets.  Felix learns the IPs of those domains by snooping on the
+	// responses that these servers send to local workloads.  Each entry can be an IP address, an IP
+	// address and port ("<IPv4>:<port>" or "[<IPv6>]:<port>") or a Kubernetes service, written as
+	// "k8s-service:(<namespace>/)<service-name>", whose cluster IP and first port are used.
+	// [Default: k8s-service:kube-dns]
+	DNSTrustedServers *[]string `json:"dnsTrustedServers,omitempty"`
+
+	// DNSExtraTTL is extra time that Felix keeps a learned domain to IP mapping after the TTL of its DNS
+	// record expires.  It covers clients that keep using an IP for a short time after its record expires.
+	// [Default: 0s]
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	DNSExtraTTL *metav1.Duration `json:"dnsExtraTTL,omitempty" configv1timescale:"seconds"`
+
+	// PolicyAuditLogFilePath is the full path to the file that Felix writes the audit records of policy
+	// rules with audit enabled to, as JSON lines.  Set to none to disable writing audit records to a file.
+	// [Default: /var/log/calico/policy/audit.log]
+	PolicyAuditLogFilePath string `json:"policyAuditLogFilePath,omitempty"`
+
+	// PolicyAuditLogFileMaxSizeMB is the size, in megabytes, at which Felix rotates the policy audit log file.
+	// [Default: 100]
+	// +kubebuilder:validation:Minimum=1
+	PolicyAuditLogFileMaxSizeMB *int `json:"policyAuditLogFileMaxSizeMB,omitempty" validate:"omitempty,gte=1"`
+
+	// PolicyAuditLogFileMaxFiles is the number of rotated policy audit log files that Felix keeps. [Default: 5]
+	// +kubebuilder:validation:Minimum=0
+	PolicyAuditLogFileMaxFiles *int `json:"policyAuditLogFileMaxFiles,omitempty" validate:"omitempty,gte=0"`
+
+	// PolicyAuditLogCollector is the address of a collector that Felix forwards the audit records of policy
+	// rules with audit enabled to, as JSON lines.  It has the form "tcp://<host>:<port>" or
+	// "udp://<host>:<port>".  Empty means that records are not forwarded. [Default: ""]
+	// +kubebuilder:validation:Pattern=`^((tcp|udp)://.+:[0-9]+)?$`
+	PolicyAuditLogCollector string `json:"policyAuditLogCollector,omitempty"`
+
 	// LogPrefix is the log prefix that Felix uses when rendering LOG rules. [Default: calico-packet]
 	LogPrefix string `json:"logPrefix,omitempty"`
 
@@ -365,6 +416,13 @@ type FelixConfigurationSpec struct {
 	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
 	ReportingTTL *metav1.Duration `json:"reportingTTL,omitempty" configv1timescale:"seconds" confignamev1:"ReportingTTLSecs"`
 
+	// PolicyStatusReportInterval is the minimum interval between Felix's reports of which generation of
+	// each policy it has programmed.  kube-controllers' policy status controller aggregates the reports into
+	// the status of each NetworkPolicy and GlobalNetworkPolicy.  Set to 0 to disable. [Default: 0s]
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	PolicyStatusReportInterval *metav1.Duration `json:"policyStatusReportInterval,omitempty" configv1timescale:"seconds"`
+
 	// EndpointReportingEnabled controls whether Felix reports endpoint status to the datastore. This is only used
 	// by the OpenStack integration. [Default: false]
 	EndpointReportingEnabled *bool `json:"endpointReportingEnabled,omitempty"`
@@ -734,15 +792,38 @@ type FelixConfigurationSpec struct {
 	BPFMapSizeRoute *int `json:"bpfMapSizeRoute,omitempty"`
 
 	// BPFMapSizeConntrack sets the size for the conntrack map.  This map must be large enough to hold
-	// an entry for each active connection.  Warning: changing the size of the conntrack map can cause disruption.
+	// an entry for each active connection.  When the size changes, Felix copies the existing entries to a new
+	// map before its programs switch to it and then copies the entries that changed in the meantime.
 	BPFMapSizeConntrack *int `json:"bpfMapSizeConntrack,omitempty"`
 
 	// BPFMapSizePerCPUConntrack determines the size of conntrack map based on the number of CPUs. If set to a
 	// non-zero value, overrides BPFMapSizeConntrack with `BPFMapSizePerCPUConntrack * (Number of CPUs)`.
-	// This map must be large enough to hold an entry for each active connection.  Warning: changing the size of the
-	// conntrack map can cause disruption.
+	// This map must be large enough to hold an entry for each active connection.  When the size changes, Felix
+	// copies the existing entries to a new map before its programs switch to it and then copies the entries that
+	// changed in the meantime.
 	BPFMapSizePerCPUConntrack *int `json:"bpfMapSizePerCpuConntrack,omitempty"`
 
+	// BPFMapSizeConntrackScaling controls whether and how Felix grows the conntrack map when it fills up.  With
+	// `DoubleIfFull`, once the occupancy of the map at the end of a conntrack scan reaches
+	// BPFMapSizeConntrackScalingPercent, Felix creates a map of double the size and restarts, copying the existing
+	// entries to the new map before its programs switch to it.  A map that was grown is kept at its larger size even if it
+	// is larger than BPFMapSizeConntrack.
+	// [Default: Disabled]
+	BPFMapSizeConntrackScaling *BPFConntrackMapScaling `json:"bpfMapSizeConntrackScaling,omitempty" validate:"omitempty,oneof=Disabled DoubleIfFull"`
+
+	// BPFMapSizeConntrackScalingPercent is the occupancy of the conntrack map, in percent, at which Felix grows
+	// the map when BPFMapSizeConntrackScaling is enabled.
+	// [Default: 90]
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	BPFMapSizeConntrackScalingPercent *int `json:"bpfMapSizeConntrackScalingPercent,omitempty" validate:"omitempty,gte=1,lte=100"`
+
+	// BPFMapSizeConntrackScalingMax is the size beyond which Felix does not grow the conntrack map when
+	// BPFMapSizeConntrackScaling is enabled.
+	// [Default: 4194304]
+	// +kubebuilder:validation:Minimum=1
+	BPFMapSizeConntrackScalingMax *int `json:"bpfMapSizeConntrackScalingMax,omitempty" validate:"omitempty,gte=1"`
+
 	// BPFMapSizeConntrackCleanupQueue sets the size for the map used to hold NAT conntrack entries that are queued
 	// for cleanup.  This should be big enough to hold all the NAT entries that expire within one cleanup interval.
 	// +kubebuilder:validation:Minimum=1
@@ -802,6 +883,45 @@ type FelixConfigurationSpec struct {
 	//+kubebuilder:validation:Enum=Enabled;Disabled
 	BPFProfiling string `json:"bpfProfiling,omitempty"`
 
+	// BPFXDPPreDNATDeny controls whether the Deny rules of pre-DNAT host endpoint policy, including rules that match
+	// network sets, are also enforced by an XDP program on the host interfaces.  This drops unwanted traffic, such as a
+	// large deny list, before the kernel allocates a socket buffer for it.  Traffic that is not denied continues to the
+	// regular BPF programs, which enforce the full policy; packets of established flows skip the XDP program's
+	// policy.  Deny rules of normal (not pre-DNAT) host endpoint policy are not enforced in XDP because that policy applies
+	// after DNAT and depends on whether the traffic is for the host or forwarded, which is not known before the packet
+	// reaches the kernel.  The XDP program is attached in native (driver) mode, or in generic mode when the interface does
+	// not support native XDP and GenericXDPEnabled is true.  It is not used on interfaces that have untracked
+	// policy.  [Default: Disabled]
+	//+kubebuilder:validation:Enum=Enabled;Disabled
+	BPFXDPPreDNATDeny string `json:"bpfXDPPreDNATDeny,omitempty"`
+
+	// BPFXDPFailsafeRateLimit is the number of new connections per second that a single source IP may open to
+	// the inbound failsafe ports in BPF mode.  Packets over the limit are dropped in XDP.  Packets of
+	// established connections are not limited.  0 means no limit.  [Default: 0]
+	// +kubebuilder:validation:Minimum=0
+	BPFXDPFailsafeRateLimit *int `json:"bpfXDPFailsafeRateLimit,omitempty" validate:"omitempty,gte=0"`
+
+	// BPFServiceStats controls whether the BPF programs count the packets, bytes and connections of each
+	// service frontend and backend that they load balance to. The counters are exported as Prometheus
+	// metrics, labelled by service and backend endpoint, and can be viewed with calico-bpf nat dump --stats.
+	// Packets and bytes are counted separately towards the backends and for their replies. Connections that
+	// are load balanced at connect time are counted separately from the ones load balanced by the TC programs
+	// and their packets and bytes are not counted, so a backend that only receives such connections shows no
+	// traffic. [Default: Disabled]
+	//+kubebuilder:validation:Enum=Enabled;Disabled
+	BPFServiceStats string `json:"bpfServiceStats,omitempty"`
+
+	// BPFEgressBandwidth controls whether the BPF programs enforce the egress bandwidth limits of workloads,
+	// which are set by the kubernetes.io/egress-bandwidth pod annotation. Packets are stamped with their
+	// earliest departure time on the host interfaces and fq holds them until then. Felix replaces the default
+	// root qdisc of the host interfaces with fq, or with one fq per tx queue if it is mq, and restores it when
+	// the limits are no longer enforced. Limits are not enforced on interfaces with a root qdisc configured
+	// by the user. Traffic between workloads on the same host is not limited. The CNI bandwidth plugin reads
+	// the same annotation, so it must not be enabled as well, otherwise the limit is applied twice. The number
+	// of bytes that were delayed is exported as a Prometheus metric per workload. [Default: Disabled]
+	//+kubebuilder:validation:Enum=Enabled;Disabled
+	BPFEgressBandwidth string `json:"bpfEgressBandwidth,omitempty"`
+
 	// RouteSource configures where Felix gets its routing information.
 	// - WorkloadIPs: use workload endpoints to construct routes.
 	// - CalicoIPAM: the default - use IPAM data to construct routes.
@@ -42,7 +42,8 @@ type GlobalNetworkPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec GlobalNetworkPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Spec   GlobalNetworkPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status PolicyStatus            `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 type GlobalNetworkPolicySpec struct {
@@ -133,6 +134,10 @@ type GlobalNetworkPolicySpec struct {
 	// If the policy is _not_ used on a particular node then the work
 	// done to preload the policy (and to maintain it) is wasted.
 	PerformanceHints []PolicyPerformanceHint `json:"performanceHints,omitempty" validate:"omitempty,unique,dive,oneof=AssumeNeededOnEveryNode"`
+
+	// Schedule, if set, limits the policy to the time windows that it describes.  Outside
+	// of those windows, the policy is ignored as if it did not exist.
+	Schedule *PolicySchedule `json:"schedule,omitempty" validate:"omitempty"`
 }
 
 // NewGlobalNetworkPolicy creates a new (zeroed) GlobalNetworkPolicy struct with the TypeMetadata initialised to the current
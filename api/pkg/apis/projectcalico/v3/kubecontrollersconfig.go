@@ -87,6 +87,12 @@ type ControllersConfig struct {
 
 	// LoadBalancer enables and configures the LoadBalancer controller. Enabled by default, set to nil to disable.
 	LoadBalancer *LoadBalancerControllerConfig `json:"loadBalancer,omitempty"`
+
+	// PolicyStatus enables and configures the policy status controller. Disabled by default, set to enable.
+	PolicyStatus *PolicyStatusControllerConfig `json:"policyStatus,omitempty"`
+
+	// PolicyRecommendation enables and configures the policy recommendation controller. Disabled by default, set to enable.
+	PolicyRecommendation *PolicyRecommendationControllerConfig `json:"policyRecommendation,omitempty"`
 }
 
 // NodeControllerConfig configures the node controller, which automatically cleans up configuration
@@ -110,6 +116,39 @@ type NodeControllerConfig struct {
 type AutoHostEndpointConfig struct {
 	// AutoCreate enables automatic creation of host endpoints for every node. [Default: Disabled]
 	AutoCreate string `json:"autoCreate,omitempty" validate:"omitempty,oneof=Enabled Disabled"`
+
+	// CreateDefaultHostEndpoint controls whether the all-interfaces host endpoint, named
+	// <node>-auto-hep, is created for every node.  Disable it to only create the host endpoints
+	// described by the templates. [Default: Enabled]
+	CreateDefaultHostEndpoint string `json:"createDefaultHostEndpoint,omitempty" validate:"omitempty,oneof=Enabled Disabled"`
+
+	// Templates describe additional host endpoints to create for the nodes that they select.
+	Templates []AutoHostEndpointTemplate `json:"templates,omitempty" validate:"omitempty,dive"`
+}
+
+// AutoHostEndpointTemplate describes a host endpoint to create for each node that it selects.
+// Calico nodes do not report their interfaces, so a template either names a single interface or
+// relies on InterfaceCIDRs to pick out the interface by its addresses.
+type AutoHostEndpointTemplate struct {
+	// GenerateName is the suffix of the names of the host endpoints created from this template,
+	// which are named <node>-<generateName>.  It must be unique among the templates.
+	GenerateName string `json:"generateName" validate:"name"`
+
+	// NodeSelector selects the nodes to create host endpoints for by their labels. [Default: all()]
+	NodeSelector string `json:"nodeSelector,omitempty" validate:"omitempty,selector"`
+
+	// InterfaceName is the interface of the host endpoints, either "*" or the name of a specific
+	// interface.  If empty, each host endpoint applies to the interface that has one of its
+	// expected IPs, so InterfaceCIDRs must be set.
+	InterfaceName string `json:"interfaceName,omitempty" validate:"omitempty,interface"`
+
+	// InterfaceCIDRs restricts the expected IPs of the host endpoints to the node's IPs within
+	// these CIDRs.  No host endpoint is created for a node without any matching IPs.
+	InterfaceCIDRs []string `json:"interfaceCIDRs,omitempty" validate:"omitempty,dive,cidr"`
+
+	// Labels are added to the labels copied from the node, replacing any node labels with the
+	// same keys.
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // PolicyControllerConfig configures the network policy controller, which syncs Kubernetes policies
@@ -140,6 +179,34 @@ type NamespaceControllerConfig struct {
 	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
 }
 
+// PolicyStatusControllerConfig configures the policy status controller, which aggregates the
+// per-node policy programming reports written by Felix into the status of each NetworkPolicy and
+// GlobalNetworkPolicy.  Felix only writes the reports if its PolicyStatusReportInterval is set.
+type PolicyStatusControllerConfig struct {
+	// ReconcilerPeriod is the period to perform reconciliation with the Calico datastore. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+}
+
+// PolicyRecommendationControllerConfig configures the policy recommendation controller, which reads
+// the flows aggregated by goldmane and maintains a StagedNetworkPolicy in each namespace that allows
+// the observed traffic.  The staged policies can be reviewed and promoted to NetworkPolicies.
+// Rules are added as new traffic is observed but never removed; delete a staged policy to start
+// learning the namespace's traffic again.
+type PolicyRecommendationControllerConfig struct {
+	// ReconcilerPeriod is the period to update the recommendations from recent flows. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+
+	// GoldmaneAddress is the host:port of goldmane's flow API. [Default: goldmane.calico-system.svc:443]
+	GoldmaneAddress string `json:"goldmaneAddress,omitempty"`
+
+	// LearningPeriod is how far back to read flows on each update. [Default: 1h]
+	LearningPeriod *metav1.Duration `json:"learningPeriod,omitempty" validate:"omitempty"`
+
+	// Namespaces restricts the recommendations to the given namespaces.  If empty, a policy is
+	// recommended for every namespace with observed traffic.
+	Namespaces []string `json:"namespaces,omitempty" validate:"omitempty,dive,name"`
+}
+
 type LoadBalancerControllerConfig struct {
 	AssignIPs AssignIPs `json:"assignIPs,omitempty" validate:"omitempty,assignIPs"`
 }
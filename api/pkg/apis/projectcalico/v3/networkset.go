@@ -47,6 +47,10 @@ type NetworkSet struct {
 type NetworkSetSpec struct {
 	// The list of IP networks that belong to this set.
 	Nets []string `json:"nets,omitempty" validate:"omitempty,dive,cidr"`
+	// The list of domain names that belong to this set.  A name may start with "*." to match
+	// any subdomain.  Domains are only matched when the set is selected by the destination
+	// of an egress rule.
+	Domains []string `json:"domains,omitempty" validate:"omitempty,dive,domain"`
 }
 
 // NewNetworkSet creates a new (zeroed) NetworkSet struct with the TypeMetadata initialised to the current version.
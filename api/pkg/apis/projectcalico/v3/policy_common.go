@@ -15,6 +15,8 @@
 package v3
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 )
 
@@ -61,6 +63,12 @@ type Rule struct {
 	// HTTP contains match criteria that apply to HTTP requests.
 	HTTP *HTTPMatch `json:"http,omitempty" validate:"omitempty"`
 
+	// Audit, if true, makes Felix write a structured audit record for each new connection that
+	// matches this rule.  The record includes the policy, tier and index of the rule, the 5-tuple
+	// of the packet, the endpoints involved and the action of the rule.  Records are written to
+	// the file and collector configured in FelixConfiguration.
+	Audit bool `json:"audit,omitempty"`
+
 	// Metadata contains additional information for this rule
 	Metadata *RuleMetadata `json:"metadata,omitempty" validate:"omitempty"`
 }
@@ -73,8 +81,34 @@ type HTTPPath struct {
 	Prefix string `json:"prefix,omitempty" validate:"omitempty"`
 }
 
+// HTTPHeaderMatch specifies an HTTP header to match.  Exactly one of the match fields must be set:
+// exact: <value>: which matches the header value exactly,
+// prefix: <value-prefix>: which matches the start of the header value,
+// regex: <regex>: which matches the whole header value against an RE2 regular expression, or
+// present: true: which matches if the header is present, whatever its value.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header.  Header names are matched case-insensitively.
+	Name    string `json:"name" validate:"required"`
+	Exact   string `json:"exact,omitempty" validate:"omitempty"`
+	Prefix  string `json:"prefix,omitempty" validate:"omitempty"`
+	Regex   string `json:"regex,omitempty" validate:"omitempty"`
+	Present bool   `json:"present,omitempty" validate:"omitempty"`
+}
+
+// GRPCMatch specifies the gRPC service and methods to match.  A request is only treated as a gRPC
+// request if its content type is application/grpc.
+type GRPCMatch struct {
+	// Service is an optional field that restricts the rule to calls to the given fully-qualified
+	// gRPC service, e.g. "helloworld.Greeter".
+	Service string `json:"service,omitempty" validate:"omitempty"`
+	// Methods is an optional field that restricts the rule to calls to one of the listed gRPC
+	// methods, e.g. "SayHello".
+	// Multiple methods are OR'd together.
+	Methods []string `json:"methods,omitempty" validate:"omitempty"`
+}
+
 // HTTPMatch is an optional field that apply only to HTTP requests
-// The Methods and Path fields are joined with AND
+// The Methods, Paths, Headers, Hosts and GRPC fields are joined with AND
 type HTTPMatch struct {
 	// Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed
 	// HTTP Methods (e.g. GET, PUT, etc.)
@@ -88,6 +122,21 @@ type HTTPMatch struct {
 	// - prefix: /bar
 	// NOTE: Each entry may ONLY specify either a `exact` or a `prefix` match. The validator will check for it.
 	Paths []HTTPPath `json:"paths,omitempty" validate:"omitempty"`
+	// Headers is an optional field that restricts the rule to apply to HTTP requests whose
+	// headers match all of the listed header matches.
+	// e.g:
+	// - name: x-tenant
+	//   exact: a
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" validate:"omitempty"`
+	// Hosts is an optional field that restricts the rule to apply to HTTP requests for one of the
+	// listed hosts, as given by the Host header (or the :authority pseudo-header for HTTP/2).  Any
+	// port in the request is ignored and hosts are matched case-insensitively.  An entry may start
+	// with "*." to match any subdomain of the given domain.
+	// Multiple hosts are OR'd together.
+	Hosts []string `json:"hosts,omitempty" validate:"omitempty,dive,domain"`
+	// GRPC is an optional field that restricts the rule to apply only to gRPC calls to the given
+	// service and methods.
+	GRPC *GRPCMatch `json:"grpc,omitempty" validate:"omitempty"`
 }
 
 // ICMPFields defines structure for ICMP and NotICMP sub-struct for ICMP code and type
@@ -99,8 +148,37 @@ type ICMPFields struct {
 	// This is a technical limitation imposed by the kernel's iptables firewall, which
 	// Calico uses to enforce the rule.
 	Code *int `json:"code,omitempty" validate:"omitempty,gte=0,lte=255"`
+	// Types is an optional list of ICMP types, each with an optional code, to match.  A packet
+	// matches if it matches any entry in the list, or any of the Groups.  Types and Groups may not
+	// be combined with the Type and Code fields.
+	Types []ICMPTypeCode `json:"types,omitempty" validate:"omitempty,dive"`
+	// Groups is an optional list of named groups of ICMP types to match.  The groups are:
+	//
+	// - EchoFamily: echo request and echo reply.
+	// - NeighborDiscovery: router and neighbor solicitations and advertisements, and redirects.
+	//   Only valid for ICMPv6.
+	// - PMTU: the messages used for path MTU discovery, that is "fragmentation needed" for
+	//   ICMP and "packet too big" for ICMPv6.
+	Groups []ICMPGroup `json:"groups,omitempty" validate:"omitempty,dive,oneof=EchoFamily NeighborDiscovery PMTU"`
 }
 
+// ICMPTypeCode is an ICMP type and, optionally, code to match.
+type ICMPTypeCode struct {
+	// Match on a specific ICMP type.
+	Type int `json:"type" validate:"gte=0,lte=254"`
+	// Match on a specific ICMP code.  If not specified, any code matches.
+	Code *int `json:"code,omitempty" validate:"omitempty,gte=0,lte=255"`
+}
+
+// +kubebuilder:validation:Enum=EchoFamily;NeighborDiscovery;PMTU
+type ICMPGroup string
+
+const (
+	ICMPGroupEchoFamily        ICMPGroup = "EchoFamily"
+	ICMPGroupNeighborDiscovery ICMPGroup = "NeighborDiscovery"
+	ICMPGroupPMTU              ICMPGroup = "PMTU"
+)
+
 // An EntityRule is a sub-component of a Rule comprising the match criteria specific
 // to a particular entity (that is either the source or destination).
 //
@@ -177,6 +255,16 @@ type EntityRule struct {
 	// ServiceAccounts is an optional field that restricts the rule to only apply to traffic that originates from (or
 	// terminates at) a pod running as a matching service account.
 	ServiceAccounts *ServiceAccountMatch `json:"serviceAccounts,omitempty" validate:"omitempty"`
+
+	// Domains is an optional field, valid for egress rules only, that restricts the rule to apply
+	// only to traffic to one of the given domain names.  A name may start with "*." to match any
+	// subdomain, for example "*.example.com" matches "www.example.com" but not "example.com".
+	// Felix learns the IP addresses of the domains by snooping on the DNS responses sent to
+	// workloads by the DNS servers in DNSTrustedServers.
+	//
+	// Domains cannot be specified on the same rule as Selector, NotSelector, NamespaceSelector, Nets,
+	// NotNets, ServiceAccounts or Services.
+	Domains []string `json:"domains,omitempty" validate:"omitempty,dive,domain"`
 }
 
 type ServiceMatch struct {
@@ -206,9 +294,87 @@ const (
 	Deny  Action = "Deny"
 	Log   Action = "Log"
 	Pass  Action = "Pass"
+	// ActionReject drops the packet like Deny but also tells the sender, with a TCP reset for TCP
+	// and an ICMP administratively prohibited error otherwise, so that clients fail fast
+	// instead of waiting for a timeout.  The eBPF dataplane replies with the ICMP error for TCP
+	// too and Windows and application layer policy treat Reject as Deny.  Replies are rate
+	// limited, packets over the limit are silently dropped.
+	ActionReject Action = "Reject"
+)
+
+// StagedAction is the action to take on a staged policy when it is promoted, or how it is
+// reported while it is still staged.
+type StagedAction string
+
+const (
+	// StagedActionSet means the staged policy, when enforced, creates or replaces the
+	// policy of the same name.
+	StagedActionSet StagedAction = "Set"
+	// StagedActionDelete means the staged policy, when enforced, removes the policy of
+	// the same name.
+	StagedActionDelete StagedAction = "Delete"
 )
 
 type RuleMetadata struct {
 	// Annotations is a set of key value pairs that give extra information about the rule
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
+
+// PolicySchedule describes when a policy is active.
+type PolicySchedule struct {
+	// Windows are the time windows during which the policy is active.  The policy is active
+	// while any of its windows is open.
+	// +kubebuilder:validation:MinItems=1
+	Windows []ScheduleWindow `json:"windows" validate:"required,min=1,dive"`
+
+	// TimeZone is the IANA time zone, such as "Europe/London", in which the windows' start times
+	// are interpreted.  [Default: UTC]
+	TimeZone string `json:"timeZone,omitempty" validate:"omitempty,timeZone"`
+}
+
+// ScheduleWindow is a recurring window of time.
+type ScheduleWindow struct {
+	// Start is a cron expression with five fields (minute, hour, day of month, month and day
+	// of week) giving the times at which the window opens.  For example, "0 2 * * *" opens the
+	// window at 02:00 every day.
+	Start string `json:"start" validate:"required,cronSchedule"`
+
+	// Duration is how long the window stays open after each start time, for example "2h".
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	Duration metav1.Duration `json:"duration"`
+}
+
+const (
+	// PolicyConditionValid is the condition type that reports whether a policy passed validation.
+	PolicyConditionValid = "Valid"
+	// PolicyConditionProgrammed is the condition type that reports whether every node that the
+	// policy applies to has programmed its current generation.
+	PolicyConditionProgrammed = "Programmed"
+
+	PolicyReasonValid      = "Valid"
+	PolicyReasonInvalid    = "Invalid"
+	PolicyReasonProgrammed = "Programmed"
+	PolicyReasonPending    = "Pending"
+	PolicyReasonNotApplied = "NotApplied"
+)
+
+// PolicyStatus reports whether a policy is valid and how far its programming has progressed.
+// It is written by kube-controllers from the reports of each node.
+type PolicyStatus struct {
+	// ObservedGeneration is the generation of the policy that the status was computed for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions are the Valid and Programmed conditions of the policy.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ProgrammedNodes is the number of nodes, among those with endpoints that the policy applies
+	// to, that have programmed the observed generation.
+	ProgrammedNodes int32 `json:"programmedNodes,omitempty"`
+
+	// PendingNodes is the number of nodes, among those with endpoints that the policy applies
+	// to, that are still programming an earlier generation.
+	PendingNodes int32 `json:"pendingNodes,omitempty"`
+}
@@ -24,6 +24,10 @@ var (
 		&NetworkPolicyList{},
 		&GlobalNetworkPolicy{},
 		&GlobalNetworkPolicyList{},
+		&StagedNetworkPolicy{},
+		&StagedNetworkPolicyList{},
+		&StagedGlobalNetworkPolicy{},
+		&StagedGlobalNetworkPolicyList{},
 		&GlobalNetworkSet{},
 		&GlobalNetworkSetList{},
 		&HostEndpoint{},
@@ -54,6 +58,13 @@ var (
 		&IPAMConfigurationList{},
 		&BlockAffinity{},
 		&BlockAffinityList{},
+		&IPAMBlock{},
+		&IPAMBlockList{},
+		&IPAMHandle{},
+		&IPAMHandleList{},
+		&WorkloadEndpoint{},
+		&WorkloadEndpointList{},
+		&PolicyImpact{},
 		&BGPFilter{},
 		&BGPFilterList{},
 		&Tier{},
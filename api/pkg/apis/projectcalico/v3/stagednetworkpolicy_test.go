@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+)
+
+// These tests verify that the staged policy specs are kept in sync with the policies that they
+// are promoted to.
+var _ = DescribeTable("Staged policy spec",
+	func(staged, enforced interface{}) {
+		stagedFields := fieldsByName(staged)
+		enforcedFields := fieldsByName(enforced)
+
+		Expect(stagedFields).To(HaveLen(len(enforcedFields) + 1))
+		Expect(stagedFields).To(HaveKey("StagedAction"))
+		for n, f := range enforcedFields {
+			Expect(stagedFields).To(HaveKey(n))
+			Expect(stagedFields[n].Tag).To(Equal(f.Tag), "Field "+n+" had different tag")
+			Expect(stagedFields[n].Type).To(Equal(f.Type), "Field "+n+" had different type")
+		}
+	},
+	Entry("StagedNetworkPolicySpec", apiv3.StagedNetworkPolicySpec{}, apiv3.NetworkPolicySpec{}),
+	Entry("StagedGlobalNetworkPolicySpec", apiv3.StagedGlobalNetworkPolicySpec{}, apiv3.GlobalNetworkPolicySpec{}),
+)
+
+var _ = Describe("Staged policy conversion", func() {
+	order := 10.0
+
+	It("should convert a StagedNetworkPolicy with the default action", func() {
+		staged := apiv3.NewStagedNetworkPolicy()
+		staged.ObjectMeta = metav1.ObjectMeta{Name: "default.np", Namespace: "ns1", ResourceVersion: "1234"}
+		staged.Spec = apiv3.StagedNetworkPolicySpec{
+			Tier:     "default",
+			Order:    &order,
+			Selector: "app == 'web'",
+			Types:    []apiv3.PolicyType{apiv3.PolicyTypeIngress},
+			Ingress:  []apiv3.Rule{{Action: apiv3.Deny}},
+		}
+
+		action, enforced := apiv3.ConvertStagedPolicyToEnforced(staged)
+		Expect(action).To(Equal(apiv3.StagedActionSet))
+		Expect(enforced.Kind).To(Equal(apiv3.KindNetworkPolicy))
+		Expect(enforced.Name).To(Equal("default.np"))
+		Expect(enforced.Namespace).To(Equal("ns1"))
+		Expect(enforced.ResourceVersion).To(BeEmpty())
+		Expect(*enforced.Spec.Order).To(Equal(order))
+		Expect(enforced.Spec.Selector).To(Equal("app == 'web'"))
+		Expect(enforced.Spec.Ingress).To(Equal([]apiv3.Rule{{Action: apiv3.Deny}}))
+	})
+
+	It("should convert a StagedGlobalNetworkPolicy with a delete action", func() {
+		staged := apiv3.NewStagedGlobalNetworkPolicy()
+		staged.ObjectMeta = metav1.ObjectMeta{Name: "default.gnp"}
+		staged.Spec = apiv3.StagedGlobalNetworkPolicySpec{
+			StagedAction: apiv3.StagedActionDelete,
+			PreDNAT:      true,
+		}
+
+		action, enforced := apiv3.ConvertStagedGlobalPolicyToEnforced(staged)
+		Expect(action).To(Equal(apiv3.StagedActionDelete))
+		Expect(enforced.Kind).To(Equal(apiv3.KindGlobalNetworkPolicy))
+		Expect(enforced.Name).To(Equal("default.gnp"))
+		Expect(enforced.Spec.PreDNAT).To(BeTrue())
+	})
+})
@@ -55,6 +55,27 @@ type TierSpec struct {
 	// [Default: Deny]
 	// +kubebuilder:validation:Enum=Pass;Deny
 	DefaultAction *Action `json:"defaultAction,omitempty" validate:"omitempty,oneof=Deny Pass"`
+	// Delegations grant users and groups access to the policies in this tier without access to
+	// the tier itself, restricted to the given namespaces and policy labels.  A delegated user
+	// still needs RBAC access to the policy resource, for example to create networkpolicies in
+	// the namespace.  Delegations apply to getting, creating, updating and deleting individual
+	// policies; listing and watching policies still requires access to the tier.
+	Delegations []TierDelegation `json:"delegations,omitempty" validate:"omitempty,dive"`
+}
+
+// TierDelegation grants restricted access to the policies in a tier.
+type TierDelegation struct {
+	// Users lists the names of the users that the delegation applies to.
+	Users []string `json:"users,omitempty"`
+	// Groups lists the groups that the delegation applies to.
+	Groups []string `json:"groups,omitempty"`
+	// Namespaces restricts the delegation to policies in the given namespaces.  If empty, the
+	// delegation applies to policies in any namespace and to global policies.
+	Namespaces []string `json:"namespaces,omitempty" validate:"omitempty,dive,name"`
+	// PolicySelector restricts the delegation to policies whose labels match the selector.  For
+	// an update, both the existing and the updated policy must match.  If empty, the delegation
+	// applies to all policies.
+	PolicySelector string `json:"policySelector,omitempty" validate:"omitempty,selector"`
 }
 
 // +genclient:nonNamespaced
@@ -85,6 +85,18 @@ func (c *FakeGlobalNetworkPolicies) Update(ctx context.Context, globalNetworkPol
 	return obj.(*v3.GlobalNetworkPolicy), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGlobalNetworkPolicies) UpdateStatus(ctx context.Context, globalNetworkPolicy *v3.GlobalNetworkPolicy, opts v1.UpdateOptions) (result *v3.GlobalNetworkPolicy, err error) {
+	emptyResult := &v3.GlobalNetworkPolicy{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceActionWithOptions(globalnetworkpoliciesResource, "status", globalNetworkPolicy, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.GlobalNetworkPolicy), err
+}
+
 // Delete takes name of the globalNetworkPolicy and deletes it. Returns an error if one occurs.
 func (c *FakeGlobalNetworkPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeIPAMBlocks implements IPAMBlockInterface
+type FakeIPAMBlocks struct {
+	Fake *FakeProjectcalicoV3
+}
+
+var ipamblocksResource = v3.SchemeGroupVersion.WithResource("ipamblocks")
+
+var ipamblocksKind = v3.SchemeGroupVersion.WithKind("IPAMBlock")
+
+// Get takes name of the iPAMBlock, and returns the corresponding iPAMBlock object, and an error if there is any.
+func (c *FakeIPAMBlocks) Get(ctx context.Context, name string, options v1.GetOptions) (result *v3.IPAMBlock, err error) {
+	emptyResult := &v3.IPAMBlock{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(ipamblocksResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMBlock), err
+}
+
+// List takes label and field selectors, and returns the list of IPAMBlocks that match those selectors.
+func (c *FakeIPAMBlocks) List(ctx context.Context, opts v1.ListOptions) (result *v3.IPAMBlockList, err error) {
+	emptyResult := &v3.IPAMBlockList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(ipamblocksResource, ipamblocksKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v3.IPAMBlockList{ListMeta: obj.(*v3.IPAMBlockList).ListMeta}
+	for _, item := range obj.(*v3.IPAMBlockList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested iPAMBlocks.
+func (c *FakeIPAMBlocks) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(ipamblocksResource, opts))
+}
+
+// Create takes the representation of a iPAMBlock and creates it.  Returns the server's representation of the iPAMBlock, and an error, if there is any.
+func (c *FakeIPAMBlocks) Create(ctx context.Context, iPAMBlock *v3.IPAMBlock, opts v1.CreateOptions) (result *v3.IPAMBlock, err error) {
+	emptyResult := &v3.IPAMBlock{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(ipamblocksResource, iPAMBlock, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMBlock), err
+}
+
+// Update takes the representation of a iPAMBlock and updates it. Returns the server's representation of the iPAMBlock, and an error, if there is any.
+func (c *FakeIPAMBlocks) Update(ctx context.Context, iPAMBlock *v3.IPAMBlock, opts v1.UpdateOptions) (result *v3.IPAMBlock, err error) {
+	emptyResult := &v3.IPAMBlock{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateActionWithOptions(ipamblocksResource, iPAMBlock, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMBlock), err
+}
+
+// Delete takes name of the iPAMBlock and deletes it. Returns an error if one occurs.
+func (c *FakeIPAMBlocks) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(ipamblocksResource, name, opts), &v3.IPAMBlock{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeIPAMBlocks) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionActionWithOptions(ipamblocksResource, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v3.IPAMBlockList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched iPAMBlock.
+func (c *FakeIPAMBlocks) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.IPAMBlock, err error) {
+	emptyResult := &v3.IPAMBlock{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(ipamblocksResource, name, pt, data, opts, subresources...), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMBlock), err
+}
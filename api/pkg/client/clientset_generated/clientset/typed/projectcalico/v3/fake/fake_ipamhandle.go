@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeIPAMHandles implements IPAMHandleInterface
+type FakeIPAMHandles struct {
+	Fake *FakeProjectcalicoV3
+}
+
+var ipamhandlesResource = v3.SchemeGroupVersion.WithResource("ipamhandles")
+
+var ipamhandlesKind = v3.SchemeGroupVersion.WithKind("IPAMHandle")
+
+// Get takes name of the iPAMHandle, and returns the corresponding iPAMHandle object, and an error if there is any.
+func (c *FakeIPAMHandles) Get(ctx context.Context, name string, options v1.GetOptions) (result *v3.IPAMHandle, err error) {
+	emptyResult := &v3.IPAMHandle{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(ipamhandlesResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMHandle), err
+}
+
+// List takes label and field selectors, and returns the list of IPAMHandles that match those selectors.
+func (c *FakeIPAMHandles) List(ctx context.Context, opts v1.ListOptions) (result *v3.IPAMHandleList, err error) {
+	emptyResult := &v3.IPAMHandleList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(ipamhandlesResource, ipamhandlesKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v3.IPAMHandleList{ListMeta: obj.(*v3.IPAMHandleList).ListMeta}
+	for _, item := range obj.(*v3.IPAMHandleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested iPAMHandles.
+func (c *FakeIPAMHandles) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(ipamhandlesResource, opts))
+}
+
+// Create takes the representation of a iPAMHandle and creates it.  Returns the server's representation of the iPAMHandle, and an error, if there is any.
+func (c *FakeIPAMHandles) Create(ctx context.Context, iPAMHandle *v3.IPAMHandle, opts v1.CreateOptions) (result *v3.IPAMHandle, err error) {
+	emptyResult := &v3.IPAMHandle{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(ipamhandlesResource, iPAMHandle, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMHandle), err
+}
+
+// Update takes the representation of a iPAMHandle and updates it. Returns the server's representation of the iPAMHandle, and an error, if there is any.
+func (c *FakeIPAMHandles) Update(ctx context.Context, iPAMHandle *v3.IPAMHandle, opts v1.UpdateOptions) (result *v3.IPAMHandle, err error) {
+	emptyResult := &v3.IPAMHandle{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateActionWithOptions(ipamhandlesResource, iPAMHandle, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMHandle), err
+}
+
+// Delete takes name of the iPAMHandle and deletes it. Returns an error if one occurs.
+func (c *FakeIPAMHandles) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(ipamhandlesResource, name, opts), &v3.IPAMHandle{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeIPAMHandles) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionActionWithOptions(ipamhandlesResource, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v3.IPAMHandleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched iPAMHandle.
+func (c *FakeIPAMHandles) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.IPAMHandle, err error) {
+	emptyResult := &v3.IPAMHandle{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(ipamhandlesResource, name, pt, data, opts, subresources...), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.IPAMHandle), err
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePolicyImpacts implements PolicyImpactInterface
+type FakePolicyImpacts struct {
+	Fake *FakeProjectcalicoV3
+}
+
+var policyimpactsResource = v3.SchemeGroupVersion.WithResource("policyimpacts")
+
+var policyimpactsKind = v3.SchemeGroupVersion.WithKind("PolicyImpact")
+
+// Create takes the representation of a policyImpact and creates it.  Returns the server's representation of the policyImpact, and an error, if there is any.
+func (c *FakePolicyImpacts) Create(ctx context.Context, policyImpact *v3.PolicyImpact, opts v1.CreateOptions) (result *v3.PolicyImpact, err error) {
+	emptyResult := &v3.PolicyImpact{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(policyimpactsResource, policyImpact, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.PolicyImpact), err
+}
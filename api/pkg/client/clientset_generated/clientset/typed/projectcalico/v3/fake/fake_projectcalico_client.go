@@ -54,10 +54,18 @@ func (c *FakeProjectcalicoV3) HostEndpoints() v3.HostEndpointInterface {
 	return &FakeHostEndpoints{c}
 }
 
+func (c *FakeProjectcalicoV3) IPAMBlocks() v3.IPAMBlockInterface {
+	return &FakeIPAMBlocks{c}
+}
+
 func (c *FakeProjectcalicoV3) IPAMConfigurations() v3.IPAMConfigurationInterface {
 	return &FakeIPAMConfigurations{c}
 }
 
+func (c *FakeProjectcalicoV3) IPAMHandles() v3.IPAMHandleInterface {
+	return &FakeIPAMHandles{c}
+}
+
 func (c *FakeProjectcalicoV3) IPPools() v3.IPPoolInterface {
 	return &FakeIPPools{c}
 }
@@ -78,14 +86,30 @@ func (c *FakeProjectcalicoV3) NetworkSets(namespace string) v3.NetworkSetInterfa
 	return &FakeNetworkSets{c, namespace}
 }
 
+func (c *FakeProjectcalicoV3) PolicyImpacts() v3.PolicyImpactInterface {
+	return &FakePolicyImpacts{c}
+}
+
 func (c *FakeProjectcalicoV3) Profiles() v3.ProfileInterface {
 	return &FakeProfiles{c}
 }
 
+func (c *FakeProjectcalicoV3) StagedGlobalNetworkPolicies() v3.StagedGlobalNetworkPolicyInterface {
+	return &FakeStagedGlobalNetworkPolicies{c}
+}
+
+func (c *FakeProjectcalicoV3) StagedNetworkPolicies(namespace string) v3.StagedNetworkPolicyInterface {
+	return &FakeStagedNetworkPolicies{c, namespace}
+}
+
 func (c *FakeProjectcalicoV3) Tiers() v3.TierInterface {
 	return &FakeTiers{c}
 }
 
+func (c *FakeProjectcalicoV3) WorkloadEndpoints(namespace string) v3.WorkloadEndpointInterface {
+	return &FakeWorkloadEndpoints{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeProjectcalicoV3) RESTClient() rest.Interface {
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkloadEndpoints implements WorkloadEndpointInterface
+type FakeWorkloadEndpoints struct {
+	Fake *FakeProjectcalicoV3
+	ns   string
+}
+
+var workloadendpointsResource = v3.SchemeGroupVersion.WithResource("workloadendpoints")
+
+var workloadendpointsKind = v3.SchemeGroupVersion.WithKind("WorkloadEndpoint")
+
+// Get takes name of the workloadEndpoint, and returns the corresponding workloadEndpoint object, and an error if there is any.
+func (c *FakeWorkloadEndpoints) Get(ctx context.Context, name string, options v1.GetOptions) (result *v3.WorkloadEndpoint, err error) {
+	emptyResult := &v3.WorkloadEndpoint{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(workloadendpointsResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.WorkloadEndpoint), err
+}
+
+// List takes label and field selectors, and returns the list of WorkloadEndpoints that match those selectors.
+func (c *FakeWorkloadEndpoints) List(ctx context.Context, opts v1.ListOptions) (result *v3.WorkloadEndpointList, err error) {
+	emptyResult := &v3.WorkloadEndpointList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(workloadendpointsResource, workloadendpointsKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v3.WorkloadEndpointList{ListMeta: obj.(*v3.WorkloadEndpointList).ListMeta}
+	for _, item := range obj.(*v3.WorkloadEndpointList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workloadEndpoints.
+func (c *FakeWorkloadEndpoints) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(workloadendpointsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a workloadEndpoint and creates it.  Returns the server's representation of the workloadEndpoint, and an error, if there is any.
+func (c *FakeWorkloadEndpoints) Create(ctx context.Context, workloadEndpoint *v3.WorkloadEndpoint, opts v1.CreateOptions) (result *v3.WorkloadEndpoint, err error) {
+	emptyResult := &v3.WorkloadEndpoint{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(workloadendpointsResource, c.ns, workloadEndpoint, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.WorkloadEndpoint), err
+}
+
+// Update takes the representation of a workloadEndpoint and updates it. Returns the server's representation of the workloadEndpoint, and an error, if there is any.
+func (c *FakeWorkloadEndpoints) Update(ctx context.Context, workloadEndpoint *v3.WorkloadEndpoint, opts v1.UpdateOptions) (result *v3.WorkloadEndpoint, err error) {
+	emptyResult := &v3.WorkloadEndpoint{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(workloadendpointsResource, c.ns, workloadEndpoint, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.WorkloadEndpoint), err
+}
+
+// Delete takes name of the workloadEndpoint and deletes it. Returns an error if one occurs.
+func (c *FakeWorkloadEndpoints) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(workloadendpointsResource, c.ns, name, opts), &v3.WorkloadEndpoint{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWorkloadEndpoints) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(workloadendpointsResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v3.WorkloadEndpointList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched workloadEndpoint.
+func (c *FakeWorkloadEndpoints) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.WorkloadEndpoint, err error) {
+	emptyResult := &v3.WorkloadEndpoint{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(workloadendpointsResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v3.WorkloadEndpoint), err
+}
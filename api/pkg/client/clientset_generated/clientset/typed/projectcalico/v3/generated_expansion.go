@@ -24,8 +24,12 @@ type GlobalNetworkSetExpansion interface{}
 
 type HostEndpointExpansion interface{}
 
+type IPAMBlockExpansion interface{}
+
 type IPAMConfigurationExpansion interface{}
 
+type IPAMHandleExpansion interface{}
+
 type IPPoolExpansion interface{}
 
 type IPReservationExpansion interface{}
@@ -36,6 +40,14 @@ type NetworkPolicyExpansion interface{}
 
 type NetworkSetExpansion interface{}
 
+type PolicyImpactExpansion interface{}
+
 type ProfileExpansion interface{}
 
+type StagedGlobalNetworkPolicyExpansion interface{}
+
+type StagedNetworkPolicyExpansion interface{}
+
 type TierExpansion interface{}
+
+type WorkloadEndpointExpansion interface{}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	scheme "github.com/projectcalico/api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// IPAMHandlesGetter has a method to return a IPAMHandleInterface.
+// A group's client should implement this interface.
+type IPAMHandlesGetter interface {
+	IPAMHandles() IPAMHandleInterface
+}
+
+// IPAMHandleInterface has methods to work with IPAMHandle resources.
+type IPAMHandleInterface interface {
+	Create(ctx context.Context, iPAMHandle *v3.IPAMHandle, opts v1.CreateOptions) (*v3.IPAMHandle, error)
+	Update(ctx context.Context, iPAMHandle *v3.IPAMHandle, opts v1.UpdateOptions) (*v3.IPAMHandle, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v3.IPAMHandle, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v3.IPAMHandleList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.IPAMHandle, err error)
+	IPAMHandleExpansion
+}
+
+// iPAMHandles implements IPAMHandleInterface
+type iPAMHandles struct {
+	*gentype.ClientWithList[*v3.IPAMHandle, *v3.IPAMHandleList]
+}
+
+// newIPAMHandles returns a IPAMHandles
+func newIPAMHandles(c *ProjectcalicoV3Client) *iPAMHandles {
+	return &iPAMHandles{
+		gentype.NewClientWithList[*v3.IPAMHandle, *v3.IPAMHandleList](
+			"ipamhandles",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v3.IPAMHandle { return &v3.IPAMHandle{} },
+			func() *v3.IPAMHandleList { return &v3.IPAMHandleList{} }),
+	}
+}
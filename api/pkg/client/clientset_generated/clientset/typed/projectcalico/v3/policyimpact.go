@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	scheme "github.com/projectcalico/api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// PolicyImpactsGetter has a method to return a PolicyImpactInterface.
+// A group's client should implement this interface.
+type PolicyImpactsGetter interface {
+	PolicyImpacts() PolicyImpactInterface
+}
+
+// PolicyImpactInterface has methods to work with PolicyImpact resources.
+type PolicyImpactInterface interface {
+	Create(ctx context.Context, policyImpact *v3.PolicyImpact, opts v1.CreateOptions) (*v3.PolicyImpact, error)
+	PolicyImpactExpansion
+}
+
+// policyImpacts implements PolicyImpactInterface
+type policyImpacts struct {
+	*gentype.Client[*v3.PolicyImpact]
+}
+
+// newPolicyImpacts returns a PolicyImpacts
+func newPolicyImpacts(c *ProjectcalicoV3Client) *policyImpacts {
+	return &policyImpacts{
+		gentype.NewClient[*v3.PolicyImpact](
+			"policyimpacts",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v3.PolicyImpact { return &v3.PolicyImpact{} }),
+	}
+}
@@ -24,14 +24,20 @@ type ProjectcalicoV3Interface interface {
 	GlobalNetworkPoliciesGetter
 	GlobalNetworkSetsGetter
 	HostEndpointsGetter
+	IPAMBlocksGetter
 	IPAMConfigurationsGetter
+	IPAMHandlesGetter
 	IPPoolsGetter
 	IPReservationsGetter
 	KubeControllersConfigurationsGetter
 	NetworkPoliciesGetter
 	NetworkSetsGetter
+	PolicyImpactsGetter
 	ProfilesGetter
+	StagedGlobalNetworkPoliciesGetter
+	StagedNetworkPoliciesGetter
 	TiersGetter
+	WorkloadEndpointsGetter
 }
 
 // ProjectcalicoV3Client is used to interact with features provided by the projectcalico.org group.
@@ -79,10 +85,18 @@ func (c *ProjectcalicoV3Client) HostEndpoints() HostEndpointInterface {
 	return newHostEndpoints(c)
 }
 
+func (c *ProjectcalicoV3Client) IPAMBlocks() IPAMBlockInterface {
+	return newIPAMBlocks(c)
+}
+
 func (c *ProjectcalicoV3Client) IPAMConfigurations() IPAMConfigurationInterface {
 	return newIPAMConfigurations(c)
 }
 
+func (c *ProjectcalicoV3Client) IPAMHandles() IPAMHandleInterface {
+	return newIPAMHandles(c)
+}
+
 func (c *ProjectcalicoV3Client) IPPools() IPPoolInterface {
 	return newIPPools(c)
 }
@@ -103,14 +117,30 @@ func (c *ProjectcalicoV3Client) NetworkSets(namespace string) NetworkSetInterfac
 	return newNetworkSets(c, namespace)
 }
 
+func (c *ProjectcalicoV3Client) PolicyImpacts() PolicyImpactInterface {
+	return newPolicyImpacts(c)
+}
+
 func (c *ProjectcalicoV3Client) Profiles() ProfileInterface {
 	return newProfiles(c)
 }
 
+func (c *ProjectcalicoV3Client) StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInterface {
+	return newStagedGlobalNetworkPolicies(c)
+}
+
+func (c *ProjectcalicoV3Client) StagedNetworkPolicies(namespace string) StagedNetworkPolicyInterface {
+	return newStagedNetworkPolicies(c, namespace)
+}
+
 func (c *ProjectcalicoV3Client) Tiers() TierInterface {
 	return newTiers(c)
 }
 
+func (c *ProjectcalicoV3Client) WorkloadEndpoints(namespace string) WorkloadEndpointInterface {
+	return newWorkloadEndpoints(c, namespace)
+}
+
 // NewForConfig creates a new ProjectcalicoV3Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	scheme "github.com/projectcalico/api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// WorkloadEndpointsGetter has a method to return a WorkloadEndpointInterface.
+// A group's client should implement this interface.
+type WorkloadEndpointsGetter interface {
+	WorkloadEndpoints(namespace string) WorkloadEndpointInterface
+}
+
+// WorkloadEndpointInterface has methods to work with WorkloadEndpoint resources.
+type WorkloadEndpointInterface interface {
+	Create(ctx context.Context, workloadEndpoint *v3.WorkloadEndpoint, opts v1.CreateOptions) (*v3.WorkloadEndpoint, error)
+	Update(ctx context.Context, workloadEndpoint *v3.WorkloadEndpoint, opts v1.UpdateOptions) (*v3.WorkloadEndpoint, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v3.WorkloadEndpoint, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v3.WorkloadEndpointList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.WorkloadEndpoint, err error)
+	WorkloadEndpointExpansion
+}
+
+// workloadEndpoints implements WorkloadEndpointInterface
+type workloadEndpoints struct {
+	*gentype.ClientWithList[*v3.WorkloadEndpoint, *v3.WorkloadEndpointList]
+}
+
+// newWorkloadEndpoints returns a WorkloadEndpoints
+func newWorkloadEndpoints(c *ProjectcalicoV3Client, namespace string) *workloadEndpoints {
+	return &workloadEndpoints{
+		gentype.NewClientWithList[*v3.WorkloadEndpoint, *v3.WorkloadEndpointList](
+			"workloadendpoints",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v3.WorkloadEndpoint { return &v3.WorkloadEndpoint{} },
+			func() *v3.WorkloadEndpointList { return &v3.WorkloadEndpointList{} }),
+	}
+}
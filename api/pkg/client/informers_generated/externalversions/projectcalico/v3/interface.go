@@ -30,8 +30,12 @@ type Interface interface {
 	GlobalNetworkSets() GlobalNetworkSetInformer
 	// HostEndpoints returns a HostEndpointInformer.
 	HostEndpoints() HostEndpointInformer
+	// IPAMBlocks returns a IPAMBlockInformer.
+	IPAMBlocks() IPAMBlockInformer
 	// IPAMConfigurations returns a IPAMConfigurationInformer.
 	IPAMConfigurations() IPAMConfigurationInformer
+	// IPAMHandles returns a IPAMHandleInformer.
+	IPAMHandles() IPAMHandleInformer
 	// IPPools returns a IPPoolInformer.
 	IPPools() IPPoolInformer
 	// IPReservations returns a IPReservationInformer.
@@ -44,8 +48,14 @@ type Interface interface {
 	NetworkSets() NetworkSetInformer
 	// Profiles returns a ProfileInformer.
 	Profiles() ProfileInformer
+	// StagedGlobalNetworkPolicies returns a StagedGlobalNetworkPolicyInformer.
+	StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInformer
+	// StagedNetworkPolicies returns a StagedNetworkPolicyInformer.
+	StagedNetworkPolicies() StagedNetworkPolicyInformer
 	// Tiers returns a TierInformer.
 	Tiers() TierInformer
+	// WorkloadEndpoints returns a WorkloadEndpointInformer.
+	WorkloadEndpoints() WorkloadEndpointInformer
 }
 
 type version struct {
@@ -109,11 +119,21 @@ func (v *version) HostEndpoints() HostEndpointInformer {
 	return &hostEndpointInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// IPAMBlocks returns a IPAMBlockInformer.
+func (v *version) IPAMBlocks() IPAMBlockInformer {
+	return &iPAMBlockInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // IPAMConfigurations returns a IPAMConfigurationInformer.
 func (v *version) IPAMConfigurations() IPAMConfigurationInformer {
 	return &iPAMConfigurationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// IPAMHandles returns a IPAMHandleInformer.
+func (v *version) IPAMHandles() IPAMHandleInformer {
+	return &iPAMHandleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // IPPools returns a IPPoolInformer.
 func (v *version) IPPools() IPPoolInformer {
 	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -144,7 +164,22 @@ func (v *version) Profiles() ProfileInformer {
 	return &profileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// StagedGlobalNetworkPolicies returns a StagedGlobalNetworkPolicyInformer.
+func (v *version) StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInformer {
+	return &stagedGlobalNetworkPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// StagedNetworkPolicies returns a StagedNetworkPolicyInformer.
+func (v *version) StagedNetworkPolicies() StagedNetworkPolicyInformer {
+	return &stagedNetworkPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Tiers returns a TierInformer.
 func (v *version) Tiers() TierInformer {
 	return &tierInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// WorkloadEndpoints returns a WorkloadEndpointInformer.
+func (v *version) WorkloadEndpoints() WorkloadEndpointInformer {
+	return &workloadEndpointInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -44,10 +44,18 @@ type GlobalNetworkSetListerExpansion interface{}
 // HostEndpointLister.
 type HostEndpointListerExpansion interface{}
 
+// IPAMBlockListerExpansion allows custom methods to be added to
+// IPAMBlockLister.
+type IPAMBlockListerExpansion interface{}
+
 // IPAMConfigurationListerExpansion allows custom methods to be added to
 // IPAMConfigurationLister.
 type IPAMConfigurationListerExpansion interface{}
 
+// IPAMHandleListerExpansion allows custom methods to be added to
+// IPAMHandleLister.
+type IPAMHandleListerExpansion interface{}
+
 // IPPoolListerExpansion allows custom methods to be added to
 // IPPoolLister.
 type IPPoolListerExpansion interface{}
@@ -80,6 +88,26 @@ type NetworkSetNamespaceListerExpansion interface{}
 // ProfileLister.
 type ProfileListerExpansion interface{}
 
+// StagedGlobalNetworkPolicyListerExpansion allows custom methods to be added to
+// StagedGlobalNetworkPolicyLister.
+type StagedGlobalNetworkPolicyListerExpansion interface{}
+
+// StagedNetworkPolicyListerExpansion allows custom methods to be added to
+// StagedNetworkPolicyLister.
+type StagedNetworkPolicyListerExpansion interface{}
+
+// StagedNetworkPolicyNamespaceListerExpansion allows custom methods to be added to
+// StagedNetworkPolicyNamespaceLister.
+type StagedNetworkPolicyNamespaceListerExpansion interface{}
+
 // TierListerExpansion allows custom methods to be added to
 // TierLister.
 type TierListerExpansion interface{}
+
+// WorkloadEndpointListerExpansion allows custom methods to be added to
+// WorkloadEndpointLister.
+type WorkloadEndpointListerExpansion interface{}
+
+// WorkloadEndpointNamespaceListerExpansion allows custom methods to be added to
+// WorkloadEndpointNamespaceLister.
+type WorkloadEndpointNamespaceListerExpansion interface{}
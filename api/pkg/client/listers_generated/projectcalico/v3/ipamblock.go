@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IPAMBlockLister helps list IPAMBlocks.
+// All objects returned here must be treated as read-only.
+type IPAMBlockLister interface {
+	// List lists all IPAMBlocks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v3.IPAMBlock, err error)
+	// Get retrieves the IPAMBlock from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v3.IPAMBlock, error)
+	IPAMBlockListerExpansion
+}
+
+// iPAMBlockLister implements the IPAMBlockLister interface.
+type iPAMBlockLister struct {
+	listers.ResourceIndexer[*v3.IPAMBlock]
+}
+
+// NewIPAMBlockLister returns a new IPAMBlockLister.
+func NewIPAMBlockLister(indexer cache.Indexer) IPAMBlockLister {
+	return &iPAMBlockLister{listers.New[*v3.IPAMBlock](indexer, v3.Resource("ipamblock"))}
+}
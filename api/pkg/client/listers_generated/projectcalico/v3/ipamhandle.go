@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IPAMHandleLister helps list IPAMHandles.
+// All objects returned here must be treated as read-only.
+type IPAMHandleLister interface {
+	// List lists all IPAMHandles in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v3.IPAMHandle, err error)
+	// Get retrieves the IPAMHandle from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v3.IPAMHandle, error)
+	IPAMHandleListerExpansion
+}
+
+// iPAMHandleLister implements the IPAMHandleLister interface.
+type iPAMHandleLister struct {
+	listers.ResourceIndexer[*v3.IPAMHandle]
+}
+
+// NewIPAMHandleLister returns a new IPAMHandleLister.
+func NewIPAMHandleLister(indexer cache.Indexer) IPAMHandleLister {
+	return &iPAMHandleLister{listers.New[*v3.IPAMHandle](indexer, v3.Resource("ipamhandle"))}
+}
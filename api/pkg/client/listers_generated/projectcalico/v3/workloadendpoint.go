@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WorkloadEndpointLister helps list WorkloadEndpoints.
+// All objects returned here must be treated as read-only.
+type WorkloadEndpointLister interface {
+	// List lists all WorkloadEndpoints in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v3.WorkloadEndpoint, err error)
+	// WorkloadEndpoints returns an object that can list and get WorkloadEndpoints.
+	WorkloadEndpoints(namespace string) WorkloadEndpointNamespaceLister
+	WorkloadEndpointListerExpansion
+}
+
+// workloadEndpointLister implements the WorkloadEndpointLister interface.
+type workloadEndpointLister struct {
+	listers.ResourceIndexer[*v3.WorkloadEndpoint]
+}
+
+// NewWorkloadEndpointLister returns a new WorkloadEndpointLister.
+func NewWorkloadEndpointLister(indexer cache.Indexer) WorkloadEndpointLister {
+	return &workloadEndpointLister{listers.New[*v3.WorkloadEndpoint](indexer, v3.Resource("workloadendpoint"))}
+}
+
+// WorkloadEndpoints returns an object that can list and get WorkloadEndpoints.
+func (s *workloadEndpointLister) WorkloadEndpoints(namespace string) WorkloadEndpointNamespaceLister {
+	return workloadEndpointNamespaceLister{listers.NewNamespaced[*v3.WorkloadEndpoint](s.ResourceIndexer, namespace)}
+}
+
+// WorkloadEndpointNamespaceLister helps list and get WorkloadEndpoints.
+// All objects returned here must be treated as read-only.
+type WorkloadEndpointNamespaceLister interface {
+	// List lists all WorkloadEndpoints in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v3.WorkloadEndpoint, err error)
+	// Get retrieves the WorkloadEndpoint from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v3.WorkloadEndpoint, error)
+	WorkloadEndpointNamespaceListerExpansion
+}
+
+// workloadEndpointNamespaceLister implements the WorkloadEndpointNamespaceLister
+// interface.
+type workloadEndpointNamespaceLister struct {
+	listers.ResourceIndexer[*v3.WorkloadEndpoint]
+}
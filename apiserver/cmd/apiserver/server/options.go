@@ -55,6 +55,10 @@ type CalicoServerOptions struct {
 	// This Kubernetes feature was made default in k8s 1.30, but may not be enabled prior.
 	EnableValidatingAdmissionPolicy bool
 
+	// GoldmaneAddress is the address of goldmane's flow API, used to evaluate flows when previewing
+	// policies.
+	GoldmaneAddress string
+
 	StopCh <-chan struct{}
 }
 
@@ -67,6 +71,8 @@ func (o *CalicoServerOptions) addFlags(flags *pflag.FlagSet) {
 		"If print-swagger is set true, then write swagger.json to location specified. Default is current directory.")
 	flags.BoolVar(&o.EnableValidatingAdmissionPolicy, "enable-validating-admission-policy", true,
 		"If true, establishes watches for ValidatingAdmissionPolicy at startup.")
+	flags.StringVar(&o.GoldmaneAddress, "goldmane-address", "",
+		"Address of the goldmane flow API used to evaluate flows for PolicyImpact requests. If empty, flows are not evaluated.")
 }
 
 func (o *CalicoServerOptions) Validate(args []string) error {
@@ -219,6 +225,7 @@ func (o *CalicoServerOptions) Config() (*apiserver.Config, error) {
 		ExtraConfig: apiserver.ExtraConfig{
 			KubernetesAPIServerConfig:  serverConfig.ClientConfig,
 			MinResourceRefreshInterval: minResourceRefreshInterval,
+			GoldmaneAddress:            o.GoldmaneAddress,
 		},
 	}
 
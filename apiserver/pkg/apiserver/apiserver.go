@@ -61,6 +61,9 @@ type ExtraConfig struct {
 	// Place you custom config here.
 	KubernetesAPIServerConfig  *rest.Config
 	MinResourceRefreshInterval time.Duration
+
+	// GoldmaneAddress is the address of goldmane's flow API, used when previewing policies.
+	GoldmaneAddress string
 }
 
 type Config struct {
@@ -108,7 +111,7 @@ func (c completedConfig) New() (*ProjectCalicoServer, error) {
 	apiGroupInfo.NegotiatedSerializer = newProtocolShieldSerializer(&Codecs)
 
 	// TODO: Make the storage type configurable
-	calicostore := calicorest.RESTStorageProvider{StorageType: "calico"}
+	calicostore := calicorest.RESTStorageProvider{StorageType: "calico", GoldmaneAddress: c.ExtraConfig.GoldmaneAddress}
 
 	// Create a backend Calico v3 clientset.
 	cc := calico.CreateClientFromConfig().(backendClient).Backend()
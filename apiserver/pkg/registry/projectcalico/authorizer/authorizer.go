@@ -6,13 +6,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 
 	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/sirupsen/logrus"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/authentication/user"
 	k8sauth "k8s.io/apiserver/pkg/authorization/authorizer"
 	"k8s.io/apiserver/pkg/endpoints/filters"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+const (
+	// AuditDecisionAnnotation and AuditReasonAnnotation are the audit annotations added to requests
+	// that are denied because of their tier.
+	AuditDecisionAnnotation = "tiers.projectcalico.org/decision"
+	AuditReasonAnnotation   = "tiers.projectcalico.org/reason"
+
+	auditDecisionForbid = "forbid"
 )
 
 type TierAuthorizer interface {
@@ -20,16 +35,43 @@ type TierAuthorizer interface {
 	// whether the user us authorized to perform the operation. Returns a Forbidden error  if the
 	// operation is not authorized.
 	AuthorizeTierOperation(ctx context.Context, policyName string, tierName string) error
+
+	// AuthorizeTieredPolicyOperation checks whether the user is authorized to perform the operation
+	// on an individual tiered policy.  If access to the tier does not authorize the operation, the
+	// tier's delegations are checked against the policies returned by getPolicies: the existing
+	// policy for a get or delete, the new policy for a create and both for an update.  Returns a
+	// Forbidden error, and annotates the audit event, if the operation is not authorized.
+	AuthorizeTieredPolicyOperation(ctx context.Context, policyName string, tierName string, getPolicies func() ([]metav1.Object, error)) error
+}
+
+// TierLister lists the tiers, used to look up a tier's delegations.
+type TierLister interface {
+	ListTiers() ([]*calico.Tier, error)
 }
 
 type authorizer struct {
 	k8sauth.Authorizer
+	tiers TierLister
 }
 
 // Returns a new TierAuthorizer that uses the provided standard authorizer to perform the underlying
 // lookups.
 func NewTierAuthorizer(a k8sauth.Authorizer) TierAuthorizer {
-	return &authorizer{a}
+	return &authorizer{Authorizer: a}
+}
+
+// NewTierAuthorizerWithDelegations returns a new TierAuthorizer that also honors the delegations
+// of the tiers returned by the lister.
+func NewTierAuthorizerWithDelegations(a k8sauth.Authorizer, tiers TierLister) TierAuthorizer {
+	return &authorizer{Authorizer: a, tiers: tiers}
+}
+
+// AnnotateForbidden adds the audit annotations for a request that is denied because of its tier.
+func AnnotateForbidden(ctx context.Context, reason string) {
+	audit.AddAuditAnnotations(ctx,
+		AuditDecisionAnnotation, auditDecisionForbid,
+		AuditReasonAnnotation, reason,
+	)
 }
 
 // AuthorizeTierOperation implements the TierAuthorizer interface.
@@ -52,6 +94,63 @@ func (a *authorizer) AuthorizeTierOperation(
 	// Log the original authorizer attributes.
 	logAuthorizerAttributes(attributes)
 
+	decisionGetTier, allowed := a.authorizeTier(attributes, tierName)
+	if allowed {
+		logrus.Trace("Operation allowed")
+		return nil
+	}
+
+	// Request is forbidden.
+	reason := forbiddenMessage(attributes, "tier", tierName, decisionGetTier)
+	logrus.Debugf("Operation on Calico tiered policy is forbidden: %v", reason)
+	return k8serrors.NewForbidden(calico.Resource(attributes.GetResource()), policyName, errors.New(reason))
+}
+
+// AuthorizeTieredPolicyOperation implements the TierAuthorizer interface.
+func (a *authorizer) AuthorizeTieredPolicyOperation(
+	ctx context.Context,
+	policyName string,
+	tierName string,
+	getPolicies func() ([]metav1.Object, error),
+) error {
+	if a.Authorizer == nil {
+		logrus.Debug("No authorizer - allow operation")
+		return nil
+	}
+
+	attributes, err := filters.GetAuthorizerAttributes(ctx)
+	if err != nil {
+		logrus.Errorf("Unable to extract authorizer attributes: %s", err)
+		return err
+	}
+
+	// Log the original authorizer attributes.
+	logAuthorizerAttributes(attributes)
+
+	decisionGetTier, allowed := a.authorizeTier(attributes, tierName)
+	if allowed {
+		logrus.Trace("Operation allowed")
+		return nil
+	}
+
+	delegated, err := a.authorizeDelegation(attributes, tierName, getPolicies)
+	if err != nil {
+		return err
+	} else if delegated {
+		logrus.Trace("Operation allowed by tier delegation")
+		return nil
+	}
+
+	// Request is forbidden.
+	reason := forbiddenMessage(attributes, "tier", tierName, decisionGetTier)
+	logrus.Debugf("Operation on Calico tiered policy is forbidden: %v", reason)
+	AnnotateForbidden(ctx, reason)
+	return k8serrors.NewForbidden(calico.Resource(attributes.GetResource()), policyName, errors.New(reason))
+}
+
+// authorizeTier checks whether RBAC on the tier authorizes the operation.  It returns the decision
+// for getting the tier, used to explain a denial, and whether the operation is allowed.
+func (a *authorizer) authorizeTier(attributes k8sauth.Attributes, tierName string) (k8sauth.Decision, bool) {
 	// We need to check whether the user is authorized to perform the action on the tier.<resourcetype>
 	// resource, with a resource name of either:
 	// - <tier>.*         (this is the wildcard syntax for any Calico policy within a tier)
@@ -142,16 +241,86 @@ func (a *authorizer) AuthorizeTierOperation(
 
 	// If the user has GET access to the tier and either the policy match or tier wildcard match are authorized
 	// then allow the request.
-	if decisionGetTier == k8sauth.DecisionAllow &&
-		(decisionPolicy == k8sauth.DecisionAllow || decisionTierWildcard == k8sauth.DecisionAllow) {
-		logrus.Trace("Operation allowed")
-		return nil
+	return decisionGetTier, decisionGetTier == k8sauth.DecisionAllow &&
+		(decisionPolicy == k8sauth.DecisionAllow || decisionTierWildcard == k8sauth.DecisionAllow)
+}
+
+// authorizeDelegation checks whether one of the tier's delegations authorizes the operation.  The
+// policies are only fetched if a delegation applies to the user and namespace.
+func (a *authorizer) authorizeDelegation(
+	attributes k8sauth.Attributes,
+	tierName string,
+	getPolicies func() ([]metav1.Object, error),
+) (bool, error) {
+	if a.tiers == nil || attributes.GetUser() == nil {
+		return false, nil
+	}
+	switch attributes.GetVerb() {
+	case "get", "create", "update", "patch", "delete":
+	default:
+		// Listing and watching still require access to the tier.
+		return false, nil
 	}
 
-	// Request is forbidden.
-	reason := forbiddenMessage(attributes, "tier", tierName, decisionGetTier)
-	logrus.Debugf("Operation on Calico tiered policy is forbidden: %v", reason)
-	return k8serrors.NewForbidden(calico.Resource(attributes.GetResource()), policyName, errors.New(reason))
+	tiers, err := a.tiers.ListTiers()
+	if err != nil {
+		return false, err
+	}
+	var delegations []calico.TierDelegation
+	for _, t := range tiers {
+		if t.Name == tierName {
+			delegations = t.Spec.Delegations
+			break
+		}
+	}
+
+	var policies []metav1.Object
+	for _, d := range delegations {
+		if !delegationAppliesTo(d, attributes.GetUser(), attributes.GetNamespace()) {
+			continue
+		}
+		if d.PolicySelector == "" {
+			return true, nil
+		}
+		sel, err := selector.Parse(d.PolicySelector)
+		if err != nil {
+			logrus.WithError(err).Warnf("Ignoring tier %s delegation with invalid policy selector", tierName)
+			continue
+		}
+		if policies == nil {
+			if policies, err = getPolicies(); err != nil {
+				return false, err
+			}
+		}
+		matches := true
+		for _, p := range policies {
+			if !sel.Evaluate(p.GetLabels()) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// delegationAppliesTo returns true if the delegation is for the user, or one of their groups, and
+// for policies in the namespace.  Global policies have an empty namespace.
+func delegationAppliesTo(d calico.TierDelegation, u user.Info, namespace string) bool {
+	if len(d.Namespaces) > 0 && !slices.Contains(d.Namespaces, namespace) {
+		return false
+	}
+	if slices.Contains(d.Users, u.GetName()) {
+		return true
+	}
+	for _, g := range u.GetGroups() {
+		if slices.Contains(d.Groups, g) {
+			return true
+		}
+	}
+	return false
 }
 
 // forbiddenMessage crafts the appropriate forbidden message for our special hierarchically owned resource types. This
@@ -4,9 +4,13 @@ package authorizer_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/audit"
 	"k8s.io/apiserver/pkg/authentication/user"
 	k8sauth "k8s.io/apiserver/pkg/authorization/authorizer"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
@@ -447,3 +451,168 @@ func TestGlobalNetworkPolicyDenied(t *testing.T) {
 		t.Fatalf("Incorrect error message listing GNP when not permitted by NP RBAC: %v", err)
 	}
 }
+
+type testTiers []*calico.Tier
+
+func (t testTiers) ListTiers() ([]*calico.Tier, error) {
+	return t, nil
+}
+
+func delegatedTier(delegations ...calico.TierDelegation) testTiers {
+	return testTiers{{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-tier"},
+		Spec:       calico.TierSpec{Delegations: delegations},
+	}}
+}
+
+// policyWithLabels returns a getter for a single policy with the given labels.
+func policyWithLabels(labels map[string]string) func() ([]metav1.Object, error) {
+	return func() ([]metav1.Object, error) {
+		return []metav1.Object{&metav1.ObjectMeta{Name: "test-tier.test-np", Labels: labels}}, nil
+	}
+}
+
+// denyAllNp returns an authorizer that denies all access to the tier for the given NP verbs.
+func denyAllNp(t *testing.T, verbs ...string) *testAuth {
+	ta := &testAuth{t, map[string]k8sauth.Decision{
+		getAttributesMapkey(getTierAttr): k8sauth.DecisionDeny,
+	}}
+	for _, verb := range verbs {
+		ta.lookup[getAttributesMapkey(createNpAttr(verb))] = k8sauth.DecisionDeny
+		ta.lookup[getAttributesMapkey(createNpTierAttr(verb))] = k8sauth.DecisionDeny
+	}
+	return ta
+}
+
+func TestNetworkPolicyTierDelegation(t *testing.T) {
+	ta := denyAllNp(t, "create", "update", "list")
+	a := authorizer.NewTierAuthorizerWithDelegations(ta, delegatedTier(calico.TierDelegation{
+		Groups:         []string{"group2"},
+		Namespaces:     []string{"test-namespace"},
+		PolicySelector: "owner == 'team-a'",
+	}))
+
+	if err := a.AuthorizeTieredPolicyOperation(
+		createNpContext("create"), "test-tier.test-np", "test-tier",
+		policyWithLabels(map[string]string{"owner": "team-a"}),
+	); err != nil {
+		t.Fatalf("Error creating NP permitted by tier delegation: %v", err)
+	}
+
+	// The policy must match the delegation's selector.
+	ctx := audit.WithAuditContext(createNpContext("create"))
+	if err := a.AuthorizeTieredPolicyOperation(
+		ctx, "test-tier.test-np", "test-tier",
+		policyWithLabels(map[string]string{"owner": "team-b"}),
+	); err == nil {
+		t.Fatalf("No error returned creating NP not matching the delegation's policy selector")
+	} else if err.Error() != createNpError("create", true) {
+		t.Fatalf("Incorrect error message creating NP not matching the delegation's policy selector: %v", err)
+	}
+	annotations := audit.AuditEventFrom(ctx).Annotations
+	if annotations[authorizer.AuditDecisionAnnotation] != "forbid" {
+		t.Fatalf("Missing decision audit annotation: %v", annotations)
+	}
+	if annotations[authorizer.AuditReasonAnnotation] == "" {
+		t.Fatalf("Missing reason audit annotation: %v", annotations)
+	}
+
+	// For an update, both the old and the new policy must match.
+	moved := func() ([]metav1.Object, error) {
+		return []metav1.Object{
+			&metav1.ObjectMeta{Labels: map[string]string{"owner": "team-a"}},
+			&metav1.ObjectMeta{Labels: map[string]string{"owner": "team-b"}},
+		}, nil
+	}
+	if err := a.AuthorizeTieredPolicyOperation(
+		createNpContext("update"), "test-tier.test-np", "test-tier", moved,
+	); err == nil {
+		t.Fatalf("No error returned updating NP to no longer match the delegation's policy selector")
+	}
+
+	// Listing still requires access to the tier.
+	if err := a.AuthorizeTieredPolicyOperation(
+		createNpContext("list"), "", "test-tier",
+		func() ([]metav1.Object, error) {
+			t.Fatalf("Unexpected policy lookup when listing NPs")
+			return nil, nil
+		},
+	); err == nil {
+		t.Fatalf("No error returned listing NP with only a tier delegation")
+	}
+}
+
+func TestNetworkPolicyTierDelegationNotApplicable(t *testing.T) {
+	ta := denyAllNp(t, "get")
+	noLookup := func() ([]metav1.Object, error) {
+		t.Fatalf("Unexpected policy lookup for a delegation that does not apply")
+		return nil, nil
+	}
+
+	for _, d := range []calico.TierDelegation{
+		// Another namespace.
+		{Groups: []string{"group1"}, Namespaces: []string{"other-namespace"}},
+		// Another user and group.
+		{Users: []string{"otheruser"}, Groups: []string{"group3"}},
+	} {
+		a := authorizer.NewTierAuthorizerWithDelegations(ta, delegatedTier(d))
+		if err := a.AuthorizeTieredPolicyOperation(
+			createNpContext("get"), "test-tier.test-np", "test-tier", noLookup,
+		); err == nil {
+			t.Fatalf("No error returned getting NP with a delegation that does not apply: %+v", d)
+		} else if err.Error() != createNpError("get", true) {
+			t.Fatalf("Incorrect error message getting NP with a delegation that does not apply: %v", err)
+		}
+	}
+
+	// Without delegations, the operation is authorized by tier alone.
+	if err := authorizer.NewTierAuthorizer(ta).AuthorizeTieredPolicyOperation(
+		createNpContext("get"), "test-tier.test-np", "test-tier", noLookup,
+	); err == nil {
+		t.Fatalf("No error returned getting NP without tier access")
+	}
+}
+
+func TestGlobalNetworkPolicyTierDelegation(t *testing.T) {
+	ta := &testAuth{t, map[string]k8sauth.Decision{
+		getAttributesMapkey(getTierAttr):                 k8sauth.DecisionDeny,
+		getAttributesMapkey(createGnpAttr("delete")):     k8sauth.DecisionDeny,
+		getAttributesMapkey(createGnpTierAttr("delete")): k8sauth.DecisionDeny,
+	}}
+
+	// A delegation without namespaces applies to global policies.
+	a := authorizer.NewTierAuthorizerWithDelegations(ta, delegatedTier(calico.TierDelegation{
+		Users: []string{"testuser"},
+	}))
+	if err := a.AuthorizeTieredPolicyOperation(
+		createGnpContext("delete"), "test-tier.test-gnp", "test-tier", nil,
+	); err != nil {
+		t.Fatalf("Error deleting GNP permitted by tier delegation: %v", err)
+	}
+
+	// A delegation restricted to namespaces does not.
+	a = authorizer.NewTierAuthorizerWithDelegations(ta, delegatedTier(calico.TierDelegation{
+		Users:      []string{"testuser"},
+		Namespaces: []string{"test-namespace"},
+	}))
+	if err := a.AuthorizeTieredPolicyOperation(
+		createGnpContext("delete"), "test-tier.test-gnp", "test-tier", nil,
+	); err == nil {
+		t.Fatalf("No error returned deleting GNP with a namespaced tier delegation")
+	} else if err.Error() != createGnpError("delete", true) {
+		t.Fatalf("Incorrect error message deleting GNP with a namespaced tier delegation: %v", err)
+	}
+
+	// Errors looking up the policy are returned.
+	a = authorizer.NewTierAuthorizerWithDelegations(ta, delegatedTier(calico.TierDelegation{
+		Users:          []string{"testuser"},
+		PolicySelector: "has(owner)",
+	}))
+	lookupErr := errors.New("lookup failed")
+	if err := a.AuthorizeTieredPolicyOperation(
+		createGnpContext("delete"), "test-tier.test-gnp", "test-tier",
+		func() ([]metav1.Object, error) { return nil, lookupErr },
+	); !errors.Is(err, lookupErr) {
+		t.Fatalf("Expected policy lookup error, got: %v", err)
+	}
+}
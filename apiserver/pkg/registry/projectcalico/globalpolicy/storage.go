@@ -103,7 +103,7 @@ func NewREST(scheme *runtime.Scheme, opts server.Options, calicoResourceLister r
 		DestroyFunc: dFunc,
 	}
 
-	return &REST{store, calicoResourceLister, authorizer.NewTierAuthorizer(opts.Authorizer), opts.ShortNames}, nil
+	return &REST{store, calicoResourceLister, authorizer.NewTierAuthorizerWithDelegations(opts.Authorizer, calicoResourceLister), opts.ShortNames}, nil
 }
 
 func (r *REST) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
@@ -119,7 +119,7 @@ func (r *REST) Create(ctx context.Context, obj runtime.Object, val rest.Validate
 	policy := obj.(*calico.GlobalNetworkPolicy)
 	// Is Tier prepended. If not prepend default?
 	tierName, _ := util.GetTierFromPolicyName(policy.Name)
-	err := r.authorizer.AuthorizeTierOperation(ctx, policy.Name, tierName)
+	err := r.authorizer.AuthorizeTieredPolicyOperation(ctx, policy.Name, tierName, util.NewPolicy(obj))
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +130,7 @@ func (r *REST) Create(ctx context.Context, obj runtime.Object, val rest.Validate
 func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc,
 	updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
 	tierName, _ := util.GetTierFromPolicyName(name)
-	err := r.authorizer.AuthorizeTierOperation(ctx, name, tierName)
+	err := r.authorizer.AuthorizeTieredPolicyOperation(ctx, name, tierName, util.UpdatedPolicies(ctx, r.Store, name, objInfo))
 	if err != nil {
 		return nil, false, err
 	}
@@ -141,7 +141,7 @@ func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObje
 // Get retrieves the item from storage.
 func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
 	tierName, _ := util.GetTierFromPolicyName(name)
-	err := r.authorizer.AuthorizeTierOperation(ctx, name, tierName)
+	err := r.authorizer.AuthorizeTieredPolicyOperation(ctx, name, tierName, util.StoredPolicy(ctx, r.Store, name))
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +151,7 @@ func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions)
 
 func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
 	tierName, _ := util.GetTierFromPolicyName(name)
-	err := r.authorizer.AuthorizeTierOperation(ctx, name, tierName)
+	err := r.authorizer.AuthorizeTieredPolicyOperation(ctx, name, tierName, util.StoredPolicy(ctx, r.Store, name))
 	if err != nil {
 		return nil, false, err
 	}
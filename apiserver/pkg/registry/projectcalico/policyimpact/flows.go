@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyimpact
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+// flowQueryTimeout bounds how long a preview waits for flows.
+const flowQueryTimeout = 10 * time.Second
+
+// flowLister returns the aggregated flows matching a request.
+type flowLister interface {
+	List(ctx context.Context, req *proto.FlowRequest) ([]*proto.Flow, error)
+}
+
+// goldmaneFlowLister queries flows from goldmane's flow API.
+type goldmaneFlowLister struct {
+	client proto.FlowAPIClient
+}
+
+func newGoldmaneFlowLister(address string) (*goldmaneFlowLister, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &goldmaneFlowLister{client: proto.NewFlowAPIClient(conn)}, nil
+}
+
+func (g *goldmaneFlowLister) List(ctx context.Context, req *proto.FlowRequest) ([]*proto.Flow, error) {
+	ctx, cancel := context.WithTimeout(ctx, flowQueryTimeout)
+	defer cancel()
+
+	stream, err := g.client.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var flows []*proto.Flow
+	for {
+		f, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return flows, nil
+		} else if err != nil {
+			return nil, err
+		}
+		flows = append(flows, f)
+	}
+}
@@ -0,0 +1,491 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyimpact
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+	validator "github.com/projectcalico/calico/libcalico-go/lib/validator/v3"
+)
+
+// Endpoint types used in flow logs.
+const (
+	flowTypeWorkload   = "wep"
+	flowTypeHost       = "hep"
+	flowTypeNetworkSet = "ns"
+)
+
+// matchResult is the outcome of matching a rule, or part of a rule, against a flow.  Flow logs
+// don't record everything that a rule can match on, so a match may be indeterminate.
+type matchResult int
+
+const (
+	noMatch matchResult = iota
+	match
+	indeterminate
+)
+
+func (m matchResult) and(o matchResult) matchResult {
+	if m == noMatch || o == noMatch {
+		return noMatch
+	}
+	if m == indeterminate || o == indeterminate {
+		return indeterminate
+	}
+	return match
+}
+
+// convertCandidate validates the candidate policy in the spec and converts it to the backend
+// model used by Felix, so that selectors and rules are evaluated exactly as they would be once
+// the policy is created.
+func convertCandidate(spec calico.PolicyImpactSpec) (*model.Policy, error) {
+	var kvp *model.KVPair
+	var processor interface {
+		Process(*model.KVPair) ([]*model.KVPair, error)
+	}
+	switch {
+	case spec.NetworkPolicy != nil && spec.GlobalNetworkPolicy != nil:
+		return nil, errors.New("only one of networkPolicy and globalNetworkPolicy may be specified")
+	case spec.NetworkPolicy != nil:
+		np := spec.NetworkPolicy.DeepCopy()
+		if np.Name == "" || np.Namespace == "" {
+			return nil, errors.New("networkPolicy must have a name and namespace")
+		}
+		defaultPolicyTypes(np.Spec.Ingress, np.Spec.Egress, &np.Spec.Types)
+		if err := validator.Validate(np); err != nil {
+			return nil, err
+		}
+		kvp = &model.KVPair{
+			Key:   model.ResourceKey{Kind: calico.KindNetworkPolicy, Name: np.Name, Namespace: np.Namespace},
+			Value: np,
+		}
+		processor = updateprocessors.NewNetworkPolicyUpdateProcessor()
+	case spec.GlobalNetworkPolicy != nil:
+		gnp := spec.GlobalNetworkPolicy.DeepCopy()
+		if gnp.Name == "" {
+			return nil, errors.New("globalNetworkPolicy must have a name")
+		}
+		defaultPolicyTypes(gnp.Spec.Ingress, gnp.Spec.Egress, &gnp.Spec.Types)
+		if err := validator.Validate(gnp); err != nil {
+			return nil, err
+		}
+		kvp = &model.KVPair{
+			Key:   model.ResourceKey{Kind: calico.KindGlobalNetworkPolicy, Name: gnp.Name},
+			Value: gnp,
+		}
+		processor = updateprocessors.NewGlobalNetworkPolicyUpdateProcessor()
+	default:
+		return nil, errors.New("one of networkPolicy and globalNetworkPolicy must be specified")
+	}
+
+	kvps, err := processor.Process(kvp)
+	if err != nil {
+		return nil, err
+	}
+	if len(kvps) != 1 || kvps[0].Value == nil {
+		return nil, errors.New("unable to convert the candidate policy")
+	}
+	return kvps[0].Value.(*model.Policy), nil
+}
+
+// defaultPolicyTypes defaults the policy types in the same way as the v3 client does when a
+// policy is created.
+func defaultPolicyTypes(ingress, egress []calico.Rule, types *[]calico.PolicyType) {
+	if len(*types) != 0 {
+		return
+	}
+	switch {
+	case len(egress) == 0:
+		*types = []calico.PolicyType{calico.PolicyTypeIngress}
+	case len(ingress) == 0:
+		*types = []calico.PolicyType{calico.PolicyTypeEgress}
+	default:
+		*types = []calico.PolicyType{calico.PolicyTypeIngress, calico.PolicyTypeEgress}
+	}
+}
+
+// evaluator evaluates a converted candidate policy against endpoints and flows.
+type evaluator struct {
+	policy   *model.Policy
+	selector selector.Selector
+	ingress  bool
+	egress   bool
+
+	// profileLabels maps profile name to the labels that the profile applies to its endpoints.
+	profileLabels map[string]map[string]string
+
+	// selectors caches the parsed rule selectors.
+	selectors map[string]selector.Selector
+}
+
+func newEvaluator(policy *model.Policy, profiles []calico.Profile) (*evaluator, error) {
+	sel, err := selector.Parse(policy.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy selector %q: %w", policy.Selector, err)
+	}
+	e := &evaluator{
+		policy:        policy,
+		selector:      sel,
+		profileLabels: map[string]map[string]string{},
+		selectors:     map[string]selector.Selector{},
+	}
+	for _, t := range policy.Types {
+		switch t {
+		case "ingress":
+			e.ingress = true
+		case "egress":
+			e.egress = true
+		}
+	}
+	for _, p := range profiles {
+		e.profileLabels[p.Name] = p.Spec.LabelsToApply
+	}
+	return e, nil
+}
+
+// appliesToWorkloads returns false for pre-DNAT and untracked policies, which only apply to host
+// endpoints.
+func (e *evaluator) appliesToWorkloads() bool {
+	return !e.policy.PreDNAT && !e.policy.DoNotTrack
+}
+
+// endpointLabels returns the labels of an endpoint including those inherited from its profiles.
+// The endpoint's own labels take precedence, followed by its profiles in order.
+func (e *evaluator) endpointLabels(labels map[string]string, profiles []string) map[string]string {
+	merged := map[string]string{}
+	for i := len(profiles) - 1; i >= 0; i-- {
+		for k, v := range e.profileLabels[profiles[i]] {
+			merged[k] = v
+		}
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// selectedEndpoints returns the endpoints selected by the candidate policy, sorted by kind,
+// namespace and name.
+func (e *evaluator) selectedEndpoints(weps []libapi.WorkloadEndpoint, heps []calico.HostEndpoint) []calico.PolicyImpactEndpoint {
+	var selected []calico.PolicyImpactEndpoint
+	if !e.appliesToWorkloads() {
+		weps = nil
+	}
+	for _, wep := range weps {
+		if !e.selector.Evaluate(e.endpointLabels(wep.Labels, wep.Spec.Profiles)) {
+			continue
+		}
+		workload := wep.Spec.Pod
+		if workload == "" {
+			workload = wep.Spec.Workload
+		}
+		selected = append(selected, calico.PolicyImpactEndpoint{
+			Kind:      libapi.KindWorkloadEndpoint,
+			Name:      wep.Name,
+			Namespace: wep.Namespace,
+			Node:      wep.Spec.Node,
+			Workload:  workload,
+		})
+	}
+	for _, hep := range heps {
+		if !e.selector.Evaluate(e.endpointLabels(hep.Labels, hep.Spec.Profiles)) {
+			continue
+		}
+		selected = append(selected, calico.PolicyImpactEndpoint{
+			Kind: calico.KindHostEndpoint,
+			Name: hep.Name,
+			Node: hep.Spec.Node,
+		})
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		a, b := selected[i], selected[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return selected
+}
+
+// flowPeer is one end of a flow together with the labels that selectors are evaluated against.
+type flowPeer struct {
+	labels map[string]string
+
+	// labelled is true if the peer is an endpoint or network set, i.e. something that selectors
+	// can match.  Public and private networks are never matched by selectors.
+	labelled bool
+}
+
+func (e *evaluator) flowPeer(typ, namespace string, labels []string) flowPeer {
+	switch typ {
+	case flowTypeWorkload, flowTypeHost, flowTypeNetworkSet:
+	default:
+		return flowPeer{}
+	}
+	own := map[string]string{}
+	for _, l := range labels {
+		k, v, _ := strings.Cut(l, "=")
+		own[k] = v
+	}
+	var profiles []string
+	if namespace != "" {
+		own[calico.LabelNamespace] = namespace
+		profiles = append(profiles, conversion.NamespaceProfileNamePrefix+namespace)
+	}
+	if typ == flowTypeWorkload {
+		own[calico.LabelOrchestrator] = calico.OrchestratorKubernetes
+	}
+	return flowPeer{labels: e.endpointLabels(own, profiles), labelled: true}
+}
+
+// evaluateFlow determines whether the candidate policy would deny a flow that is currently
+// allowed.  It returns the denied flow, or nil if the policy would not deny it.  The second
+// return value is true if the outcome could not be determined from the flow log.
+func (e *evaluator) evaluateFlow(f *proto.Flow) (*calico.PolicyImpactFlow, bool) {
+	k := f.Key
+	if k == nil || !strings.EqualFold(k.Action, "allow") {
+		return nil, false
+	}
+
+	src := e.flowPeer(k.SourceType, k.SourceNamespace, f.SourceLabels)
+	dst := e.flowPeer(k.DestType, k.DestNamespace, f.DestLabels)
+
+	// The policy applies at the end of the flow that reported it: ingress rules are evaluated for
+	// flows reported by the destination and egress rules for flows reported by the source.
+	var direction calico.PolicyType
+	var local flowPeer
+	var localType string
+	var rules []model.Rule
+	switch k.Reporter {
+	case "dst":
+		direction, local, localType, rules = calico.PolicyTypeIngress, dst, k.DestType, e.policy.InboundRules
+		if !e.ingress {
+			return nil, false
+		}
+	case "src":
+		direction, local, localType, rules = calico.PolicyTypeEgress, src, k.SourceType, e.policy.OutboundRules
+		if !e.egress {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+	if localType != flowTypeHost && (localType != flowTypeWorkload || !e.appliesToWorkloads()) {
+		return nil, false
+	}
+	if !e.selector.Evaluate(local.labels) {
+		return nil, false
+	}
+
+	denied := func(reason string, ruleIndex *int) *calico.PolicyImpactFlow {
+		return &calico.PolicyImpactFlow{
+			Direction:             direction,
+			SourceName:            k.SourceName,
+			SourceNamespace:       k.SourceNamespace,
+			SourceType:            k.SourceType,
+			DestName:              k.DestName,
+			DestNamespace:         k.DestNamespace,
+			DestType:              k.DestType,
+			DestPort:              k.DestPort,
+			Protocol:              k.Proto,
+			Reason:                reason,
+			RuleIndex:             ruleIndex,
+			NumConnectionsStarted: f.NumConnectionsStarted,
+		}
+	}
+
+	for i := range rules {
+		r := &rules[i]
+		switch e.ruleMatches(r, src, dst, k.Proto, k.DestPort) {
+		case noMatch:
+			continue
+		case indeterminate:
+			return nil, true
+		}
+		switch r.Action {
+		case "allow", "next-tier":
+			return nil, false
+		case "deny", "reject":
+			idx := i
+			return denied(calico.PolicyImpactReasonDenyRule, &idx), false
+		}
+		// Log rules don't terminate evaluation.
+	}
+	return denied(calico.PolicyImpactReasonNoMatchingRule, nil), false
+}
+
+// ruleMatches matches a rule against the fields recorded in a flow log.
+func (e *evaluator) ruleMatches(r *model.Rule, src, dst flowPeer, proto string, port int64) matchResult {
+	result := protocolMatches(r.Protocol, r.NotProtocol, proto).
+		and(e.selectorMatches(r.SrcSelector, r.NotSrcSelector, src)).
+		and(e.selectorMatches(r.DstSelector, r.NotDstSelector, dst)).
+		and(portsMatch(r.DstPorts, r.NotDstPorts, port))
+
+	// Flow logs don't record addresses, source ports, ICMP types, domains or HTTP attributes, and
+	// service matches depend on the service's endpoints rather than on how the flow was addressed.
+	// A mismatch on the fields above still rules the rule out.
+	if r.IPVersion != nil ||
+		r.SrcNet != nil || len(r.SrcNets) > 0 || r.NotSrcNet != nil || len(r.NotSrcNets) > 0 ||
+		r.DstNet != nil || len(r.DstNets) > 0 || r.NotDstNet != nil || len(r.NotDstNets) > 0 ||
+		len(r.SrcPorts) > 0 || len(r.NotSrcPorts) > 0 ||
+		r.ICMPType != nil || r.ICMPCode != nil || r.NotICMPType != nil || r.NotICMPCode != nil ||
+		len(r.ICMPTypes) > 0 || len(r.NotICMPTypes) > 0 ||
+		r.SrcService != "" || r.DstService != "" || len(r.DstDomains) > 0 || r.HTTPMatch != nil {
+		result = result.and(indeterminate)
+	}
+	return result
+}
+
+func (e *evaluator) selectorMatches(sel, notSel string, peer flowPeer) matchResult {
+	result := match
+	if sel != "" {
+		if !peer.labelled {
+			return noMatch
+		}
+		result = result.and(e.evaluateSelector(sel, peer.labels, false))
+	}
+	if notSel != "" && peer.labelled {
+		result = result.and(e.evaluateSelector(notSel, peer.labels, true))
+	}
+	return result
+}
+
+func (e *evaluator) evaluateSelector(sel string, labels map[string]string, negate bool) matchResult {
+	parsed, ok := e.selectors[sel]
+	if !ok {
+		var err error
+		parsed, err = selector.Parse(sel)
+		if err != nil {
+			log.WithError(err).WithField("selector", sel).Warn("Unable to parse rule selector")
+			return indeterminate
+		}
+		e.selectors[sel] = parsed
+	}
+	if parsed.Evaluate(labels) != negate {
+		return match
+	}
+	return noMatch
+}
+
+func protocolMatches(p, notP *numorstring.Protocol, flowProto string) matchResult {
+	if p == nil && notP == nil {
+		return match
+	}
+	got, ok := protocolNumber(flowProto)
+	if !ok {
+		return indeterminate
+	}
+	if p != nil {
+		want, ok := ruleProtocolNumber(*p)
+		if !ok {
+			return indeterminate
+		}
+		if want != got {
+			return noMatch
+		}
+	}
+	if notP != nil {
+		notWant, ok := ruleProtocolNumber(*notP)
+		if !ok {
+			return indeterminate
+		}
+		if notWant == got {
+			return noMatch
+		}
+	}
+	return match
+}
+
+var protocolNumbers = map[string]uint8{
+	"icmp":    1,
+	"tcp":     6,
+	"udp":     17,
+	"icmpv6":  58,
+	"sctp":    132,
+	"udplite": 136,
+}
+
+func protocolNumber(s string) (uint8, bool) {
+	if n, err := strconv.ParseUint(s, 10, 8); err == nil {
+		return uint8(n), true
+	}
+	n, ok := protocolNumbers[strings.ToLower(s)]
+	return n, ok
+}
+
+func ruleProtocolNumber(p numorstring.Protocol) (uint8, bool) {
+	if p.Type == numorstring.NumOrStringNum {
+		return p.NumVal, true
+	}
+	return protocolNumber(p.StrVal)
+}
+
+func portsMatch(ports, notPorts []numorstring.Port, port int64) matchResult {
+	if len(ports) == 0 && len(notPorts) == 0 {
+		return match
+	}
+	if port == 0 {
+		return indeterminate
+	}
+	result := match
+	if len(ports) > 0 {
+		result = portInRanges(ports, port)
+	}
+	if len(notPorts) > 0 {
+		switch portInRanges(notPorts, port) {
+		case match:
+			return noMatch
+		case indeterminate:
+			result = result.and(indeterminate)
+		}
+	}
+	return result
+}
+
+// portInRanges returns whether the port is in one of the numeric ranges.  Named ports can't be
+// resolved from a flow log so they make a miss indeterminate.
+func portInRanges(ports []numorstring.Port, port int64) matchResult {
+	named := false
+	for _, p := range ports {
+		if p.PortName != "" {
+			named = true
+			continue
+		}
+		if port >= int64(p.MinPort) && port <= int64(p.MaxPort) {
+			return match
+		}
+	}
+	if named {
+		return indeterminate
+	}
+	return noMatch
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+package policyimpact
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+)
+
+type fakeFlowLister struct {
+	flows []*proto.Flow
+	err   error
+}
+
+func (f *fakeFlowLister) List(ctx context.Context, req *proto.FlowRequest) ([]*proto.Flow, error) {
+	return f.flows, f.err
+}
+
+var (
+	tcp = numorstring.ProtocolFromString("TCP")
+
+	// dbPolicy allows the api pods to reach the database on 5432, denies ssh from anywhere and allows
+	// 8080 from a private network.
+	dbPolicy = &calico.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "prod"},
+		Spec: calico.NetworkPolicySpec{
+			Selector: "app == 'db'",
+			Ingress: []calico.Rule{
+				{
+					Action:      calico.Allow,
+					Protocol:    &tcp,
+					Source:      calico.EntityRule{Selector: "app == 'api'"},
+					Destination: calico.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(5432)}},
+				},
+				{
+					Action:      calico.Deny,
+					Protocol:    &tcp,
+					Destination: calico.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(22)}},
+				},
+				{
+					Action:      calico.Allow,
+					Protocol:    &tcp,
+					Source:      calico.EntityRule{Nets: []string{"10.0.0.0/8"}},
+					Destination: calico.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(8080)}},
+				},
+			},
+		},
+	}
+
+	profiles = []calico.Profile{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "kns.prod"},
+			Spec:       calico.ProfileSpec{LabelsToApply: map[string]string{"pcns.team": "payments"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "kns.dev"},
+			Spec:       calico.ProfileSpec{LabelsToApply: map[string]string{"pcns.team": "dev"}},
+		},
+	}
+)
+
+func wep(namespace, name, app string) libapi.WorkloadEndpoint {
+	return libapi.WorkloadEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":                    app,
+				calico.LabelNamespace:    namespace,
+				calico.LabelOrchestrator: calico.OrchestratorKubernetes,
+			},
+		},
+		Spec: libapi.WorkloadEndpointSpec{
+			Node:     "node1",
+			Pod:      name,
+			Profiles: []string{"kns." + namespace},
+		},
+	}
+}
+
+func flow(reporter, action, srcApp, dstApp string, port int64) *proto.Flow {
+	return &proto.Flow{
+		Key: &proto.FlowKey{
+			SourceName:      srcApp + "-*",
+			SourceNamespace: "prod",
+			SourceType:      "wep",
+			DestName:        dstApp + "-*",
+			DestNamespace:   "prod",
+			DestType:        "wep",
+			DestPort:        port,
+			Proto:           "tcp",
+			Reporter:        reporter,
+			Action:          action,
+		},
+		SourceLabels:          []string{"app=" + srcApp},
+		DestLabels:            []string{"app=" + dstApp},
+		NumConnectionsStarted: 3,
+	}
+}
+
+func TestConvertCandidateErrors(t *testing.T) {
+	for name, spec := range map[string]calico.PolicyImpactSpec{
+		"no policy":    {},
+		"two policies": {NetworkPolicy: dbPolicy, GlobalNetworkPolicy: &calico.GlobalNetworkPolicy{}},
+		"no namespace": {NetworkPolicy: &calico.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "np"}}},
+		"no name":      {GlobalNetworkPolicy: &calico.GlobalNetworkPolicy{}},
+		"bad selector": {GlobalNetworkPolicy: &calico.GlobalNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "gnp"},
+			Spec:       calico.GlobalNetworkPolicySpec{Selector: "app =="},
+		}},
+	} {
+		if _, err := convertCandidate(spec); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestSelectedEndpoints(t *testing.T) {
+	weps := []libapi.WorkloadEndpoint{
+		wep("prod", "db-0", "db"),
+		wep("prod", "api-0", "api"),
+		wep("dev", "db-0", "db"),
+	}
+	heps := []calico.HostEndpoint{{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1-eth0", Labels: map[string]string{"app": "db"}},
+		Spec:       calico.HostEndpointSpec{Node: "node1"},
+	}}
+
+	// A namespaced policy only selects workloads in its own namespace.
+	policy, err := convertCandidate(calico.PolicyImpactSpec{NetworkPolicy: dbPolicy})
+	if err != nil {
+		t.Fatalf("Failed to convert policy: %v", err)
+	}
+	e, err := newEvaluator(policy, profiles)
+	if err != nil {
+		t.Fatalf("Failed to create evaluator: %v", err)
+	}
+	selected := e.selectedEndpoints(weps, heps)
+	if len(selected) != 1 || selected[0].Namespace != "prod" || selected[0].Name != "db-0" || selected[0].Workload != "db-0" {
+		t.Fatalf("Unexpected endpoints: %+v", selected)
+	}
+
+	// A global policy's namespace selector is evaluated against the labels inherited from the
+	// namespace profile, and host endpoints are included.
+	policy, err = convertCandidate(calico.PolicyImpactSpec{GlobalNetworkPolicy: &calico.GlobalNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gnp"},
+		Spec:       calico.GlobalNetworkPolicySpec{Selector: "app == 'db'", NamespaceSelector: "team == 'dev'"},
+	}})
+	if err != nil {
+		t.Fatalf("Failed to convert policy: %v", err)
+	}
+	e, _ = newEvaluator(policy, profiles)
+	selected = e.selectedEndpoints(weps, heps)
+	if len(selected) != 1 || selected[0].Namespace != "dev" {
+		t.Fatalf("Unexpected endpoints: %+v", selected)
+	}
+
+	policy, _ = convertCandidate(calico.PolicyImpactSpec{GlobalNetworkPolicy: &calico.GlobalNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gnp"},
+		Spec:       calico.GlobalNetworkPolicySpec{Selector: "app == 'db'"},
+	}})
+	e, _ = newEvaluator(policy, profiles)
+	selected = e.selectedEndpoints(weps, heps)
+	if len(selected) != 3 || selected[0].Kind != calico.KindHostEndpoint {
+		t.Fatalf("Unexpected endpoints: %+v", selected)
+	}
+}
+
+func TestEvaluateFlows(t *testing.T) {
+	policy, err := convertCandidate(calico.PolicyImpactSpec{NetworkPolicy: dbPolicy})
+	if err != nil {
+		t.Fatalf("Failed to convert policy: %v", err)
+	}
+	e, _ := newEvaluator(policy, profiles)
+
+	external := flow("dst", "Allow", "", "db", 8080)
+	external.Key.SourceType = "pvt"
+	external.Key.SourceNamespace = ""
+	external.SourceLabels = nil
+
+	lister := &fakeFlowLister{flows: []*proto.Flow{
+		// Allowed by the first rule.
+		flow("dst", "Allow", "api", "db", 5432),
+		// Matches no rule.
+		flow("dst", "Allow", "web", "db", 5432),
+		// Denied by the second rule.
+		flow("dst", "Allow", "api", "db", 22),
+		// Already denied.
+		flow("dst", "Deny", "web", "db", 80),
+		// The policy has no egress rules.
+		flow("src", "Allow", "db", "web", 80),
+		// Not selected by the policy.
+		flow("dst", "Allow", "web", "api", 80),
+		// Would be decided by the rule with nets.
+		external,
+	}}
+
+	r := &REST{flows: lister}
+	status := calico.PolicyImpactStatus{}
+	r.evaluateFlows(context.Background(), e, calico.PolicyImpactSpec{}, &status)
+
+	if status.FlowsUnavailable != "" {
+		t.Fatalf("Unexpected flow error: %s", status.FlowsUnavailable)
+	}
+	if status.IndeterminateFlows != 1 {
+		t.Errorf("Expected 1 indeterminate flow, got %d", status.IndeterminateFlows)
+	}
+	if len(status.DeniedFlows) != 2 {
+		t.Fatalf("Expected 2 denied flows, got %+v", status.DeniedFlows)
+	}
+	noMatch, denyRule := status.DeniedFlows[0], status.DeniedFlows[1]
+	if noMatch.SourceName != "web-*" || noMatch.Reason != calico.PolicyImpactReasonNoMatchingRule || noMatch.RuleIndex != nil {
+		t.Errorf("Unexpected denied flow: %+v", noMatch)
+	}
+	if denyRule.DestPort != 22 || denyRule.Reason != calico.PolicyImpactReasonDenyRule ||
+		denyRule.RuleIndex == nil || *denyRule.RuleIndex != 1 || denyRule.Direction != calico.PolicyTypeIngress {
+		t.Errorf("Unexpected denied flow: %+v", denyRule)
+	}
+
+	// A failure to read flows is reported in the status.
+	r = &REST{flows: &fakeFlowLister{err: errors.New("unavailable")}}
+	status = calico.PolicyImpactStatus{}
+	r.evaluateFlows(context.Background(), e, calico.PolicyImpactSpec{}, &status)
+	if status.FlowsUnavailable == "" || status.DeniedFlows != nil {
+		t.Errorf("Expected flows to be unavailable, got %+v", status)
+	}
+
+	r = &REST{}
+	r.evaluateFlows(context.Background(), e, calico.PolicyImpactSpec{}, &status)
+	if status.FlowsUnavailable == "" {
+		t.Errorf("Expected flows to be unavailable without a goldmane address")
+	}
+}
+
+func TestPortsMatch(t *testing.T) {
+	named := numorstring.NamedPort("http")
+	for _, tc := range []struct {
+		ports, notPorts []numorstring.Port
+		port            int64
+		expected        matchResult
+	}{
+		{nil, nil, 80, match},
+		{[]numorstring.Port{numorstring.SinglePort(80)}, nil, 80, match},
+		{[]numorstring.Port{numorstring.SinglePort(80)}, nil, 81, noMatch},
+		{[]numorstring.Port{{MinPort: 1000, MaxPort: 2000}}, nil, 1500, match},
+		{[]numorstring.Port{named}, nil, 80, indeterminate},
+		{[]numorstring.Port{named, numorstring.SinglePort(80)}, nil, 80, match},
+		{nil, []numorstring.Port{numorstring.SinglePort(80)}, 80, noMatch},
+		{nil, []numorstring.Port{numorstring.SinglePort(80)}, 81, match},
+		{nil, []numorstring.Port{named}, 81, indeterminate},
+		{[]numorstring.Port{numorstring.SinglePort(80)}, nil, 0, indeterminate},
+	} {
+		if actual := portsMatch(tc.ports, tc.notPorts, tc.port); actual != tc.expected {
+			t.Errorf("portsMatch(%v, %v, %d) = %v, expected %v", tc.ports, tc.notPorts, tc.port, actual, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyimpact
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	calicostorage "github.com/projectcalico/calico/apiserver/pkg/storage/calico"
+	"github.com/projectcalico/calico/goldmane/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// REST implements a create-only RESTStorage for PolicyImpacts.  Nothing is stored: each create
+// evaluates the candidate policy and returns the result in the status.
+//
+// Note that a PolicyImpact reveals the endpoints and flows selected by the candidate policy, so
+// create access should only be granted to users who can already see them.
+type REST struct {
+	client clientv3.Interface
+	flows  flowLister
+}
+
+var (
+	_ rest.Creater              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// NewREST returns a RESTStorage object for PolicyImpacts.  Flows are read from goldmane at the
+// given address; if the address is empty, flows are not evaluated.
+func NewREST(goldmaneAddress string) (*REST, error) {
+	r := &REST{client: calicostorage.CreateClientFromConfig()}
+	if goldmaneAddress != "" {
+		flows, err := newGoldmaneFlowLister(goldmaneAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create goldmane client: %w", err)
+		}
+		r.flows = flows
+	}
+	return r, nil
+}
+
+func (r *REST) New() runtime.Object {
+	return &calico.PolicyImpact{}
+}
+
+func (r *REST) Destroy() {
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+func (r *REST) GetSingularName() string {
+	return "policyimpact"
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	impact, ok := obj.(*calico.PolicyImpact)
+	if !ok {
+		return nil, k8serrors.NewBadRequest(fmt.Sprintf("not a PolicyImpact: %#v", obj))
+	}
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	if w := impact.Spec.FlowWindowSeconds; w != nil && *w <= 0 {
+		return nil, k8serrors.NewBadRequest("flowWindowSeconds must be positive")
+	}
+	policy, err := convertCandidate(impact.Spec)
+	if err != nil {
+		return nil, k8serrors.NewBadRequest(fmt.Sprintf("invalid candidate policy: %v", err))
+	}
+
+	profiles, err := r.client.Profiles().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, k8serrors.NewInternalError(err)
+	}
+	e, err := newEvaluator(policy, profiles.Items)
+	if err != nil {
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+
+	// A namespaced policy can only select workload endpoints in its own namespace.
+	weps, err := r.client.WorkloadEndpoints().List(ctx, options.ListOptions{Namespace: policy.Namespace})
+	if err != nil {
+		return nil, k8serrors.NewInternalError(err)
+	}
+	var heps []calico.HostEndpoint
+	if policy.Namespace == "" {
+		hepList, err := r.client.HostEndpoints().List(ctx, options.ListOptions{})
+		if err != nil {
+			return nil, k8serrors.NewInternalError(err)
+		}
+		heps = hepList.Items
+	}
+
+	result := impact.DeepCopy()
+	result.Status = calico.PolicyImpactStatus{
+		Endpoints: e.selectedEndpoints(weps.Items, heps),
+	}
+	r.evaluateFlows(ctx, e, impact.Spec, &result.Status)
+	result.CreationTimestamp = metav1.Now()
+	return result, nil
+}
+
+// evaluateFlows evaluates the recent flows from goldmane against the candidate policy.  Failing to
+// read flows doesn't fail the request since the endpoint preview is still useful.
+func (r *REST) evaluateFlows(ctx context.Context, e *evaluator, spec calico.PolicyImpactSpec, status *calico.PolicyImpactStatus) {
+	if r.flows == nil {
+		status.FlowsUnavailable = "The API server is not configured with a goldmane address."
+		return
+	}
+	window := int64(calico.DefaultPolicyImpactFlowWindowSeconds)
+	if spec.FlowWindowSeconds != nil {
+		window = int64(*spec.FlowWindowSeconds)
+	}
+	flows, err := r.flows.List(ctx, &proto.FlowRequest{StartTimeGt: time.Now().Unix() - window})
+	if err != nil {
+		log.WithError(err).Warn("Failed to query flows for policy impact")
+		status.FlowsUnavailable = fmt.Sprintf("Failed to query flows from goldmane: %v", err)
+		return
+	}
+	for _, f := range flows {
+		denied, unknown := e.evaluateFlow(f)
+		if unknown {
+			status.IndeterminateFlows++
+		} else if denied != nil {
+			status.DeniedFlows = append(status.DeniedFlows, *denied)
+		}
+	}
+}
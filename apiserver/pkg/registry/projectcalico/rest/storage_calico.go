@@ -34,15 +34,19 @@ import (
 	calicognetworkset "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/globalnetworkset"
 	calicogpolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/globalpolicy"
 	calicohostendpoint "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/hostendpoint"
+	calicoipamblock "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ipamblock"
 	calicoipamconfig "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ipamconfig"
+	calicoipamhandle "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ipamhandle"
 	calicoippool "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ippool"
 	calicoipreservation "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ipreservation"
 	calicokubecontrollersconfig "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/kubecontrollersconfig"
 	calicopolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/networkpolicy"
 	caliconetworkset "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/networkset"
+	calicopolicyimpact "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/policyimpact"
 	calicoprofile "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/profile"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/server"
 	calicotier "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/tier"
+	calicoworkloadendpoint "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/workloadendpoint"
 	calicostorage "github.com/projectcalico/calico/apiserver/pkg/storage/calico"
 	"github.com/projectcalico/calico/apiserver/pkg/storage/etcd"
 )
@@ -51,6 +55,10 @@ import (
 // the calico API group. It implements (./pkg/apiserver).RESTStorageProvider
 type RESTStorageProvider struct {
 	StorageType server.StorageType
+
+	// GoldmaneAddress is the address of goldmane's flow API, used to evaluate flows when
+	// previewing policies.  Flows aren't evaluated if it is empty.
+	GoldmaneAddress string
 }
 
 // NewV3Storage constructs v3 api storage.
@@ -456,6 +464,72 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{"blockaffinity", "affinity", "affinities"},
 	)
 
+	ipamBlockRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("ipamblocks"), nil)
+	if err != nil {
+		return nil, err
+	}
+	ipamBlockOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   ipamBlockRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicoipamblock.EmptyObject(),
+			ScopeStrategy: calicoipamblock.NewStrategy(scheme),
+			NewListFunc:   calicoipamblock.NewList,
+			GetAttrsFunc:  calicoipamblock.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: ipamBlockRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"ipamblock"},
+	)
+
+	ipamHandleRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("ipamhandles"), nil)
+	if err != nil {
+		return nil, err
+	}
+	ipamHandleOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   ipamHandleRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicoipamhandle.EmptyObject(),
+			ScopeStrategy: calicoipamhandle.NewStrategy(scheme),
+			NewListFunc:   calicoipamhandle.NewList,
+			GetAttrsFunc:  calicoipamhandle.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: ipamHandleRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"ipamhandle"},
+	)
+
+	workloadEndpointRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("workloadendpoints"), nil)
+	if err != nil {
+		return nil, err
+	}
+	workloadEndpointOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   workloadEndpointRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicoworkloadendpoint.EmptyObject(),
+			ScopeStrategy: calicoworkloadendpoint.NewStrategy(scheme),
+			NewListFunc:   calicoworkloadendpoint.NewList,
+			GetAttrsFunc:  calicoworkloadendpoint.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: workloadEndpointRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"wep", "weps"},
+	)
+
 	storage := map[string]rest.Storage{}
 	storage["tiers"] = rESTInPeace(calicotier.NewREST(scheme, *tierOpts))
 	storage["networkpolicies"] = rESTInPeace(calicopolicy.NewREST(scheme, *policyOpts, calicoLister))
@@ -474,6 +548,10 @@ func (p RESTStorageProvider) NewV3Storage(
 	storage["caliconodestatuses"] = rESTInPeace(caliconodestatus.NewREST(scheme, *caliconodestatusOpts))
 	storage["ipamconfigurations"] = rESTInPeace(calicoipamconfig.NewREST(scheme, *ipamconfigOpts))
 	storage["blockaffinities"] = rESTInPeace(calicoblockaffinity.NewREST(scheme, *blockAffinityOpts))
+	storage["ipamblocks"] = rESTInPeace(calicoipamblock.NewREST(scheme, *ipamBlockOpts))
+	storage["ipamhandles"] = rESTInPeace(calicoipamhandle.NewREST(scheme, *ipamHandleOpts))
+	storage["workloadendpoints"] = rESTInPeace(calicoworkloadendpoint.NewREST(scheme, *workloadEndpointOpts))
+	storage["policyimpacts"] = rESTInPeace(calicopolicyimpact.NewREST(p.GoldmaneAddress))
 
 	kubeControllersConfigsStorage, kubeControllersConfigsStatusStorage, err := calicokubecontrollersconfig.NewREST(scheme, *kubeControllersConfigsOpts)
 	if err != nil {
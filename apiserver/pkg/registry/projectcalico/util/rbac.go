@@ -9,10 +9,14 @@ import (
 
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apiserver/pkg/endpoints/filters"
+	"k8s.io/apiserver/pkg/registry/rest"
 
 	"github.com/projectcalico/calico/apiserver/pkg/rbac"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/authorizer"
@@ -97,7 +101,7 @@ func getTiersFromSelector(options *metainternalversion.ListOptions) ([]string, e
 }
 
 // getAuthorizedTiers gets all the available Tiers to the user
-func getAuthorizedTiers(ctx context.Context, authorizer authorizer.TierAuthorizer, calicoResourceLister rbac.CalicoResourceLister) ([]string, error) {
+func getAuthorizedTiers(ctx context.Context, tierAuthorizer authorizer.TierAuthorizer, calicoResourceLister rbac.CalicoResourceLister) ([]string, error) {
 	tiers, err := calicoResourceLister.ListTiers()
 	if err != nil {
 		return nil, err
@@ -105,7 +109,7 @@ func getAuthorizedTiers(ctx context.Context, authorizer authorizer.TierAuthorize
 	var allowedTiers []string
 
 	for _, tier := range tiers {
-		err := authorizer.AuthorizeTierOperation(ctx, "", tier.Name)
+		err := tierAuthorizer.AuthorizeTierOperation(ctx, "", tier.Name)
 		if err == nil {
 			allowedTiers = append(allowedTiers, tier.Name)
 		}
@@ -116,17 +120,22 @@ func getAuthorizedTiers(ctx context.Context, authorizer authorizer.TierAuthorize
 		if err != nil {
 			return nil, err
 		}
-		return nil, errors.NewForbidden(v3.Resource(attributes.GetResource()), "", fmt.Errorf("Operation on Calico tiered policy is forbidden"))
+		reason := "Operation on Calico tiered policy is forbidden"
+		authorizer.AnnotateForbidden(ctx, reason)
+		return nil, errors.NewForbidden(v3.Resource(attributes.GetResource()), "", fmt.Errorf("%s", reason))
 	}
 
 	return allowedTiers, nil
 }
 
 // authorizeTiers ensures that the user has access to all the supplied Tiers
-func authorizeTiers(ctx context.Context, tiers []string, authorizer authorizer.TierAuthorizer) error {
+func authorizeTiers(ctx context.Context, tiers []string, tierAuthorizer authorizer.TierAuthorizer) error {
 	for _, tier := range tiers {
-		err := authorizer.AuthorizeTierOperation(ctx, "", tier)
+		err := tierAuthorizer.AuthorizeTierOperation(ctx, "", tier)
 		if err != nil {
+			if errors.IsForbidden(err) {
+				authorizer.AnnotateForbidden(ctx, err.Error())
+			}
 			return err
 		}
 	}
@@ -151,3 +160,51 @@ func GetTierFromPolicyName(policyName string) (string, string) {
 	}
 	return policySlice[0], policySlice[1]
 }
+
+// NewPolicy returns a function returning the policy being created, for checking against the
+// delegations of its tier.
+func NewPolicy(obj runtime.Object) func() ([]metav1.Object, error) {
+	return func() ([]metav1.Object, error) {
+		policy, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		return []metav1.Object{policy}, nil
+	}
+}
+
+// StoredPolicy returns a function that gets the stored policy, for checking against the delegations
+// of its tier.
+func StoredPolicy(ctx context.Context, getter rest.Getter, name string) func() ([]metav1.Object, error) {
+	return func() ([]metav1.Object, error) {
+		obj, err := getter.Get(ctx, name, &metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return NewPolicy(obj)()
+	}
+}
+
+// UpdatedPolicies returns a function that gets both the stored and the updated policy, for checking
+// against the delegations of their tier.
+func UpdatedPolicies(ctx context.Context, getter rest.Getter, name string, objInfo rest.UpdatedObjectInfo) func() ([]metav1.Object, error) {
+	return func() ([]metav1.Object, error) {
+		oldObj, err := getter.Get(ctx, name, &metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		newObj, err := objInfo.UpdatedObject(ctx, oldObj)
+		if err != nil {
+			return nil, err
+		}
+		oldPolicy, err := meta.Accessor(oldObj)
+		if err != nil {
+			return nil, err
+		}
+		newPolicy, err := meta.Accessor(newObj)
+		if err != nil {
+			return nil, err
+		}
+		return []metav1.Object{oldPolicy, newPolicy}, nil
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import (
+	"context"
+
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	calicowatch "github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// backendClient is implemented by the clientv3 client.  It gives access to resources, such as the IPAM
+// resources, that the v3 client doesn't expose.
+type backendClient interface {
+	Backend() bapi.Client
+}
+
+func backendFor(c clientv3.Interface) bapi.Client {
+	return c.(backendClient).Backend()
+}
+
+// backendWatcher adapts a backend watch to the clientv3 watch.Interface so that resources read through
+// the backend client can be served by the resourceStore.  convert turns each backend KVPair into the
+// corresponding libcalico resource.
+type backendWatcher struct {
+	backend bapi.WatchInterface
+	convert func(*model.KVPair) resourceObject
+	results chan calicowatch.Event
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+func newBackendWatcher(
+	ctx context.Context,
+	backend bapi.WatchInterface,
+	convert func(*model.KVPair) resourceObject,
+) *backendWatcher {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &backendWatcher{
+		backend: backend,
+		convert: convert,
+		results: make(chan calicowatch.Event, calicowatch.DefaultChanSize),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	go w.run()
+	return w
+}
+
+func (w *backendWatcher) Stop() {
+	w.cancel()
+}
+
+func (w *backendWatcher) ResultChan() <-chan calicowatch.Event {
+	return w.results
+}
+
+func (w *backendWatcher) run() {
+	defer close(w.results)
+	defer w.backend.Stop()
+	for {
+		select {
+		case be, ok := <-w.backend.ResultChan():
+			if !ok {
+				return
+			}
+			select {
+			case w.results <- w.convertEvent(be):
+			case <-w.ctx.Done():
+				return
+			}
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *backendWatcher) convertEvent(be bapi.WatchEvent) calicowatch.Event {
+	e := calicowatch.Event{Error: be.Error}
+	switch be.Type {
+	case bapi.WatchAdded:
+		e.Type = calicowatch.Added
+	case bapi.WatchModified:
+		e.Type = calicowatch.Modified
+	case bapi.WatchDeleted:
+		e.Type = calicowatch.Deleted
+	default:
+		e.Type = calicowatch.Error
+	}
+	if be.Old != nil {
+		e.Previous = w.convert(be.Old)
+	}
+	if be.New != nil {
+		e.Object = w.convert(be.New)
+	}
+	return e
+}
@@ -105,6 +105,18 @@ func convertToAAPI(libcalicoObject runtime.Object) (res runtime.Object) {
 		aapi := &v3.BlockAffinity{}
 		BlockAffinityConverter{}.convertToAAPI(obj, aapi)
 		return aapi
+	case *libapi.IPAMBlock:
+		aapi := &v3.IPAMBlock{}
+		IPAMBlockConverter{}.convertToAAPI(obj, aapi)
+		return aapi
+	case *libapi.IPAMHandle:
+		aapi := &v3.IPAMHandle{}
+		IPAMHandleConverter{}.convertToAAPI(obj, aapi)
+		return aapi
+	case *libapi.WorkloadEndpoint:
+		aapi := &v3.WorkloadEndpoint{}
+		WorkloadEndpointConverter{}.convertToAAPI(obj, aapi)
+		return aapi
 	default:
 		logrus.Tracef("Unrecognized libcalico object (type %v)", reflect.TypeOf(libcalicoObject))
 		return nil
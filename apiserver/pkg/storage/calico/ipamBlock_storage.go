@@ -0,0 +1,284 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// ipamReadOnlyMsg is returned for any attempt to modify the IPAM resources.  Modifying the IPAM data
+// directly is likely to corrupt it, so we point the user at the tool that does so safely.
+const ipamReadOnlyMsg = "%s resources are read-only. Use calicoctl ipam to manage IP address allocations."
+
+// NewIPAMBlockStorage creates a new libcalico-based storage.Interface implementation for IPAMBlocks.  The v3
+// client doesn't expose IPAM blocks, so they are read from the backend client.
+func NewIPAMBlockStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to create IPAM block. "+ipamReadOnlyMsg, "IPAM block")
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to update IPAM block. "+ipamReadOnlyMsg, "IPAM block")
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		cidr, err := ipamBlockNameToCIDR(name)
+		if err != nil {
+			return nil, cerrors.ErrorResourceDoesNotExist{Err: err, Identifier: name}
+		}
+		key := model.BlockKey{CIDR: cidr}
+		kvp, err := backendFor(c).Get(ctx, key, ogo.ResourceVersion)
+		if err != nil {
+			return nil, err
+		}
+		if kvp.Value.(*model.AllocationBlock).Deleted {
+			return nil, cerrors.ErrorResourceDoesNotExist{Identifier: key}
+		}
+		return ipamBlockToLibcalico(kvp, newIPPoolLookup(c).poolForBlock(ctx, cidr)), nil
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to delete IPAM block. "+ipamReadOnlyMsg, "IPAM block")
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		kvps, err := backendFor(c).List(ctx, model.BlockListOptions{}, olo.ResourceVersion)
+		if err != nil {
+			return nil, err
+		}
+		pools := newIPPoolLookup(c)
+		list := libapi.NewIPAMBlockList()
+		list.ResourceVersion = kvps.Revision
+		for _, kvp := range kvps.KVPairs {
+			block := kvp.Value.(*model.AllocationBlock)
+			if block.Deleted {
+				continue
+			}
+			res := ipamBlockToLibcalico(kvp, pools.poolForBlock(ctx, block.CIDR))
+			if olo.Name != "" && res.Name != olo.Name {
+				continue
+			}
+			list.Items = append(list.Items, *res)
+		}
+		return list, nil
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		w, err := backendFor(c).Watch(ctx, model.BlockListOptions{}, bapi.WatchOptions{Revision: olo.ResourceVersion})
+		if err != nil {
+			return nil, err
+		}
+		pools := newIPPoolLookup(c)
+		return newBackendWatcher(ctx, w, func(kvp *model.KVPair) resourceObject {
+			block := kvp.Value.(*model.AllocationBlock)
+			return ipamBlockToLibcalico(kvp, pools.poolForBlock(ctx, block.CIDR))
+		}), nil
+	}
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.IPAMBlock{}),
+		aapiListType:      reflect.TypeOf(aapi.IPAMBlockList{}),
+		libCalicoType:     reflect.TypeOf(libapi.IPAMBlock{}),
+		libCalicoListType: reflect.TypeOf(libapi.IPAMBlockList{}),
+		isNamespaced:      false,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "IPAMBlock",
+		converter:         IPAMBlockConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+// ipamBlockToLibcalico converts a backend IPAM block to the libcalico resource.  The name of the IP pool that
+// contains the block, if any, is added as a label so that blocks can be selected by pool.
+func ipamBlockToLibcalico(kvp *model.KVPair, pool string) *libapi.IPAMBlock {
+	block := kvp.Value.(*model.AllocationBlock)
+	res := libapi.NewIPAMBlock()
+	res.Name = names.CIDRToName(block.CIDR)
+	res.ResourceVersion = kvp.Revision
+	if kvp.UID != nil {
+		res.UID = *kvp.UID
+	}
+	if pool != "" {
+		res.Labels = map[string]string{aapi.LabelIPPool: pool}
+	}
+	res.Spec = libapi.IPAMBlockSpec{
+		CIDR:                        block.CIDR.String(),
+		Affinity:                    block.Affinity,
+		Allocations:                 block.Allocations,
+		Unallocated:                 block.Unallocated,
+		SequenceNumber:              block.SequenceNumber,
+		SequenceNumberForAllocation: block.SequenceNumberForAllocation,
+		Deleted:                     block.Deleted,
+	}
+	for _, a := range block.Attributes {
+		res.Spec.Attributes = append(res.Spec.Attributes, libapi.AllocationAttribute{
+			AttrPrimary:   a.AttrPrimary,
+			AttrSecondary: a.AttrSecondary,
+		})
+	}
+	return res
+}
+
+// ipamBlockNameToCIDR reverses names.CIDRToName.  IPv4 block names have exactly four address segments, for
+// example, "10-0-1-0-26"; anything else is an IPv6 block, for example, "fd00-1--40-122".
+func ipamBlockNameToCIDR(name string) (cnet.IPNet, error) {
+	i := strings.LastIndex(name, "-")
+	if i < 0 {
+		return cnet.IPNet{}, fmt.Errorf("invalid IPAM block name %q", name)
+	}
+	addr, prefixLen := name[:i], name[i+1:]
+	if parts := strings.Split(addr, "-"); len(parts) == 4 && !strings.Contains(addr, "--") {
+		addr = strings.Join(parts, ".")
+	} else {
+		addr = strings.ReplaceAll(addr, "-", ":")
+	}
+	_, cidr, err := cnet.ParseCIDR(addr + "/" + prefixLen)
+	if err != nil {
+		return cnet.IPNet{}, fmt.Errorf("invalid IPAM block name %q: %w", name, err)
+	}
+	if names.CIDRToName(*cidr) != name {
+		// Not in canonical form, so it can't be the name of a block.
+		return cnet.IPNet{}, fmt.Errorf("invalid IPAM block name %q", name)
+	}
+	return *cidr, nil
+}
+
+// ipPoolReloadInterval limits how often an ipPoolLookup reloads the IP pools when it is asked about a
+// block that isn't in any of the pools that it knows about.
+const ipPoolReloadInterval = 10 * time.Second
+
+// ipPoolLookup finds the IP pool that contains an IPAM block.  It loads the pools on first use and
+// reloads them when asked about a block that isn't in any known pool, for example, because the pool was
+// created after the lookup loaded the pools.  It is not safe for concurrent use.
+type ipPoolLookup struct {
+	client   clientv3.Interface
+	pools    []aapi.IPPool
+	lastLoad time.Time
+}
+
+func newIPPoolLookup(c clientv3.Interface) *ipPoolLookup {
+	return &ipPoolLookup{client: c}
+}
+
+// poolForBlock returns the name of the IP pool that contains the given block, or "" if there isn't one.
+func (l *ipPoolLookup) poolForBlock(ctx context.Context, block cnet.IPNet) string {
+	if name := l.find(block); name != "" {
+		return name
+	}
+	if !l.lastLoad.IsZero() && time.Since(l.lastLoad) < ipPoolReloadInterval {
+		return ""
+	}
+	l.lastLoad = time.Now()
+	pools, err := l.client.IPPools().List(ctx, options.ListOptions{})
+	if err != nil {
+		log.WithError(err).Warn("Failed to list IP pools, unable to determine the pool of IPAM block.")
+		return ""
+	}
+	l.pools = pools.Items
+	return l.find(block)
+}
+
+func (l *ipPoolLookup) find(block cnet.IPNet) string {
+	for _, p := range l.pools {
+		_, poolCIDR, err := cnet.ParseCIDR(p.Spec.CIDR)
+		if err != nil {
+			continue
+		}
+		if poolCIDR.Covers(block.IPNet) {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+type IPAMBlockConverter struct{}
+
+func (gc IPAMBlockConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	var lcgIPAMBlock *libapi.IPAMBlock
+	// This should not be called since IPAM blocks are read-only through the AAPI.
+	log.Error("IPAM block API is read-only. Should not attempt to create/update IPAM blocks through the API.")
+	return lcgIPAMBlock
+}
+
+func (gc IPAMBlockConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgIPAMBlock := libcalicoObject.(*libapi.IPAMBlock)
+	aapiIPAMBlock := aapiObj.(*aapi.IPAMBlock)
+	lcgSpec := lcgIPAMBlock.Spec
+	aapiIPAMBlock.Spec = aapi.IPAMBlockSpec{
+		CIDR:                        lcgSpec.CIDR,
+		Affinity:                    lcgSpec.Affinity,
+		Allocations:                 lcgSpec.Allocations,
+		Unallocated:                 lcgSpec.Unallocated,
+		SequenceNumber:              lcgSpec.SequenceNumber,
+		SequenceNumberForAllocation: lcgSpec.SequenceNumberForAllocation,
+		Deleted:                     lcgSpec.Deleted,
+	}
+	for _, a := range lcgSpec.Attributes {
+		aapiIPAMBlock.Spec.Attributes = append(aapiIPAMBlock.Spec.Attributes, aapi.AllocationAttribute{
+			AttrPrimary:   a.AttrPrimary,
+			AttrSecondary: a.AttrSecondary,
+		})
+	}
+	aapiIPAMBlock.TypeMeta = lcgIPAMBlock.TypeMeta
+	aapiIPAMBlock.ObjectMeta = lcgIPAMBlock.ObjectMeta
+
+	// Make sure that the API version is correct.
+	aapiIPAMBlock.TypeMeta.APIVersion = aapi.GroupVersionCurrent
+}
+
+func (gc IPAMBlockConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	aapiIPAMBlockList := aapiListObj.(*aapi.IPAMBlockList)
+	if libcalicoListObject == nil {
+		aapiIPAMBlockList.Items = []aapi.IPAMBlock{}
+		return
+	}
+	lcgIPAMBlockList := libcalicoListObject.(*libapi.IPAMBlockList)
+	aapiIPAMBlockList.TypeMeta = lcgIPAMBlockList.TypeMeta
+	aapiIPAMBlockList.ListMeta = lcgIPAMBlockList.ListMeta
+	for _, item := range lcgIPAMBlockList.Items {
+		aapiIPAMBlock := aapi.IPAMBlock{}
+		gc.convertToAAPI(&item, &aapiIPAMBlock)
+		if matched, err := pred.Matches(&aapiIPAMBlock); err == nil && matched {
+			aapiIPAMBlockList.Items = append(aapiIPAMBlockList.Items, aapiIPAMBlock)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+package calico
+
+import (
+	"testing"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+func TestIPAMBlockNameToCIDR(t *testing.T) {
+	for _, cidr := range []string{
+		"10.0.1.0/26",
+		"192.168.255.192/30",
+		"fd00::/122",
+		"fd00:1::40/122",
+		"2001:db8:1:2:3:4:5:0/120",
+		"::/122",
+	} {
+		_, expected, err := cnet.ParseCIDR(cidr)
+		if err != nil {
+			t.Fatalf("Bad test CIDR %s: %v", cidr, err)
+		}
+		name := names.CIDRToName(*expected)
+		actual, err := ipamBlockNameToCIDR(name)
+		if err != nil {
+			t.Fatalf("Failed to convert name %s back to a CIDR: %v", name, err)
+		}
+		if actual.String() != expected.String() {
+			t.Fatalf("Name %s converted to %s, expected %s", name, actual.String(), expected.String())
+		}
+	}
+
+	for _, name := range []string{"", "foo", "10-0-1-0", "10-0-1-1-24", "10-0-1-0-33", "010-0-1-0-26"} {
+		if cidr, err := ipamBlockNameToCIDR(name); err == nil {
+			t.Fatalf("Expected an error for name %q, got %s", name, cidr.String())
+		}
+	}
+}
+
+func TestIPAMBlockToLibcalico(t *testing.T) {
+	_, cidr, _ := cnet.ParseCIDR("10.0.1.0/26")
+	affinity := "host:node1"
+	handle := "handle1"
+	kvp := &model.KVPair{
+		Key: model.BlockKey{CIDR: *cidr},
+		Value: &model.AllocationBlock{
+			CIDR:        *cidr,
+			Affinity:    &affinity,
+			Allocations: []*int{nil},
+			Attributes:  []model.AllocationAttribute{{AttrPrimary: &handle}},
+		},
+		Revision: "1234",
+	}
+
+	block := ipamBlockToLibcalico(kvp, "default-ipv4-ippool")
+	if block.Name != "10-0-1-0-26" || block.ResourceVersion != "1234" {
+		t.Fatalf("Unexpected metadata: %+v", block.ObjectMeta)
+	}
+	if block.Labels[v3.LabelIPPool] != "default-ipv4-ippool" {
+		t.Fatalf("Expected pool label, got %v", block.Labels)
+	}
+	if *block.Spec.Attributes[0].AttrPrimary != handle || block.Spec.CIDR != "10.0.1.0/26" {
+		t.Fatalf("Unexpected spec: %+v", block.Spec)
+	}
+
+	if block := ipamBlockToLibcalico(kvp, ""); block.Labels != nil {
+		t.Fatalf("Expected no labels for a block outside any pool, got %v", block.Labels)
+	}
+}
+
+func TestIPPoolLookupFind(t *testing.T) {
+	l := &ipPoolLookup{pools: []v3.IPPool{
+		{ObjectMeta: metav1.ObjectMeta{Name: "pool1"}, Spec: v3.IPPoolSpec{CIDR: "10.0.0.0/16"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pool2"}, Spec: v3.IPPoolSpec{CIDR: "10.1.0.0/30"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pool3"}, Spec: v3.IPPoolSpec{CIDR: "fd00::/64"}},
+	}}
+	for block, expected := range map[string]string{
+		"10.0.4.0/26":  "pool1",
+		"10.1.0.0/26":  "",
+		"10.2.0.0/26":  "",
+		"fd00::40/122": "pool3",
+	} {
+		_, cidr, _ := cnet.ParseCIDR(block)
+		if actual := l.find(*cidr); actual != expected {
+			t.Fatalf("Expected block %s to be in pool %q, got %q", block, expected, actual)
+		}
+	}
+}
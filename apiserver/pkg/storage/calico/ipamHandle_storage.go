@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewIPAMHandleStorage creates a new libcalico-based storage.Interface implementation for IPAMHandles.  The v3
+// client doesn't expose IPAM handles, so they are read from the backend client.
+func NewIPAMHandleStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to create IPAM handle. "+ipamReadOnlyMsg, "IPAM handle")
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to update IPAM handle. "+ipamReadOnlyMsg, "IPAM handle")
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		key := model.IPAMHandleKey{HandleID: name}
+		kvp, err := backendFor(c).Get(ctx, key, ogo.ResourceVersion)
+		if err != nil {
+			return nil, err
+		}
+		if kvp.Value.(*model.IPAMHandle).Deleted {
+			return nil, cerrors.ErrorResourceDoesNotExist{Identifier: key}
+		}
+		return ipamHandleToLibcalico(kvp), nil
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to delete IPAM handle. "+ipamReadOnlyMsg, "IPAM handle")
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		kvps, err := backendFor(c).List(ctx, model.IPAMHandleListOptions{}, olo.ResourceVersion)
+		if err != nil {
+			return nil, err
+		}
+		list := libapi.NewIPAMHandleList()
+		list.ResourceVersion = kvps.Revision
+		for _, kvp := range kvps.KVPairs {
+			if kvp.Value.(*model.IPAMHandle).Deleted {
+				continue
+			}
+			res := ipamHandleToLibcalico(kvp)
+			if olo.Name != "" && res.Name != olo.Name {
+				continue
+			}
+			list.Items = append(list.Items, *res)
+		}
+		return list, nil
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		w, err := backendFor(c).Watch(ctx, model.IPAMHandleListOptions{}, bapi.WatchOptions{Revision: olo.ResourceVersion})
+		if err != nil {
+			return nil, err
+		}
+		return newBackendWatcher(ctx, w, func(kvp *model.KVPair) resourceObject {
+			return ipamHandleToLibcalico(kvp)
+		}), nil
+	}
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.IPAMHandle{}),
+		aapiListType:      reflect.TypeOf(aapi.IPAMHandleList{}),
+		libCalicoType:     reflect.TypeOf(libapi.IPAMHandle{}),
+		libCalicoListType: reflect.TypeOf(libapi.IPAMHandleList{}),
+		isNamespaced:      false,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "IPAMHandle",
+		converter:         IPAMHandleConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+// ipamHandleToLibcalico converts a backend IPAM handle to the libcalico resource.
+func ipamHandleToLibcalico(kvp *model.KVPair) *libapi.IPAMHandle {
+	handle := kvp.Value.(*model.IPAMHandle)
+	res := libapi.NewIPAMHandle()
+	res.Name = kvp.Key.(model.IPAMHandleKey).HandleID
+	res.ResourceVersion = kvp.Revision
+	if kvp.UID != nil {
+		res.UID = *kvp.UID
+	}
+	res.Spec = libapi.IPAMHandleSpec{
+		HandleID: kvp.Key.(model.IPAMHandleKey).HandleID,
+		Block:    handle.Block,
+		Deleted:  handle.Deleted,
+	}
+	return res
+}
+
+type IPAMHandleConverter struct{}
+
+func (gc IPAMHandleConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	var lcgIPAMHandle *libapi.IPAMHandle
+	// This should not be called since IPAM handles are read-only through the AAPI.
+	log.Error("IPAM handle API is read-only. Should not attempt to create/update IPAM handles through the API.")
+	return lcgIPAMHandle
+}
+
+func (gc IPAMHandleConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgIPAMHandle := libcalicoObject.(*libapi.IPAMHandle)
+	aapiIPAMHandle := aapiObj.(*aapi.IPAMHandle)
+	aapiIPAMHandle.Spec = aapi.IPAMHandleSpec{
+		HandleID: lcgIPAMHandle.Spec.HandleID,
+		Block:    lcgIPAMHandle.Spec.Block,
+		Deleted:  lcgIPAMHandle.Spec.Deleted,
+	}
+	aapiIPAMHandle.TypeMeta = lcgIPAMHandle.TypeMeta
+	aapiIPAMHandle.ObjectMeta = lcgIPAMHandle.ObjectMeta
+
+	// Make sure that the API version is correct.
+	aapiIPAMHandle.TypeMeta.APIVersion = aapi.GroupVersionCurrent
+}
+
+func (gc IPAMHandleConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	aapiIPAMHandleList := aapiListObj.(*aapi.IPAMHandleList)
+	if libcalicoListObject == nil {
+		aapiIPAMHandleList.Items = []aapi.IPAMHandle{}
+		return
+	}
+	lcgIPAMHandleList := libcalicoListObject.(*libapi.IPAMHandleList)
+	aapiIPAMHandleList.TypeMeta = lcgIPAMHandleList.TypeMeta
+	aapiIPAMHandleList.ListMeta = lcgIPAMHandleList.ListMeta
+	for _, item := range lcgIPAMHandleList.Items {
+		aapiIPAMHandle := aapi.IPAMHandle{}
+		gc.convertToAAPI(&item, &aapiIPAMHandle)
+		if matched, err := pred.Matches(&aapiIPAMHandle); err == nil && matched {
+			aapiIPAMHandleList.Items = append(aapiIPAMHandleList.Items, aapiIPAMHandle)
+		}
+	}
+}
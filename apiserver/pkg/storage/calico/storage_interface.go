@@ -49,6 +49,12 @@ func NewStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc)
 		return NewIPAMConfigurationStorage(opts)
 	case "projectcalico.org/blockaffinities":
 		return NewBlockAffinityStorage(opts)
+	case "projectcalico.org/ipamblocks":
+		return NewIPAMBlockStorage(opts)
+	case "projectcalico.org/ipamhandles":
+		return NewIPAMHandleStorage(opts)
+	case "projectcalico.org/workloadendpoints":
+		return NewWorkloadEndpointStorage(opts)
 	default:
 		logrus.Fatalf("Unable to create storage for resource %v", opts.RESTOptions.ResourcePrefix)
 		return registry.DryRunnableStorage{}, nil
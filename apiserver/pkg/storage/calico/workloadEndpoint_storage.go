@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewWorkloadEndpointStorage creates a new libcalico-based storage.Interface implementation for WorkloadEndpoints.
+// WorkloadEndpoints are owned by the orchestrator plugins so they are read-only through the API server.
+func NewWorkloadEndpointStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to create workload endpoint. Workload endpoint resources are read-only.")
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to update workload endpoint. Workload endpoint resources are read-only.")
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		return c.WorkloadEndpoints().Get(ctx, ns, name, ogo)
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		return nil, fmt.Errorf("Unable to delete workload endpoint. Workload endpoint resources are read-only.")
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		return c.WorkloadEndpoints().List(ctx, olo)
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		return c.WorkloadEndpoints().Watch(ctx, olo)
+	}
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.WorkloadEndpoint{}),
+		aapiListType:      reflect.TypeOf(aapi.WorkloadEndpointList{}),
+		libCalicoType:     reflect.TypeOf(libapi.WorkloadEndpoint{}),
+		libCalicoListType: reflect.TypeOf(libapi.WorkloadEndpointList{}),
+		isNamespaced:      true,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "WorkloadEndpoint",
+		converter:         WorkloadEndpointConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+type WorkloadEndpointConverter struct{}
+
+func (gc WorkloadEndpointConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	var lcgWorkloadEndpoint *libapi.WorkloadEndpoint
+	// This should not be called since workload endpoints are read-only through the AAPI.
+	log.Error("Workload endpoint API is read-only. Should not attempt to create/update workload endpoints through the API.")
+	return lcgWorkloadEndpoint
+}
+
+func (gc WorkloadEndpointConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgWorkloadEndpoint := libcalicoObject.(*libapi.WorkloadEndpoint)
+	aapiWorkloadEndpoint := aapiObj.(*aapi.WorkloadEndpoint)
+	lcgSpec := lcgWorkloadEndpoint.Spec
+	aapiWorkloadEndpoint.Spec = aapi.WorkloadEndpointSpec{
+		Orchestrator:               lcgSpec.Orchestrator,
+		Workload:                   lcgSpec.Workload,
+		Node:                       lcgSpec.Node,
+		ContainerID:                lcgSpec.ContainerID,
+		Pod:                        lcgSpec.Pod,
+		Endpoint:                   lcgSpec.Endpoint,
+		ServiceAccountName:         lcgSpec.ServiceAccountName,
+		IPNetworks:                 lcgSpec.IPNetworks,
+		IPv4Gateway:                lcgSpec.IPv4Gateway,
+		IPv6Gateway:                lcgSpec.IPv6Gateway,
+		Profiles:                   lcgSpec.Profiles,
+		InterfaceName:              lcgSpec.InterfaceName,
+		MAC:                        lcgSpec.MAC,
+		AllowSpoofedSourcePrefixes: lcgSpec.AllowSpoofedSourcePrefixes,
+	}
+	for _, nat := range lcgSpec.IPNATs {
+		aapiWorkloadEndpoint.Spec.IPNATs = append(aapiWorkloadEndpoint.Spec.IPNATs, aapi.IPNAT{
+			InternalIP: nat.InternalIP,
+			ExternalIP: nat.ExternalIP,
+		})
+	}
+	for _, port := range lcgSpec.Ports {
+		aapiWorkloadEndpoint.Spec.Ports = append(aapiWorkloadEndpoint.Spec.Ports, aapi.WorkloadEndpointPort{
+			Name:     port.Name,
+			Protocol: port.Protocol,
+			Port:     port.Port,
+			HostPort: port.HostPort,
+			HostIP:   port.HostIP,
+		})
+	}
+	if lcgSpec.QoSControls != nil {
+		aapiWorkloadEndpoint.Spec.QoSControls = &aapi.QoSControls{
+			EgressBandwidth: lcgSpec.QoSControls.EgressBandwidth,
+		}
+	}
+	aapiWorkloadEndpoint.TypeMeta = lcgWorkloadEndpoint.TypeMeta
+	aapiWorkloadEndpoint.ObjectMeta = lcgWorkloadEndpoint.ObjectMeta
+
+	// Make sure that the API version is correct.
+	aapiWorkloadEndpoint.TypeMeta.APIVersion = aapi.GroupVersionCurrent
+}
+
+func (gc WorkloadEndpointConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	aapiWorkloadEndpointList := aapiListObj.(*aapi.WorkloadEndpointList)
+	if libcalicoListObject == nil {
+		aapiWorkloadEndpointList.Items = []aapi.WorkloadEndpoint{}
+		return
+	}
+	lcgWorkloadEndpointList := libcalicoListObject.(*libapi.WorkloadEndpointList)
+	aapiWorkloadEndpointList.TypeMeta = lcgWorkloadEndpointList.TypeMeta
+	aapiWorkloadEndpointList.ListMeta = lcgWorkloadEndpointList.ListMeta
+	for _, item := range lcgWorkloadEndpointList.Items {
+		aapiWorkloadEndpoint := aapi.WorkloadEndpoint{}
+		gc.convertToAAPI(&item, &aapiWorkloadEndpoint)
+		if matched, err := pred.Matches(&aapiWorkloadEndpoint); err == nil && matched {
+			aapiWorkloadEndpointList.Items = append(aapiWorkloadEndpointList.Items, aapiWorkloadEndpoint)
+		}
+	}
+}
@@ -37,7 +37,10 @@ import (
 
 	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
 	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	libclient "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
 	"github.com/projectcalico/calico/libcalico-go/lib/options"
 )
 
@@ -1765,6 +1768,127 @@ func testBlockAffinityClient(client calicoclient.Interface, name string) error {
 	return nil
 }
 
+// TestIPAMBlockClient exercises the IPAMBlock client.
+func TestIPAMBlockClient(t *testing.T) {
+	const name = "test-ipamblock"
+	rootTestFunc := func() func(t *testing.T) {
+		return func(t *testing.T) {
+			client, shutdownServer := getFreshApiserverAndClient(t, func() runtime.Object {
+				return &v3.IPAMBlock{}
+			})
+			defer shutdownServer()
+			if err := testIPAMBlockClient(client); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if !t.Run(name, rootTestFunc()) {
+		t.Errorf("test-ipamblock test failed")
+	}
+}
+
+func testIPAMBlockClient(client calicoclient.Interface) error {
+	ipamBlockClient := client.ProjectcalicoV3().IPAMBlocks()
+	ctx := context.Background()
+
+	config := apiconfig.NewCalicoAPIConfig()
+	config.Spec = apiconfig.CalicoAPIConfigSpec{
+		DatastoreType: apiconfig.Kubernetes,
+		EtcdConfig: apiconfig.EtcdConfig{
+			EtcdEndpoints: "http://localhost:2379",
+		},
+		KubeConfig: apiconfig.KubeConfig{
+			Kubeconfig: os.Getenv("KUBECONFIG"),
+		},
+	}
+	apiClient, err := libclient.New(*config)
+	if err != nil {
+		return fmt.Errorf("unable to create Calico lib v3 client: %s", err)
+	}
+	type backendClient interface {
+		Backend() bapi.Client
+	}
+	bc := apiClient.(backendClient).Backend()
+
+	pool := &v3.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-ipamblock-pool"},
+		Spec:       v3.IPPoolSpec{CIDR: "10.123.0.0/16"},
+	}
+	if _, err := apiClient.IPPools().Create(ctx, pool, options.SetOptions{}); err != nil {
+		return fmt.Errorf("error creating IP pool (%s)", err)
+	}
+	defer func() {
+		_, _ = apiClient.IPPools().Delete(ctx, pool.Name, options.DeleteOptions{})
+	}()
+
+	// IPAM blocks can only be created through the IPAM code, so use the backend client.
+	_, cidr, _ := cnet.ParseCIDR("10.123.4.0/26")
+	affinity := "host:node1"
+	kvp, err := bc.Create(ctx, &model.KVPair{
+		Key: model.BlockKey{CIDR: *cidr},
+		Value: &model.AllocationBlock{
+			CIDR:        *cidr,
+			Affinity:    &affinity,
+			Allocations: make([]*int, 64),
+			Unallocated: []int{0, 1, 2, 3},
+			Attributes:  []model.AllocationAttribute{},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating IPAM block through the backend (%s)", err)
+	}
+	defer func() {
+		_, _ = bc.DeleteKVP(ctx, kvp)
+	}()
+
+	const name = "10-123-4-0-26"
+	block, err := ipamBlockClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting IPAM block %s (%s)", name, err)
+	}
+	if block.Spec.CIDR != "10.123.4.0/26" {
+		return fmt.Errorf("unexpected CIDR %s", block.Spec.CIDR)
+	}
+	if block.Labels[v3.LabelIPPool] != pool.Name {
+		return fmt.Errorf("expected IPAM block to be labelled with pool %s, got %v", pool.Name, block.Labels)
+	}
+
+	for selector, expected := range map[string]int{
+		"node=node1":                      1,
+		"node=node2":                      0,
+		"pool=test-ipamblock-pool":        1,
+		"pool=some-other-pool":            0,
+		"node=node1,pool=some-other-pool": 0,
+	} {
+		blocks, err := ipamBlockClient.List(ctx, metav1.ListOptions{FieldSelector: selector})
+		if err != nil {
+			return fmt.Errorf("error listing IPAM blocks with field selector %s (%s)", selector, err)
+		}
+		if len(blocks.Items) != expected {
+			return fmt.Errorf("expected %d IPAM blocks with field selector %s, got %d", expected, selector, len(blocks.Items))
+		}
+	}
+
+	_, err = ipamBlockClient.Create(ctx, &v3.IPAMBlock{
+		ObjectMeta: metav1.ObjectMeta{Name: "10-123-5-0-26"},
+		Spec:       v3.IPAMBlockSpec{CIDR: "10.123.5.0/26"},
+	}, metav1.CreateOptions{})
+	if err == nil || !strings.Contains(err.Error(), "calicoctl ipam") {
+		return fmt.Errorf("should not be able to create IPAM block (%v)", err)
+	}
+	_, err = ipamBlockClient.Update(ctx, block, metav1.UpdateOptions{})
+	if err == nil {
+		return fmt.Errorf("should not be able to update IPAM block %s", name)
+	}
+	err = ipamBlockClient.Delete(ctx, name, metav1.DeleteOptions{})
+	if err == nil {
+		return fmt.Errorf("should not be able to delete IPAM block %s", name)
+	}
+
+	return nil
+}
+
 // TestBGPFilterClient exercises the BGPFilter client.
 func TestBGPFilterClient(t *testing.T) {
 	const name = "test-bgpfilter"
@@ -88,10 +88,17 @@ func checkTiers(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, req
 		}
 
 		action := NO_MATCH
+		enforced := false
 	Policy:
 		for i, name := range policies {
 			pID := types.PolicyID{Tier: tier.GetName(), Name: name}
 			policy := store.PolicyByID[pID]
+			if policy.GetStaged() {
+				// Staged policies never affect the verdict.
+				log.Debugf("Skipping staged policy (ordinal=%d, policyId=%v)", i, pID)
+				continue
+			}
+			enforced = true
 			action = checkPolicy(policy, reqCache)
 			log.Debugf("Policy checked (ordinal=%d, profileId=%v, action=%v)", i, pID, action)
 			switch action {
@@ -114,7 +121,7 @@ func checkTiers(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, req
 			}
 		}
 		// Done evaluating policies in the tier. If no policy rules have matched, apply tier's default action.
-		if action == NO_MATCH {
+		if action == NO_MATCH && enforced {
 			log.Debugf("No policy matched. Tier default action %v applies.", tier.DefaultAction)
 			// If the default action is anything beside Pass, then apply tier default deny action.
 			// Otherwise, continue to next tier or profiles.
@@ -190,10 +197,12 @@ func checkRules(rules []*proto.Rule, req *requestCache, policyNamespace string)
 // actionFromString converts a string action name, like "allow" into an Action.
 func actionFromString(s string) Action {
 	// Felix currently passes us the v1 resource types where the "pass" action is called "next-tier".
-	// Here we support both the v1 and v3 action names.
+	// Here we support both the v1 and v3 action names.  There is no connection to reset at this
+	// layer so rejected requests are simply denied.
 	m := map[string]Action{
 		"allow":     ALLOW,
 		"deny":      DENY,
+		"reject":    DENY,
 		"pass":      PASS,
 		"next-tier": PASS,
 		"log":       LOG,
@@ -33,6 +33,8 @@ func TestActionFromString(t *testing.T) {
 	Expect(actionFromString("Allow")).To(Equal(ALLOW))
 	Expect(actionFromString("deny")).To(Equal(DENY))
 	Expect(actionFromString("Deny")).To(Equal(DENY))
+	Expect(actionFromString("reject")).To(Equal(DENY))
+	Expect(actionFromString("Reject")).To(Equal(DENY))
 	Expect(actionFromString("pass")).To(Equal(PASS))
 	Expect(actionFromString("Pass")).To(Equal(PASS))
 	Expect(actionFromString("log")).To(Equal(LOG))
@@ -257,6 +259,51 @@ func TestCheckStorePolicyMatch(t *testing.T) {
 	Expect(status.Code).To(Equal(PERMISSION_DENIED))
 }
 
+// Staged policies must not affect the verdict.
+func TestCheckStoreStagedPolicy(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{
+			{
+				Name:            "tier1",
+				IngressPolicies: []string{"staged:policy1"},
+			},
+		},
+		ProfileIds: []string{"profile1"},
+	}
+	store.PolicyByID[types.PolicyID{Tier: "tier1", Name: "staged:policy1"}] = &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{
+				Action:    "deny",
+				HttpMatch: &proto.HTTPMatch{Methods: []string{"GET"}},
+			},
+		},
+		Staged: true,
+	}
+	store.ProfileByID[types.ProfileID{Name: "profile1"}] = &proto.Profile{
+		InboundRules: []*proto.Rule{{Action: "allow"}},
+	}
+
+	req := &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/steve",
+		},
+		Destination: &authz.AttributeContext_Peer{
+			Principal: "spiffe://cluster.local/ns/default/sa/sally",
+		},
+		Request: &authz.AttributeContext_Request{
+			Http: &authz.AttributeContext_HttpRequest{Method: "GET"},
+		},
+	}}
+
+	// The staged deny doesn't apply and, since the tier has no enforced
+	// policy, its default deny doesn't either.
+	status := checkStore(store, store.Endpoint, req)
+	Expect(status.Code).To(Equal(OK))
+}
+
 // And endpoint with no Tiers should evaluate Profiles.
 func TestCheckStoreProfileOnly(t *testing.T) {
 	RegisterTestingT(t)
@@ -17,7 +17,9 @@ package checker
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"strings"
+	"sync"
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
@@ -196,7 +198,11 @@ func matchHTTP(rule *proto.HTTPMatch, req *authz.AttributeContext_HttpRequest) b
 		log.Debug("nil HTTPRule.  Return true")
 		return true
 	}
-	return matchHTTPMethods(rule.GetMethods(), req.GetMethod()) && matchHTTPPaths(rule.GetPaths(), req.GetPath())
+	return matchHTTPMethods(rule.GetMethods(), req.GetMethod()) &&
+		matchHTTPPaths(rule.GetPaths(), req.GetPath()) &&
+		matchHTTPHeaders(rule.GetHeaders(), req.GetHeaders()) &&
+		matchHTTPHosts(rule.GetHosts(), req.GetHost()) &&
+		matchGRPC(rule.GetGrpc(), req)
 }
 
 func matchHTTPMethods(methods []string, reqMethod string) bool {
@@ -260,6 +266,107 @@ func matchHTTPPaths(paths []*proto.HTTPMatch_PathMatch, reqPath string) bool {
 	return false
 }
 
+func matchHTTPHeaders(headers []*proto.HTTPMatch_HeaderMatch, reqHeaders map[string]string) bool {
+	log.WithFields(log.Fields{
+		"headers": headers,
+	}).Debug("Matching HTTP Headers")
+	// Envoy passes the header names in lower case, with the values of repeated headers
+	// joined by commas.
+	for _, headerMatch := range headers {
+		value, present := reqHeaders[strings.ToLower(headerMatch.GetName())]
+		if !present {
+			log.Debugf("HTTP Header %s not present.", headerMatch.GetName())
+			return false
+		}
+		matched := false
+		switch headerMatch.GetHeaderMatch().(type) {
+		case *proto.HTTPMatch_HeaderMatch_Exact:
+			matched = value == headerMatch.GetExact()
+		case *proto.HTTPMatch_HeaderMatch_Prefix:
+			matched = strings.HasPrefix(value, headerMatch.GetPrefix())
+		case *proto.HTTPMatch_HeaderMatch_Regex:
+			matched = matchRegex(headerMatch.GetRegex(), value)
+		case *proto.HTTPMatch_HeaderMatch_Present:
+			matched = true
+		}
+		if !matched {
+			log.Debugf("HTTP Header %s not matched.", headerMatch.GetName())
+			return false
+		}
+	}
+	return true
+}
+
+// headerRegexps caches the compiled header regexes, which are shared by all requests.
+var headerRegexps sync.Map
+
+func matchRegex(expr, value string) bool {
+	re, ok := headerRegexps.Load(expr)
+	if !ok {
+		compiled, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			// Should have been rejected by validation before it got to Dikastes.
+			log.WithError(err).WithField("regex", expr).Warn("unable to compile regex")
+			return false
+		}
+		re, _ = headerRegexps.LoadOrStore(expr, compiled)
+	}
+	return re.(*regexp.Regexp).MatchString(value)
+}
+
+func matchHTTPHosts(hosts []string, reqHost string) bool {
+	log.WithFields(log.Fields{
+		"hosts":   hosts,
+		"reqHost": reqHost,
+	}).Debug("Matching HTTP Hosts")
+	if len(hosts) == 0 {
+		log.Debug("Rule has 0 HTTP Hosts, matched.")
+		return true
+	}
+	if h, _, err := net.SplitHostPort(reqHost); err == nil {
+		reqHost = h
+	}
+	reqHost = strings.TrimSuffix(strings.ToLower(reqHost), ".")
+	for _, host := range hosts {
+		host = strings.ToLower(host)
+		if suffix, ok := strings.CutPrefix(host, "*"); ok {
+			if strings.HasSuffix(reqHost, suffix) {
+				log.Debugf("HTTP Host wildcard %s matched.", host)
+				return true
+			}
+		} else if reqHost == host {
+			log.Debug("HTTP Host matched.")
+			return true
+		}
+	}
+	log.Debug("HTTP Host not matched.")
+	return false
+}
+
+func matchGRPC(grpc *proto.HTTPMatch_GRPCMatch, req *authz.AttributeContext_HttpRequest) bool {
+	log.WithFields(log.Fields{
+		"grpc": grpc,
+	}).Debug("Matching gRPC")
+	if grpc == nil {
+		return true
+	}
+	if !strings.HasPrefix(req.GetHeaders()["content-type"], "application/grpc") {
+		log.Debug("Request is not gRPC.")
+		return false
+	}
+	// gRPC calls are POSTs to /<package>.<service>/<method>.
+	service, method, ok := strings.Cut(strings.TrimPrefix(req.GetPath(), "/"), "/")
+	if !ok {
+		log.WithField("path", req.GetPath()).Debug("Invalid gRPC path.")
+		return false
+	}
+	if grpc.GetService() != "" && grpc.GetService() != service {
+		log.Debug("gRPC service not matched.")
+		return false
+	}
+	return matchName(grpc.GetMethods(), method)
+}
+
 func matchSrcIPSets(r *proto.Rule, req *requestCache) bool {
 	log.WithFields(log.Fields{
 		"SrcIpSetIds":    r.SrcIpSetIds,
@@ -140,6 +140,133 @@ func TestMatchHTTPPaths(t *testing.T) {
 	}
 }
 
+// HTTP Headers clauses are AND'd together.
+func TestMatchHTTPHeaders(t *testing.T) {
+	exact := &proto.HTTPMatch_HeaderMatch{Name: "X-Tenant", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: "a"}}
+	prefix := &proto.HTTPMatch_HeaderMatch{Name: "x-version", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Prefix{Prefix: "v1."}}
+	regex := &proto.HTTPMatch_HeaderMatch{Name: "user-agent", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Regex{Regex: "curl/[0-9.]+"}}
+	present := &proto.HTTPMatch_HeaderMatch{Name: "authorization", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Present{Present: true}}
+	testCases := []struct {
+		title      string
+		headers    []*proto.HTTPMatch_HeaderMatch
+		reqHeaders map[string]string
+		result     bool
+	}{
+		{"empty", nil, map[string]string{"x-tenant": "a"}, true},
+		{"exact", []*proto.HTTPMatch_HeaderMatch{exact}, map[string]string{"x-tenant": "a"}, true},
+		{"exact fail", []*proto.HTTPMatch_HeaderMatch{exact}, map[string]string{"x-tenant": "ab"}, false},
+		{"missing", []*proto.HTTPMatch_HeaderMatch{exact}, map[string]string{"x-other": "a"}, false},
+		{"prefix", []*proto.HTTPMatch_HeaderMatch{prefix}, map[string]string{"x-version": "v1.2"}, true},
+		{"prefix fail", []*proto.HTTPMatch_HeaderMatch{prefix}, map[string]string{"x-version": "v2.1"}, false},
+		{"regex", []*proto.HTTPMatch_HeaderMatch{regex}, map[string]string{"user-agent": "curl/7.68.0"}, true},
+		{"regex must match whole value", []*proto.HTTPMatch_HeaderMatch{regex}, map[string]string{"user-agent": "not-curl/7.68.0"}, false},
+		{"present", []*proto.HTTPMatch_HeaderMatch{present}, map[string]string{"authorization": ""}, true},
+		{"present fail", []*proto.HTTPMatch_HeaderMatch{present}, map[string]string{}, false},
+		{"multiple", []*proto.HTTPMatch_HeaderMatch{exact, present}, map[string]string{"x-tenant": "a", "authorization": "x"}, true},
+		{"multiple fail", []*proto.HTTPMatch_HeaderMatch{exact, present}, map[string]string{"x-tenant": "a"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPHeaders(tc.headers, tc.reqHeaders)).To(Equal(tc.result))
+		})
+	}
+}
+
+// HTTP Hosts clause with empty list will match any host.
+func TestMatchHTTPHosts(t *testing.T) {
+	testCases := []struct {
+		title   string
+		hosts   []string
+		reqHost string
+		result  bool
+	}{
+		{"empty", nil, "example.com", true},
+		{"exact", []string{"example.com"}, "example.com", true},
+		{"case-insensitive", []string{"Example.com"}, "EXAMPLE.com", true},
+		{"with port", []string{"example.com"}, "example.com:8080", true},
+		{"exact fail", []string{"example.com"}, "www.example.com", false},
+		{"wildcard", []string{"*.example.com"}, "www.example.com", true},
+		{"wildcard nested", []string{"*.example.com"}, "a.b.example.com:443", true},
+		{"wildcard does not match domain", []string{"*.example.com"}, "example.com", false},
+		{"wildcard fail", []string{"*.example.com"}, "www.example.org", false},
+		{"multiple", []string{"example.org", "example.com"}, "example.com", true},
+		{"IPv6 with port", []string{"example.com"}, "[::1]:80", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPHosts(tc.hosts, tc.reqHost)).To(Equal(tc.result))
+		})
+	}
+}
+
+// A gRPC clause only matches gRPC requests to the given service and methods.
+func TestMatchGRPC(t *testing.T) {
+	grpcHeaders := map[string]string{"content-type": "application/grpc"}
+	testCases := []struct {
+		title  string
+		grpc   *proto.HTTPMatch_GRPCMatch
+		req    *auth.AttributeContext_HttpRequest
+		result bool
+	}{
+		{"nil", nil, &auth.AttributeContext_HttpRequest{Path: "/foo"}, true},
+		{"any", &proto.HTTPMatch_GRPCMatch{},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Greeter/SayHello", Headers: grpcHeaders}, true},
+		{"not gRPC", &proto.HTTPMatch_GRPCMatch{},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Greeter/SayHello"}, false},
+		{"gRPC with suffix", &proto.HTTPMatch_GRPCMatch{},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Greeter/SayHello", Headers: map[string]string{"content-type": "application/grpc+proto"}}, true},
+		{"service", &proto.HTTPMatch_GRPCMatch{Service: "helloworld.Greeter"},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Greeter/SayHello", Headers: grpcHeaders}, true},
+		{"service fail", &proto.HTTPMatch_GRPCMatch{Service: "helloworld.Greeter"},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Other/SayHello", Headers: grpcHeaders}, false},
+		{"method", &proto.HTTPMatch_GRPCMatch{Service: "helloworld.Greeter", Methods: []string{"SayHello", "SayBye"}},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Greeter/SayBye", Headers: grpcHeaders}, true},
+		{"method fail", &proto.HTTPMatch_GRPCMatch{Methods: []string{"SayHello"}},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Greeter/SayBye", Headers: grpcHeaders}, false},
+		{"invalid path", &proto.HTTPMatch_GRPCMatch{},
+			&auth.AttributeContext_HttpRequest{Path: "/helloworld.Greeter", Headers: grpcHeaders}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchGRPC(tc.grpc, tc.req)).To(Equal(tc.result))
+		})
+	}
+}
+
+// The clauses of an HTTP Match are AND'd together.
+func TestMatchHTTPAllClauses(t *testing.T) {
+	RegisterTestingT(t)
+
+	rule := &proto.HTTPMatch{
+		Methods: []string{"GET"},
+		Paths:   []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Prefix{Prefix: "/api"}}},
+		Headers: []*proto.HTTPMatch_HeaderMatch{
+			{Name: "x-tenant", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: "a"}},
+		},
+		Hosts: []string{"api.example.com"},
+	}
+	req := &auth.AttributeContext_HttpRequest{
+		Method:  "GET",
+		Path:    "/api/v1",
+		Host:    "api.example.com",
+		Headers: map[string]string{"x-tenant": "a"},
+	}
+	Expect(matchHTTP(rule, req)).To(BeTrue())
+
+	req.Headers["x-tenant"] = "b"
+	Expect(matchHTTP(rule, req)).To(BeFalse())
+
+	req.Headers["x-tenant"] = "a"
+	req.Host = "other.example.com"
+	Expect(matchHTTP(rule, req)).To(BeFalse())
+}
+
 // An omitted HTTP Match clause always matches.
 func TestMatchHTTPNil(t *testing.T) {
 	RegisterTestingT(t)
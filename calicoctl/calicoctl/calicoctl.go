@@ -43,6 +43,7 @@ func main() {
     label        Add or update labels of resources.
     convert      Convert config files between different API versions.
     ipam         IP address management.
+    policy       Policy analysis.
     node         Calico node management.
     version      Display the version of this binary.
     datastore    Calico datastore management.
@@ -129,6 +130,8 @@ Description:
 			err = commands.Node(args)
 		case "ipam":
 			err = commands.IPAM(args)
+		case "policy":
+			err = commands.Policy(args)
 		case "datastore":
 			err = commands.Datastore(args)
 		default:
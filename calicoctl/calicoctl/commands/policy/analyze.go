@@ -0,0 +1,891 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+// The checks that the analysis performs.
+const (
+	CheckUnreachableRule   = "unreachable-rule"
+	CheckShadowedRule      = "shadowed-rule"
+	CheckUnmatchedSelector = "unmatched-selector"
+	CheckMissingReference  = "missing-reference"
+	CheckBroadRule         = "broad-rule"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Finding is a problem found in a policy, or in one of its rules if Rule is set.
+type Finding struct {
+	Check     string `json:"check"`
+	Severity  string `json:"severity"`
+	Tier      string `json:"tier"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Policy    string `json:"policy"`
+	Direction string `json:"direction,omitempty"`
+	Rule      *int   `json:"rule,omitempty"`
+	Message   string `json:"message"`
+}
+
+// Resources holds the policies to analyse and the cluster state that they are analysed against.
+type Resources struct {
+	Tiers                 []apiv3.Tier
+	NetworkPolicies       []apiv3.NetworkPolicy
+	GlobalNetworkPolicies []apiv3.GlobalNetworkPolicy
+
+	// HaveClusterState is false if the endpoints, network sets and profiles below are not known,
+	// in which case the checks that need them are skipped.
+	HaveClusterState  bool
+	WorkloadEndpoints []libapiv3.WorkloadEndpoint
+	HostEndpoints     []apiv3.HostEndpoint
+	NetworkSets       []apiv3.NetworkSet
+	GlobalNetworkSets []apiv3.GlobalNetworkSet
+	Profiles          []apiv3.Profile
+}
+
+type policyInfo struct {
+	kind      string
+	namespace string
+	name      string
+	tier      string
+	tierOrder *float64
+	order     *float64
+
+	// Normalised selectors.
+	selector               string
+	namespaceSelector      string
+	serviceAccountSelector string
+
+	doNotTrack     bool
+	preDNAT        bool
+	applyOnForward bool
+	scheduled      bool
+
+	ingress []apiv3.Rule
+	egress  []apiv3.Rule
+}
+
+func (p *policyInfo) namespaced() bool {
+	return p.kind == apiv3.KindNetworkPolicy
+}
+
+func (p *policyInfo) key() string {
+	if p.namespaced() {
+		return p.namespace + "/" + p.name
+	}
+	return p.name
+}
+
+func (p *policyInfo) rules(dir apiv3.PolicyType) []apiv3.Rule {
+	if dir == apiv3.PolicyTypeIngress {
+		return p.ingress
+	}
+	return p.egress
+}
+
+func (p *policyInfo) finding(check, severity string, dir apiv3.PolicyType, rule int, format string, args ...interface{}) Finding {
+	f := Finding{
+		Check:     check,
+		Severity:  severity,
+		Tier:      p.tier,
+		Kind:      p.kind,
+		Namespace: p.namespace,
+		Policy:    p.name,
+		Direction: string(dir),
+		Message:   fmt.Sprintf(format, args...),
+	}
+	if rule >= 0 {
+		f.Rule = &rule
+	}
+	return f
+}
+
+// terminator is a rule that ends the evaluation of the traffic that it matches, either for the
+// rest of its tier (Pass) or for all tiers.
+type terminator struct {
+	policy *policyInfo
+	index  int
+	rule   *apiv3.Rule
+}
+
+func (t terminator) String() string {
+	return fmt.Sprintf("rule %d (%s) of %s %s", t.index, t.rule.Action, t.policy.kind, t.policy.key())
+}
+
+// Analyse analyses the policies in tier order and returns the problems that it finds, in the
+// order of the policies and rules that they apply to.
+func Analyse(res *Resources) []Finding {
+	policies := orderedPolicies(res)
+
+	var findings []Finding
+	for _, dir := range []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress} {
+		findings = append(findings, analyseReachability(policies, dir)...)
+	}
+	for _, p := range policies {
+		findings = append(findings, analyseBroadRules(p)...)
+	}
+	if res.HaveClusterState {
+		state := newClusterState(res)
+		for _, p := range policies {
+			findings = append(findings, state.analyseReferences(p)...)
+		}
+	}
+
+	position := map[string]int{}
+	for i, p := range policies {
+		position[p.kind+"/"+p.key()] = i
+	}
+	positionOf := func(f Finding) int {
+		key := f.Policy
+		if f.Namespace != "" {
+			key = f.Namespace + "/" + f.Policy
+		}
+		return position[f.Kind+"/"+key]
+	}
+	ruleOf := func(f Finding) int {
+		if f.Rule == nil {
+			return -1
+		}
+		return *f.Rule
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		fi, fj := findings[i], findings[j]
+		if pi, pj := positionOf(fi), positionOf(fj); pi != pj {
+			return pi < pj
+		}
+		if fi.Direction != fj.Direction {
+			// Policy-wide findings first, then Ingress, then Egress.
+			return directionOrder(fi.Direction) < directionOrder(fj.Direction)
+		}
+		return ruleOf(fi) < ruleOf(fj)
+	})
+	return findings
+}
+
+func directionOrder(dir string) int {
+	switch apiv3.PolicyType(dir) {
+	case apiv3.PolicyTypeIngress:
+		return 1
+	case apiv3.PolicyTypeEgress:
+		return 2
+	}
+	return 0
+}
+
+// orderedPolicies returns the policies in the order that they are evaluated: by tier order, then
+// by policy order, with unordered tiers and policies last and ties broken by name.
+func orderedPolicies(res *Resources) []*policyInfo {
+	tierOrders := map[string]*float64{}
+	defaultOrder := apiv3.DefaultTierOrder
+	tierOrders[names.DefaultTierName] = &defaultOrder
+	for _, t := range res.Tiers {
+		tierOrders[t.Name] = t.Spec.Order
+	}
+
+	var policies []*policyInfo
+	for i := range res.NetworkPolicies {
+		np := &res.NetworkPolicies[i]
+		policies = append(policies, &policyInfo{
+			kind:                   apiv3.KindNetworkPolicy,
+			namespace:              np.Namespace,
+			name:                   np.Name,
+			tier:                   tierName(np.Spec.Tier),
+			order:                  np.Spec.Order,
+			selector:               selector.Normalise(np.Spec.Selector),
+			serviceAccountSelector: normaliseOptional(np.Spec.ServiceAccountSelector),
+			scheduled:              np.Spec.Schedule != nil,
+			ingress:                np.Spec.Ingress,
+			egress:                 np.Spec.Egress,
+		})
+	}
+	for i := range res.GlobalNetworkPolicies {
+		gnp := &res.GlobalNetworkPolicies[i]
+		policies = append(policies, &policyInfo{
+			kind:                   apiv3.KindGlobalNetworkPolicy,
+			name:                   gnp.Name,
+			tier:                   tierName(gnp.Spec.Tier),
+			order:                  gnp.Spec.Order,
+			selector:               selector.Normalise(gnp.Spec.Selector),
+			namespaceSelector:      normaliseOptional(gnp.Spec.NamespaceSelector),
+			serviceAccountSelector: normaliseOptional(gnp.Spec.ServiceAccountSelector),
+			doNotTrack:             gnp.Spec.DoNotTrack,
+			preDNAT:                gnp.Spec.PreDNAT,
+			applyOnForward:         gnp.Spec.ApplyOnForward,
+			scheduled:              gnp.Spec.Schedule != nil,
+			ingress:                gnp.Spec.Ingress,
+			egress:                 gnp.Spec.Egress,
+		})
+	}
+	for _, p := range policies {
+		p.tierOrder = tierOrders[p.tier]
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		pi, pj := policies[i], policies[j]
+		if pi.tier != pj.tier {
+			if c := compareOrders(pi.tierOrder, pj.tierOrder); c != 0 {
+				return c < 0
+			}
+			return pi.tier < pj.tier
+		}
+		if c := compareOrders(pi.order, pj.order); c != 0 {
+			return c < 0
+		}
+		return pi.key() < pj.key()
+	})
+	return policies
+}
+
+func tierName(tier string) string {
+	if tier == "" {
+		return names.DefaultTierName
+	}
+	return tier
+}
+
+// normaliseOptional normalises a selector where an empty selector means that there is no
+// restriction, rather than all().
+func normaliseOptional(sel string) string {
+	if strings.TrimSpace(sel) == "" {
+		return ""
+	}
+	return selector.Normalise(sel)
+}
+
+// compareOrders compares two orders, where a nil order comes after any other.
+func compareOrders(a, b *float64) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	case *a < *b:
+		return -1
+	case *a > *b:
+		return 1
+	}
+	return 0
+}
+
+// analyseReachability finds the rules in the given direction that can never match because an
+// earlier rule, in the same policy or in an earlier policy that applies to all of the same
+// endpoints, matches all of their traffic and ends its evaluation.
+func analyseReachability(policies []*policyInfo, dir apiv3.PolicyType) []Finding {
+	var findings []Finding
+	var earlier []terminator
+	tier := ""
+	for _, p := range policies {
+		if p.tier != tier {
+			// A Pass only skips the rest of its own tier.
+			var kept []terminator
+			for _, t := range earlier {
+				if t.rule.Action != apiv3.Pass {
+					kept = append(kept, t)
+				}
+			}
+			earlier = kept
+			tier = p.tier
+		}
+
+		var covering []terminator
+		for _, t := range earlier {
+			if scopeCovers(t.policy, p) {
+				covering = append(covering, t)
+			}
+		}
+
+		var own []terminator
+		rules := p.rules(dir)
+		for i := range rules {
+			r := &rules[i]
+			candidates := append(append([]terminator(nil), covering...), own...)
+			if t, ok := firstUnconditional(candidates); ok {
+				findings = append(findings, p.finding(CheckUnreachableRule, SeverityError, dir, i,
+					"rule can never match: %s matches all traffic before it", t))
+				continue
+			}
+			shadowed := false
+			for _, t := range candidates {
+				if ruleCovers(t.policy, t.rule, p, r) {
+					findings = append(findings, p.finding(CheckShadowedRule, SeverityError, dir, i,
+						"rule can never match: %s matches all of its traffic", t))
+					shadowed = true
+					break
+				}
+			}
+			if shadowed || !isTerminal(r.Action) {
+				continue
+			}
+			own = append(own, terminator{policy: p, index: i, rule: r})
+		}
+
+		if !p.scheduled {
+			// A scheduled policy is not always in force, so it cannot hide later policies.
+			earlier = append(earlier, own...)
+		}
+	}
+	return findings
+}
+
+func firstUnconditional(ts []terminator) (terminator, bool) {
+	for _, t := range ts {
+		if isUnconditional(t.rule) {
+			return t, true
+		}
+	}
+	return terminator{}, false
+}
+
+func isTerminal(action apiv3.Action) bool {
+	switch action {
+	case apiv3.Allow, apiv3.Deny, apiv3.Pass, apiv3.ActionReject:
+		return true
+	}
+	return false
+}
+
+// isUnconditional returns true if the rule matches all traffic.
+func isUnconditional(r *apiv3.Rule) bool {
+	return ruleCovers(nil, r, nil, &apiv3.Rule{})
+}
+
+// scopeCovers returns true if policy p applies to all of the endpoints and traffic that policy
+// q applies to.
+func scopeCovers(p, q *policyInfo) bool {
+	if p.scheduled || p.doNotTrack != q.doNotTrack || p.preDNAT != q.preDNAT {
+		return false
+	}
+	if q.applyOnForward && !p.applyOnForward {
+		return false
+	}
+	if p.serviceAccountSelector != "" && p.serviceAccountSelector != q.serviceAccountSelector {
+		return false
+	}
+	if p.selector != "all()" && p.selector != q.selector {
+		return false
+	}
+	if p.namespaced() {
+		return q.namespaced() && p.namespace == q.namespace
+	}
+	if p.namespaceSelector == "" {
+		return true
+	}
+	if q.namespaced() {
+		return p.namespaceSelector == "all()"
+	}
+	return p.namespaceSelector == q.namespaceSelector
+}
+
+// ruleCovers returns true if rule a, in policy pa, matches all of the traffic that rule b, in
+// policy pb, matches.  It is conservative: it may return false even though a covers b.
+func ruleCovers(pa *policyInfo, a *apiv3.Rule, pb *policyInfo, b *apiv3.Rule) bool {
+	if !valueCovers(a.IPVersion, b.IPVersion) ||
+		!protocolCovers(a.Protocol, b.Protocol) ||
+		!protocolCovers(a.NotProtocol, b.NotProtocol) ||
+		!valueCovers(a.ICMP, b.ICMP) ||
+		!valueCovers(a.NotICMP, b.NotICMP) ||
+		!valueCovers(a.HTTP, b.HTTP) {
+		return false
+	}
+	namespaced := pa != nil && pa.namespaced()
+	return entityCovers(a.Source, b.Source, namespaced) &&
+		entityCovers(a.Destination, b.Destination, namespaced)
+}
+
+// valueCovers returns true if the optional match a is absent or the same as b.
+func valueCovers[T any](a, b *T) bool {
+	return a == nil || (b != nil && reflect.DeepEqual(*a, *b))
+}
+
+func protocolCovers(a, b *numorstring.Protocol) bool {
+	if a == nil {
+		return true
+	}
+	if b == nil {
+		return false
+	}
+	an, aErr := a.NumValue()
+	bn, bErr := b.NumValue()
+	if aErr == nil && bErr == nil {
+		return an == bn
+	}
+	return strings.EqualFold(a.String(), b.String())
+}
+
+func entityCovers(a, b apiv3.EntityRule, namespaced bool) bool {
+	if namespaced && a.NamespaceSelector == "" && b.NamespaceSelector != "" &&
+		(a.Selector != "" || a.NotSelector != "" || a.ServiceAccounts != nil) {
+		// In a NetworkPolicy, a selector without a namespace selector only matches
+		// endpoints in the policy's namespace.
+		return false
+	}
+	return netsCover(a.Nets, b.Nets) &&
+		listCovers(a.NotNets, b.NotNets) &&
+		selectorCovers(a.Selector, b.Selector) &&
+		selectorCovers(a.NotSelector, b.NotSelector) &&
+		selectorCovers(a.NamespaceSelector, b.NamespaceSelector) &&
+		valueCovers(a.Services, b.Services) &&
+		valueCovers(a.ServiceAccounts, b.ServiceAccounts) &&
+		portsCover(a.Ports, b.Ports) &&
+		listCovers(a.NotPorts, b.NotPorts) &&
+		domainsCover(a.Domains, b.Domains)
+}
+
+func listCovers[T any](a, b []T) bool {
+	return len(a) == 0 || reflect.DeepEqual(a, b)
+}
+
+func selectorCovers(a, b string) bool {
+	return a == "" || (b != "" && selector.Normalise(a) == selector.Normalise(b))
+}
+
+// netsCover returns true if every net in b is contained in one of the nets in a.
+func netsCover(a, b []string) bool {
+	if len(a) == 0 {
+		return true
+	}
+	if len(b) == 0 {
+		return false
+	}
+	for _, bs := range b {
+		bn := parseNet(bs)
+		if bn == nil {
+			return false
+		}
+		bOnes, bBits := bn.Mask.Size()
+		contained := false
+		for _, as := range a {
+			an := parseNet(as)
+			if an == nil {
+				continue
+			}
+			aOnes, aBits := an.Mask.Size()
+			if aBits == bBits && aOnes <= bOnes && an.Contains(bn.IP) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			return false
+		}
+	}
+	return true
+}
+
+func parseNet(s string) *net.IPNet {
+	if _, n, err := net.ParseCIDR(s); err == nil {
+		return n
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+// portsCover returns true if every port in b is contained in one of the ports in a.
+func portsCover(a, b []numorstring.Port) bool {
+	if len(a) == 0 {
+		return true
+	}
+	if len(b) == 0 {
+		return false
+	}
+	for _, bp := range b {
+		contained := false
+		for _, ap := range a {
+			if bp.PortName != "" {
+				contained = ap.PortName == bp.PortName
+			} else {
+				contained = ap.PortName == "" && ap.MinPort <= bp.MinPort && bp.MaxPort <= ap.MaxPort
+			}
+			if contained {
+				break
+			}
+		}
+		if !contained {
+			return false
+		}
+	}
+	return true
+}
+
+// domainsCover returns true if every domain in b is matched by one of the domains in a.
+func domainsCover(a, b []string) bool {
+	if len(a) == 0 {
+		return true
+	}
+	if len(b) == 0 {
+		return false
+	}
+	for _, bd := range b {
+		contained := false
+		for _, ad := range a {
+			if strings.EqualFold(ad, bd) {
+				contained = true
+			} else if suffix, ok := strings.CutPrefix(strings.ToLower(ad), "*"); ok {
+				contained = strings.HasSuffix(strings.ToLower(bd), suffix)
+			}
+			if contained {
+				break
+			}
+		}
+		if !contained {
+			return false
+		}
+	}
+	return true
+}
+
+// analyseBroadRules finds Allow rules that allow any protocol and port to or from anywhere.
+func analyseBroadRules(p *policyInfo) []Finding {
+	var findings []Finding
+	for _, dir := range []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress} {
+		for i, r := range p.rules(dir) {
+			if r.Action != apiv3.Allow || r.Protocol != nil || r.HTTP != nil ||
+				len(r.Destination.Ports) > 0 || len(r.Destination.NotPorts) > 0 ||
+				!entityUnrestricted(r.Source) || !entityUnrestricted(r.Destination) {
+				continue
+			}
+			peer := "from any source"
+			if dir == apiv3.PolicyTypeEgress {
+				peer = "to any destination"
+			}
+			findings = append(findings, p.finding(CheckBroadRule, SeverityWarning, dir, i,
+				"rule allows all protocols and ports %s", peer))
+		}
+	}
+	return findings
+}
+
+func entityUnrestricted(e apiv3.EntityRule) bool {
+	if e.Selector != "" || e.NotSelector != "" || e.NamespaceSelector != "" ||
+		e.ServiceAccounts != nil || e.Services != nil || len(e.Domains) > 0 || len(e.NotNets) > 0 {
+		return false
+	}
+	if len(e.Nets) == 0 {
+		return true
+	}
+	for _, n := range e.Nets {
+		if pn := parseNet(n); pn != nil {
+			if ones, _ := pn.Mask.Size(); ones == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type endpoint struct {
+	// namespace is empty for host endpoints.
+	namespace      string
+	serviceAccount string
+	labels         map[string]string
+}
+
+type labelled struct {
+	namespace string
+	name      string
+	labels    map[string]string
+}
+
+// clusterState holds the endpoints, network sets, namespaces and service accounts that
+// selectors and references are checked against.
+type clusterState struct {
+	workloads         []endpoint
+	hosts             []endpoint
+	networkSets       []labelled
+	globalNetworkSets []labelled
+	// Namespaces and service accounts are only known in Kubernetes, where they are
+	// mirrored as profiles.
+	namespaces      map[string]map[string]string
+	serviceAccounts []labelled
+}
+
+func newClusterState(res *Resources) *clusterState {
+	s := &clusterState{namespaces: map[string]map[string]string{}}
+	profileLabels := map[string]map[string]string{}
+	for _, prof := range res.Profiles {
+		profileLabels[prof.Name] = prof.Spec.LabelsToApply
+		if ns, ok := strings.CutPrefix(prof.Name, conversion.NamespaceProfileNamePrefix); ok {
+			s.namespaces[ns] = stripPrefix(prof.Spec.LabelsToApply, conversion.NamespaceLabelPrefix)
+		} else if nsAndName, ok := strings.CutPrefix(prof.Name, conversion.ServiceAccountProfileNamePrefix); ok {
+			// Namespace names cannot contain dots, so the first dot ends the namespace.
+			if ns, name, ok := strings.Cut(nsAndName, "."); ok {
+				s.serviceAccounts = append(s.serviceAccounts, labelled{
+					namespace: ns,
+					name:      name,
+					labels:    stripPrefix(prof.Spec.LabelsToApply, conversion.ServiceAccountLabelPrefix),
+				})
+			}
+		}
+	}
+	// Endpoints inherit the labels of their profiles.
+	withProfiles := func(labels map[string]string, profiles []string) map[string]string {
+		out := map[string]string{}
+		for _, prof := range profiles {
+			for k, v := range profileLabels[prof] {
+				out[k] = v
+			}
+		}
+		for k, v := range labels {
+			out[k] = v
+		}
+		return out
+	}
+	for _, wep := range res.WorkloadEndpoints {
+		s.workloads = append(s.workloads, endpoint{
+			namespace:      wep.Namespace,
+			serviceAccount: wep.Spec.ServiceAccountName,
+			labels:         withProfiles(wep.Labels, wep.Spec.Profiles),
+		})
+	}
+	for _, hep := range res.HostEndpoints {
+		s.hosts = append(s.hosts, endpoint{labels: withProfiles(hep.Labels, hep.Spec.Profiles)})
+	}
+	for _, ns := range res.NetworkSets {
+		s.networkSets = append(s.networkSets, labelled{namespace: ns.Namespace, name: ns.Name, labels: ns.Labels})
+	}
+	for _, gns := range res.GlobalNetworkSets {
+		s.globalNetworkSets = append(s.globalNetworkSets, labelled{name: gns.Name, labels: gns.Labels})
+	}
+	return s
+}
+
+func stripPrefix(labels map[string]string, prefix string) map[string]string {
+	out := map[string]string{}
+	for k, v := range labels {
+		if name, ok := strings.CutPrefix(k, prefix); ok {
+			out[name] = v
+		}
+	}
+	return out
+}
+
+// matches evaluates a selector that has already been validated, so a parse error can only
+// come from an invalid policy that the datastore would not have accepted.
+func matches(sel string, labels map[string]string) bool {
+	parsed, err := selector.Parse(sel)
+	if err != nil {
+		return false
+	}
+	return parsed.Evaluate(labels)
+}
+
+func (s *clusterState) namespacesKnown() bool {
+	return len(s.namespaces) > 0
+}
+
+// inNamespaces returns a function that returns true for the namespaces selected by the given
+// namespace selector.  If the namespaces are not known, it selects them all.
+func (s *clusterState) inNamespaces(nsSel string) func(ns string) bool {
+	if nsSel == "" || !s.namespacesKnown() {
+		return func(string) bool { return true }
+	}
+	return func(ns string) bool {
+		return matches(nsSel, s.namespaces[ns])
+	}
+}
+
+func (s *clusterState) serviceAccountLabels(ns, name string) map[string]string {
+	for _, sa := range s.serviceAccounts {
+		if sa.namespace == ns && sa.name == name {
+			return sa.labels
+		}
+	}
+	return nil
+}
+
+// appliesTo returns true if the policy applies to the endpoint.
+func (s *clusterState) appliesTo(p *policyInfo, ep endpoint) bool {
+	if p.namespaced() && (ep.namespace == "" || ep.namespace != p.namespace) {
+		return false
+	}
+	if p.namespaceSelector != "" && (ep.namespace == "" || !s.inNamespaces(p.namespaceSelector)(ep.namespace)) {
+		return false
+	}
+	if p.serviceAccountSelector != "" && len(s.serviceAccounts) > 0 && (ep.namespace == "" ||
+		!matches(p.serviceAccountSelector, s.serviceAccountLabels(ep.namespace, ep.serviceAccount))) {
+		return false
+	}
+	return matches(p.selector, ep.labels)
+}
+
+// selectsAnything returns true if the selector of a rule in policy p matches any endpoint or
+// network set.
+func (s *clusterState) selectsAnything(p *policyInfo, e apiv3.EntityRule) bool {
+	var candidates []map[string]string
+	switch {
+	case e.NamespaceSelector == "global()":
+		for _, ep := range s.hosts {
+			candidates = append(candidates, ep.labels)
+		}
+		for _, gns := range s.globalNetworkSets {
+			candidates = append(candidates, gns.labels)
+		}
+	case e.NamespaceSelector == "" && p.namespaced():
+		for _, ep := range s.workloads {
+			if ep.namespace == p.namespace {
+				candidates = append(candidates, ep.labels)
+			}
+		}
+		for _, ns := range s.networkSets {
+			if ns.namespace == p.namespace {
+				candidates = append(candidates, ns.labels)
+			}
+		}
+	default:
+		inNamespace := s.inNamespaces(e.NamespaceSelector)
+		for _, ep := range s.workloads {
+			if inNamespace(ep.namespace) {
+				candidates = append(candidates, ep.labels)
+			}
+		}
+		for _, ns := range s.networkSets {
+			if inNamespace(ns.namespace) {
+				candidates = append(candidates, ns.labels)
+			}
+		}
+		if e.NamespaceSelector == "" {
+			for _, ep := range s.hosts {
+				candidates = append(candidates, ep.labels)
+			}
+			for _, gns := range s.globalNetworkSets {
+				candidates = append(candidates, gns.labels)
+			}
+		}
+	}
+	for _, labels := range candidates {
+		if matches(e.Selector, labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// analyseReferences finds policies that apply to no endpoints and rules whose selectors and
+// service accounts refer to nothing that exists.
+func (s *clusterState) analyseReferences(p *policyInfo) []Finding {
+	var findings []Finding
+	applies := false
+	for _, ep := range append(append([]endpoint(nil), s.workloads...), s.hosts...) {
+		if s.appliesTo(p, ep) {
+			applies = true
+			break
+		}
+	}
+	if !applies {
+		findings = append(findings, p.finding(CheckUnmatchedSelector, SeverityWarning, "", -1,
+			"policy does not apply to any endpoint"))
+	}
+
+	for _, dir := range []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress} {
+		for i, r := range p.rules(dir) {
+			for _, side := range []struct {
+				name   string
+				entity apiv3.EntityRule
+			}{{"source", r.Source}, {"destination", r.Destination}} {
+				for _, msg := range s.missingReferences(p, side.entity) {
+					findings = append(findings, p.finding(CheckMissingReference, SeverityError, dir, i,
+						"%s %s", side.name, msg))
+				}
+			}
+		}
+	}
+	return findings
+}
+
+func (s *clusterState) missingReferences(p *policyInfo, e apiv3.EntityRule) []string {
+	var missing []string
+	nsSel := e.NamespaceSelector
+	if nsSel != "" && nsSel != "global()" && s.namespacesKnown() {
+		found := false
+		for _, labels := range s.namespaces {
+			if matches(nsSel, labels) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, "namespace selector matches no namespaces")
+		}
+	}
+	if e.Selector != "" && !s.selectsAnything(p, e) {
+		missing = append(missing, "selector matches no endpoints or network sets")
+	}
+	if e.ServiceAccounts == nil || len(s.serviceAccounts) == 0 {
+		return missing
+	}
+
+	var candidates []labelled
+	for _, sa := range s.serviceAccounts {
+		if p.namespaced() && nsSel == "" {
+			if sa.namespace != p.namespace {
+				continue
+			}
+		} else if !s.inNamespaces(nsSel)(sa.namespace) {
+			continue
+		}
+		candidates = append(candidates, sa)
+	}
+	for _, name := range e.ServiceAccounts.Names {
+		found := false
+		for _, sa := range candidates {
+			if sa.name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, fmt.Sprintf("service account %q does not exist", name))
+		}
+	}
+	if e.ServiceAccounts.Selector != "" {
+		found := false
+		for _, sa := range candidates {
+			if matches(e.ServiceAccounts.Selector, sa.labels) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, "service account selector matches no service accounts")
+		}
+	}
+	return missing
+}
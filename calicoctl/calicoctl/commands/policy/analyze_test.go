@@ -0,0 +1,397 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/projectcalico/calico/calicoctl/calicoctl/commands/policy"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+)
+
+var (
+	tcp     = numorstring.ProtocolFromString("TCP")
+	udp     = numorstring.ProtocolFromString("UDP")
+	denyAll = apiv3.Rule{Action: apiv3.Deny}
+	passAll = apiv3.Rule{Action: apiv3.Pass}
+)
+
+func order(o float64) *float64 {
+	return &o
+}
+
+func tier(name string, o float64) apiv3.Tier {
+	return apiv3.Tier{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       apiv3.TierSpec{Order: order(o)},
+	}
+}
+
+func gnp(name, tier string, o float64, selector string, ingress ...apiv3.Rule) apiv3.GlobalNetworkPolicy {
+	return apiv3.GlobalNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apiv3.GlobalNetworkPolicySpec{
+			Tier:     tier,
+			Order:    order(o),
+			Selector: selector,
+			Ingress:  ingress,
+		},
+	}
+}
+
+func np(namespace, name string, o float64, selector string, ingress ...apiv3.Rule) apiv3.NetworkPolicy {
+	return apiv3.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: apiv3.NetworkPolicySpec{
+			Order:    order(o),
+			Selector: selector,
+			Ingress:  ingress,
+		},
+	}
+}
+
+func allowTCP(ports ...numorstring.Port) apiv3.Rule {
+	return apiv3.Rule{
+		Action:      apiv3.Allow,
+		Protocol:    &tcp,
+		Destination: apiv3.EntityRule{Ports: ports},
+	}
+}
+
+type summary struct {
+	Check  string
+	Policy string
+	Rule   int
+}
+
+func summarise(findings []Finding) []summary {
+	var out []summary
+	for _, f := range findings {
+		s := summary{Check: f.Check, Policy: f.Policy, Rule: -1}
+		if f.Rule != nil {
+			s.Rule = *f.Rule
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+var _ = Describe("Policy analysis", func() {
+	Describe("reachability", func() {
+		It("should report rules after an unconditional rule in the same policy", func() {
+			findings := Analyse(&Resources{
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("default.p1", "", 1, "app == 'a'",
+						allowTCP(numorstring.SinglePort(80)),
+						denyAll,
+						allowTCP(numorstring.SinglePort(443)),
+					),
+				},
+			})
+			Expect(findings).To(HaveLen(1))
+			Expect(findings[0].Check).To(Equal(CheckUnreachableRule))
+			Expect(findings[0].Severity).To(Equal(SeverityError))
+			Expect(*findings[0].Rule).To(Equal(2))
+			Expect(findings[0].Direction).To(Equal("Ingress"))
+			Expect(findings[0].Message).To(ContainSubstring("rule 1 (Deny) of GlobalNetworkPolicy default.p1"))
+		})
+
+		It("should report rules whose traffic is all matched by an earlier rule", func() {
+			findings := Analyse(&Resources{
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("default.p1", "", 1, "app == 'a'",
+						apiv3.Rule{
+							Action:   apiv3.Deny,
+							Protocol: &tcp,
+							Source:   apiv3.EntityRule{Nets: []string{"10.0.0.0/8"}},
+							Destination: apiv3.EntityRule{
+								Ports: []numorstring.Port{{MinPort: 8000, MaxPort: 9000}},
+							},
+						},
+						// Narrower source and ports: shadowed.
+						apiv3.Rule{
+							Action:   apiv3.Allow,
+							Protocol: &tcp,
+							Source:   apiv3.EntityRule{Nets: []string{"10.1.0.0/16", "10.2.3.4"}},
+							Destination: apiv3.EntityRule{
+								Ports: []numorstring.Port{numorstring.SinglePort(8080)},
+							},
+						},
+						// Different protocol: reachable.
+						apiv3.Rule{
+							Action:   apiv3.Allow,
+							Protocol: &udp,
+							Source:   apiv3.EntityRule{Nets: []string{"10.1.0.0/16"}},
+						},
+						// Port outside the range: reachable.
+						apiv3.Rule{
+							Action:   apiv3.Allow,
+							Protocol: &tcp,
+							Source:   apiv3.EntityRule{Nets: []string{"10.1.0.0/16"}},
+							Destination: apiv3.EntityRule{
+								Ports: []numorstring.Port{numorstring.SinglePort(9090)},
+							},
+						},
+					),
+				},
+			})
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckShadowedRule, "default.p1", 1},
+			}))
+		})
+
+		It("should not treat Log rules as ending evaluation", func() {
+			findings := Analyse(&Resources{
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("default.p1", "", 1, "app == 'a'",
+						apiv3.Rule{Action: apiv3.Log},
+						allowTCP(numorstring.SinglePort(80)),
+					),
+				},
+			})
+			Expect(findings).To(BeEmpty())
+		})
+
+		It("should only let an unconditional Pass hide the rest of its tier", func() {
+			findings := Analyse(&Resources{
+				Tiers: []apiv3.Tier{tier("security", 100)},
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("security.pass", "security", 1, "all()", passAll),
+					gnp("security.later", "security", 2, "app == 'a'", allowTCP(numorstring.SinglePort(80))),
+					gnp("default.p1", "", 1, "app == 'a'", allowTCP(numorstring.SinglePort(80))),
+				},
+			})
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckUnreachableRule, "security.later", 0},
+			}))
+		})
+
+		It("should let an unconditional Deny hide later tiers", func() {
+			findings := Analyse(&Resources{
+				Tiers: []apiv3.Tier{tier("security", 100)},
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("default.p1", "", 1, "app == 'a'", allowTCP(numorstring.SinglePort(80))),
+					gnp("security.deny", "security", 1, "", denyAll),
+				},
+				NetworkPolicies: []apiv3.NetworkPolicy{
+					np("prod", "np1", 1, "app == 'a'", allowTCP(numorstring.SinglePort(80))),
+				},
+			})
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckUnreachableRule, "default.p1", 0},
+				{CheckUnreachableRule, "np1", 0},
+			}))
+		})
+
+		It("should only compare policies that apply to the same endpoints", func() {
+			findings := Analyse(&Resources{
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("default.deny-b", "", 1, "app == 'b'", denyAll),
+					gnp("default.allow-a", "", 2, "app == 'a'", allowTCP(numorstring.SinglePort(80))),
+				},
+				NetworkPolicies: []apiv3.NetworkPolicy{
+					np("dev", "deny-all", 1, "", denyAll),
+					np("prod", "allow-web", 2, "", allowTCP(numorstring.SinglePort(80))),
+					np("dev", "allow-web", 2, "", allowTCP(numorstring.SinglePort(80))),
+				},
+			})
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckUnreachableRule, "allow-web", 0},
+			}))
+			Expect(findings[0].Namespace).To(Equal("dev"))
+		})
+
+		It("should report a rule shadowed by a rule in an earlier policy with the same selector", func() {
+			findings := Analyse(&Resources{
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("default.p1", "", 1, "app == 'a'", allowTCP(numorstring.Port{MinPort: 80, MaxPort: 90})),
+					gnp("default.p2", "", 2, "app=='a'", allowTCP(numorstring.SinglePort(85))),
+				},
+			})
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckShadowedRule, "default.p2", 0},
+			}))
+		})
+
+		It("should not let a scheduled policy hide later policies", func() {
+			scheduled := gnp("default.p1", "", 1, "", denyAll)
+			scheduled.Spec.Schedule = &apiv3.PolicySchedule{}
+			findings := Analyse(&Resources{
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					scheduled,
+					gnp("default.p2", "", 2, "app == 'a'", allowTCP(numorstring.SinglePort(80))),
+				},
+			})
+			Expect(findings).To(BeEmpty())
+		})
+
+		It("should not compare a namespaced selector with a rule that selects other namespaces", func() {
+			findings := Analyse(&Resources{
+				NetworkPolicies: []apiv3.NetworkPolicy{
+					np("prod", "p1", 1, "",
+						apiv3.Rule{Action: apiv3.Deny, Source: apiv3.EntityRule{Selector: "role == 'db'"}},
+						apiv3.Rule{Action: apiv3.Allow, Source: apiv3.EntityRule{
+							Selector:          "role == 'db'",
+							NamespaceSelector: "all()",
+						}},
+					),
+				},
+			})
+			Expect(findings).To(BeEmpty())
+		})
+	})
+
+	Describe("broad rules", func() {
+		It("should warn about rules that allow anything from anywhere", func() {
+			findings := Analyse(&Resources{
+				GlobalNetworkPolicies: []apiv3.GlobalNetworkPolicy{
+					gnp("default.p1", "", 1, "app == 'a'",
+						apiv3.Rule{Action: apiv3.Allow, Source: apiv3.EntityRule{Nets: []string{"0.0.0.0/0"}}},
+					),
+					gnp("default.p2", "", 2, "app == 'b'",
+						apiv3.Rule{Action: apiv3.Allow, Source: apiv3.EntityRule{Nets: []string{"10.0.0.0/8"}}},
+						allowTCP(),
+					),
+				},
+			})
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckBroadRule, "default.p1", 0},
+			}))
+			Expect(findings[0].Severity).To(Equal(SeverityWarning))
+		})
+	})
+
+	Describe("references", func() {
+		var res *Resources
+
+		BeforeEach(func() {
+			res = &Resources{
+				HaveClusterState: true,
+				WorkloadEndpoints: []libapiv3.WorkloadEndpoint{{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "prod",
+						Name:      "node1-k8s-web-eth0",
+						Labels:    map[string]string{"app": "web", "projectcalico.org/namespace": "prod"},
+					},
+					Spec: libapiv3.WorkloadEndpointSpec{
+						ServiceAccountName: "web",
+						Profiles:           []string{"kns.prod", "ksa.prod.web"},
+					},
+				}},
+				NetworkSets: []apiv3.NetworkSet{{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "prod",
+						Name:      "partners",
+						Labels:    map[string]string{"set": "partners"},
+					},
+				}},
+				GlobalNetworkSets: []apiv3.GlobalNetworkSet{{
+					ObjectMeta: metav1.ObjectMeta{Name: "scanners", Labels: map[string]string{"set": "scanners"}},
+				}},
+				Profiles: []apiv3.Profile{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "kns.prod"},
+						Spec: apiv3.ProfileSpec{LabelsToApply: map[string]string{
+							"pcns.env":                    "prod",
+							"pcns.projectcalico.org/name": "prod",
+						}},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "ksa.prod.web"},
+						Spec: apiv3.ProfileSpec{LabelsToApply: map[string]string{
+							"pcsa.tier":                   "frontend",
+							"pcsa.projectcalico.org/name": "web",
+						}},
+					},
+				},
+			}
+		})
+
+		It("should accept selectors and references that match the cluster state", func() {
+			res.NetworkPolicies = []apiv3.NetworkPolicy{
+				np("prod", "p1", 1, "app == 'web'",
+					apiv3.Rule{Action: apiv3.Allow, Protocol: &tcp, Source: apiv3.EntityRule{Selector: "set == 'partners'"}},
+					apiv3.Rule{Action: apiv3.Allow, Protocol: &udp, Source: apiv3.EntityRule{
+						Selector:          "set == 'scanners'",
+						NamespaceSelector: "global()",
+					}},
+					apiv3.Rule{Action: apiv3.Allow, Protocol: &udp, Source: apiv3.EntityRule{
+						ServiceAccounts: &apiv3.ServiceAccountMatch{Names: []string{"web"}, Selector: "tier == 'frontend'"},
+					}},
+				),
+			}
+			res.GlobalNetworkPolicies = []apiv3.GlobalNetworkPolicy{
+				gnp("default.g1", "", 1, "app == 'web'",
+					apiv3.Rule{Action: apiv3.Deny, Protocol: &tcp, Source: apiv3.EntityRule{NamespaceSelector: "env == 'prod'"}},
+				),
+			}
+			res.GlobalNetworkPolicies[0].Spec.NamespaceSelector = "env == 'prod'"
+			res.GlobalNetworkPolicies[0].Spec.ServiceAccountSelector = "tier == 'frontend'"
+			Expect(Analyse(res)).To(BeEmpty())
+		})
+
+		It("should report policies that apply to no endpoints", func() {
+			res.NetworkPolicies = []apiv3.NetworkPolicy{
+				np("dev", "p1", 1, "app == 'web'", allowTCP()),
+			}
+			res.GlobalNetworkPolicies = []apiv3.GlobalNetworkPolicy{
+				gnp("default.g1", "", 1, "app == 'web'", allowTCP()),
+			}
+			res.GlobalNetworkPolicies[0].Spec.NamespaceSelector = "env == 'dev'"
+			findings := Analyse(res)
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckUnmatchedSelector, "default.g1", -1},
+				{CheckUnmatchedSelector, "p1", -1},
+			}))
+			Expect(findings[0].Direction).To(BeEmpty())
+		})
+
+		It("should report rules that refer to nothing", func() {
+			res.NetworkPolicies = []apiv3.NetworkPolicy{
+				np("prod", "p1", 1, "app == 'web'",
+					// The network set is in another namespace.
+					apiv3.Rule{Action: apiv3.Allow, Protocol: &tcp, Source: apiv3.EntityRule{Selector: "set == 'scanners'"}},
+					apiv3.Rule{Action: apiv3.Allow, Protocol: &udp, Source: apiv3.EntityRule{NamespaceSelector: "env == 'dev'"}},
+					apiv3.Rule{Action: apiv3.Allow, Protocol: &udp, Destination: apiv3.EntityRule{
+						ServiceAccounts: &apiv3.ServiceAccountMatch{Names: []string{"api"}},
+					}},
+				),
+			}
+			findings := Analyse(res)
+			Expect(summarise(findings)).To(Equal([]summary{
+				{CheckMissingReference, "p1", 0},
+				{CheckMissingReference, "p1", 1},
+				{CheckMissingReference, "p1", 2},
+			}))
+			Expect(findings[0].Message).To(Equal("source selector matches no endpoints or network sets"))
+			Expect(findings[1].Message).To(Equal("source namespace selector matches no namespaces"))
+			Expect(findings[2].Message).To(Equal(`destination service account "api" does not exist`))
+		})
+
+		It("should skip the checks without the cluster state", func() {
+			res.HaveClusterState = false
+			res.NetworkPolicies = []apiv3.NetworkPolicy{
+				np("dev", "p1", 1, "app == 'web'",
+					apiv3.Rule{Action: apiv3.Allow, Protocol: &tcp, Source: apiv3.EntityRule{Selector: "set == 'nothing'"}},
+				),
+			}
+			Expect(Analyse(res)).To(BeEmpty())
+		})
+	})
+})
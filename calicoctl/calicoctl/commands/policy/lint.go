@@ -0,0 +1,296 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/olekukonko/tablewriter"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/argutils"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/file"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/resourcemgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// Lint analyses a set of policies and reports the problems that it finds.
+func Lint(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> policy lint [--filename=<FILENAME>] [--recursive] [--offline] [--tier=<TIER>]
+                [--output=<OUTPUT>] [--config=<CONFIG>] [--allow-version-mismatch]
+
+Examples:
+  # Lint the policies in the datastore.
+  <BINARY_NAME> policy lint
+
+  # Lint the policies in a directory of manifests against the endpoints in the cluster.
+  <BINARY_NAME> policy lint -f ./policies/ -R
+
+  # Lint the policies in a manifest without access to a cluster, in JSON format for CI.
+  <BINARY_NAME> policy lint -f policies.yaml --offline -o json
+
+Options:
+  -h --help                    Show this screen.
+  -f --filename=<FILENAME>     Filename to read the policies from instead of the datastore.
+                               If set to "-" loads from stdin.  If filename is a directory,
+                               all .json .yaml and .yml files within that directory are read.
+                               Tiers, network sets, endpoints and profiles in the files are
+                               used alongside those in the datastore.
+  -R --recursive               Process the filename specified in -f or --filename recursively.
+     --offline                 Do not connect to the datastore.  Only the resources in the
+                               files are used, and the checks against endpoints, network sets
+                               and service accounts are skipped.
+     --tier=<TIER>             Only report problems in policies in this tier.
+  -o --output=<OUTPUT>         Output format.  One of: table, json.  [default: table]
+  -c --config=<CONFIG>         Path to the file containing connection configuration in
+                               YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The policy lint command analyses policies in tier order and reports:
+
+  - unreachable-rule    rules after a rule, in the same policy or an earlier policy
+                        that applies to the same endpoints, that matches all traffic
+                        with a Deny, Pass, Reject or Allow action.
+  - shadowed-rule       rules whose traffic is all matched by such an earlier rule.
+  - unmatched-selector  policies that do not apply to any current endpoint.
+  - missing-reference   rule selectors that match no endpoints or network sets, and
+                        references to service accounts and namespaces that do not exist.
+  - broad-rule          Allow rules that allow any protocol and port from any source
+                        or to any destination.
+
+  unreachable-rule, shadowed-rule and missing-reference are errors, the others are
+  warnings.  The command exits with a non-zero status if it finds any errors.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	output := parsedArgs["--output"].(string)
+	if output != "table" && output != "json" {
+		return fmt.Errorf("Unrecognized output format '%s', expected one of: table, json", output)
+	}
+	_, fromFile := parsedArgs["--filename"].(string)
+	offline := argutils.ArgBoolOrFalse(parsedArgs, "--offline")
+	if offline && !fromFile {
+		return fmt.Errorf("--offline requires --filename")
+	}
+
+	res := &Resources{}
+	if fromFile {
+		err := file.Iter(parsedArgs, func(modifiedArgs map[string]interface{}) error {
+			return loadFile(res, modifiedArgs["--filename"].(string))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if !offline {
+		err = common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"])
+		if err != nil {
+			return err
+		}
+		c, err := clientmgr.NewClient(parsedArgs["--config"].(string))
+		if err != nil {
+			return err
+		}
+		if err := loadDatastore(context.Background(), c, res, !fromFile); err != nil {
+			return err
+		}
+	}
+
+	findings := Analyse(res)
+	if tier, ok := parsedArgs["--tier"].(string); ok {
+		var filtered []Finding
+		for _, f := range findings {
+			if f.Tier == tier {
+				filtered = append(filtered, f)
+			}
+		}
+		findings = filtered
+	}
+
+	if output == "json" {
+		err = printJSON(os.Stdout, findings)
+	} else {
+		printTable(os.Stdout, findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	numErrors := 0
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			numErrors++
+		}
+	}
+	if numErrors > 0 {
+		return fmt.Errorf("Found %d policy error(s)", numErrors)
+	}
+	return nil
+}
+
+// loadFile adds the resources in the given file to res.
+func loadFile(res *Resources, filename string) error {
+	loaded, err := resourcemgr.CreateResourcesFromFile(filename)
+	if err != nil {
+		return fmt.Errorf("Failed to execute command: %v", err)
+	}
+	var objs []runtime.Object
+	for _, obj := range loaded {
+		if list, ok := obj.(resourcemgr.ResourceListObject); ok {
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				return err
+			}
+			objs = append(objs, items...)
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	for _, obj := range objs {
+		switch r := obj.(type) {
+		case *apiv3.Tier:
+			res.Tiers = append(res.Tiers, *r)
+		case *apiv3.NetworkPolicy:
+			if r.Namespace == "" {
+				r.Namespace = "default"
+			}
+			res.NetworkPolicies = append(res.NetworkPolicies, *r)
+		case *apiv3.GlobalNetworkPolicy:
+			res.GlobalNetworkPolicies = append(res.GlobalNetworkPolicies, *r)
+		case *libapiv3.WorkloadEndpoint:
+			res.WorkloadEndpoints = append(res.WorkloadEndpoints, *r)
+		case *apiv3.HostEndpoint:
+			res.HostEndpoints = append(res.HostEndpoints, *r)
+		case *apiv3.NetworkSet:
+			res.NetworkSets = append(res.NetworkSets, *r)
+		case *apiv3.GlobalNetworkSet:
+			res.GlobalNetworkSets = append(res.GlobalNetworkSets, *r)
+		case *apiv3.Profile:
+			res.Profiles = append(res.Profiles, *r)
+		}
+	}
+	return nil
+}
+
+// loadDatastore adds the tiers and cluster state in the datastore to res and, if withPolicies
+// is set, the policies.
+func loadDatastore(ctx context.Context, c client.Interface, res *Resources, withPolicies bool) error {
+	tiers, err := c.Tiers().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to list tiers: %v", err)
+	}
+	res.Tiers = append(res.Tiers, tiers.Items...)
+
+	if withPolicies {
+		nps, err := c.NetworkPolicies().List(ctx, options.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("Failed to list network policies: %v", err)
+		}
+		res.NetworkPolicies = append(res.NetworkPolicies, nps.Items...)
+		gnps, err := c.GlobalNetworkPolicies().List(ctx, options.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("Failed to list global network policies: %v", err)
+		}
+		res.GlobalNetworkPolicies = append(res.GlobalNetworkPolicies, gnps.Items...)
+	}
+
+	weps, err := c.WorkloadEndpoints().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to list workload endpoints: %v", err)
+	}
+	res.WorkloadEndpoints = append(res.WorkloadEndpoints, weps.Items...)
+	heps, err := c.HostEndpoints().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to list host endpoints: %v", err)
+	}
+	res.HostEndpoints = append(res.HostEndpoints, heps.Items...)
+	nss, err := c.NetworkSets().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to list network sets: %v", err)
+	}
+	res.NetworkSets = append(res.NetworkSets, nss.Items...)
+	gnss, err := c.GlobalNetworkSets().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to list global network sets: %v", err)
+	}
+	res.GlobalNetworkSets = append(res.GlobalNetworkSets, gnss.Items...)
+	profiles, err := c.Profiles().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to list profiles: %v", err)
+	}
+	res.Profiles = append(res.Profiles, profiles.Items...)
+
+	res.HaveClusterState = true
+	return nil
+}
+
+func printJSON(w io.Writer, findings []Finding) error {
+	if findings == nil {
+		findings = []Finding{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(findings)
+}
+
+func printTable(w io.Writer, findings []Finding) {
+	if len(findings) == 0 {
+		fmt.Fprintln(w, "No problems found.")
+		return
+	}
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"SEVERITY", "CHECK", "TIER", "POLICY", "DIRECTION", "RULE", "MESSAGE"})
+	table.SetAutoWrapText(false)
+	for _, f := range findings {
+		policy := f.Policy
+		if f.Namespace != "" {
+			policy = f.Namespace + "/" + f.Policy
+		}
+		rule := ""
+		if f.Rule != nil {
+			rule = strconv.Itoa(*f.Rule)
+		}
+		table.Append([]string{f.Severity, f.Check, f.Tier, policy, f.Direction, rule, f.Message})
+	}
+	table.Render()
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+package policy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/reporters"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/testutils"
+)
+
+func init() {
+	testutils.HookLogrusForGinkgo()
+}
+
+func TestPolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	junitReporter := reporters.NewJUnitReporter("../../../report/policy_suite.xml")
+	RunSpecsWithDefaultAndCustomReporters(t, "Policy Suite", []Reporter{junitReporter})
+}
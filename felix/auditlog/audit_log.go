@@ -0,0 +1,389 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auditlog writes structured audit records for the connections that match policy rules
+// with audit enabled.  The dataplane copies the first packet of each such connection to Felix,
+// through NFLOG for iptables and nftables and through a perf event ring for BPF.  The Logger maps
+// it back to the rule and the local endpoints involved and writes a JSON record to its sinks.
+package auditlog
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/bpf/audit"
+	"github.com/projectcalico/calico/felix/nfnetlink"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/felix/types"
+)
+
+var counterVecRecords = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "felix_policy_audit_records",
+	Help: "Number of policy audit records handled by Felix, broken down by result.",
+}, []string{"result"})
+
+func init() {
+	prometheus.MustRegister(counterVecRecords)
+}
+
+const (
+	resultWritten     = "written"
+	resultUnknownRule = "unknown-rule"
+	resultWriteFailed = "write-failed"
+
+	kindPolicy  = "Policy"
+	kindProfile = "Profile"
+)
+
+// Record is the audit record of a connection that matched a rule with audit enabled.
+type Record struct {
+	Time time.Time `json:"time"`
+
+	// Kind is either "Policy" or "Profile".
+	Kind      string `json:"kind"`
+	Tier      string `json:"tier,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Policy    string `json:"policy"`
+	Direction string `json:"direction"`
+	RuleIndex int    `json:"ruleIndex"`
+	// Verdict is the action of the rule.
+	Verdict string `json:"verdict"`
+
+	Protocol string `json:"protocol"`
+	SrcIP    string `json:"srcIP"`
+	SrcPort  int    `json:"srcPort,omitempty"`
+	DstIP    string `json:"dstIP"`
+	DstPort  int    `json:"dstPort,omitempty"`
+
+	// The local workload endpoints that sent and received the packet, if any.
+	SrcEndpoint *Endpoint `json:"srcEndpoint,omitempty"`
+	DstEndpoint *Endpoint `json:"dstEndpoint,omitempty"`
+}
+
+type Endpoint struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// MatchIDFunc calculates the ID that the BPF policy programs use for a rule.
+type MatchIDFunc func(dir, action, owner, name string, idx int) uint64
+
+type ruleInfo struct {
+	kind      string
+	tier      string
+	namespace string
+	name      string
+	direction string
+	index     int
+	verdict   string
+}
+
+type ownerRules struct {
+	ruleIDs  []string
+	matchIDs []uint64
+}
+
+// Logger turns the packets and events copied from the dataplane into audit records.  It is a
+// dataplane manager so that it learns about the active policies and local endpoints.
+type Logger struct {
+	mutex          sync.Mutex
+	rulesByID      map[string]*ruleInfo
+	rulesByMatchID map[uint64]*ruleInfo
+	rulesByOwner   map[string]ownerRules
+	endpointsByIP  map[string]*Endpoint
+	endpointIPs    map[types.WorkloadEndpointID][]string
+
+	matchIDFunc MatchIDFunc
+	sinks       []io.WriteCloser
+	nowFunc     func() time.Time
+}
+
+// New creates a Logger that writes records to the given sinks.  matchIDFunc is only needed in
+// BPF mode, to map events back to rules.
+func New(sinks []io.WriteCloser, matchIDFunc MatchIDFunc) *Logger {
+	return &Logger{
+		rulesByID:      map[string]*ruleInfo{},
+		rulesByMatchID: map[uint64]*ruleInfo{},
+		rulesByOwner:   map[string]ownerRules{},
+		endpointsByIP:  map[string]*Endpoint{},
+		endpointIPs:    map[types.WorkloadEndpointID][]string{},
+		matchIDFunc:    matchIDFunc,
+		sinks:          sinks,
+		nowFunc:        time.Now,
+	}
+}
+
+func (l *Logger) OnUpdate(msg interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	switch msg := msg.(type) {
+	case *proto.ActivePolicyUpdate:
+		id := types.ProtoToPolicyID(msg.GetId())
+		l.removeRules(policyKey(id))
+		l.addRules(policyKey(id), &ruleInfo{
+			kind:      kindPolicy,
+			tier:      id.Tier,
+			namespace: msg.Policy.Namespace,
+			name:      id.Name,
+		}, msg.Policy.InboundRules, msg.Policy.OutboundRules)
+	case *proto.ActivePolicyRemove:
+		l.removeRules(policyKey(types.ProtoToPolicyID(msg.GetId())))
+	case *proto.ActiveProfileUpdate:
+		id := types.ProtoToProfileID(msg.GetId())
+		l.removeRules(profileKey(id))
+		l.addRules(profileKey(id), &ruleInfo{
+			kind: kindProfile,
+			name: id.Name,
+		}, msg.Profile.InboundRules, msg.Profile.OutboundRules)
+	case *proto.ActiveProfileRemove:
+		l.removeRules(profileKey(types.ProtoToProfileID(msg.GetId())))
+	case *proto.WorkloadEndpointUpdate:
+		id := types.ProtoToWorkloadEndpointID(msg.GetId())
+		l.removeEndpoint(id)
+		ep := workloadEndpoint(id)
+		var ips []string
+		for _, nets := range [][]string{msg.Endpoint.Ipv4Nets, msg.Endpoint.Ipv6Nets} {
+			for _, n := range nets {
+				ip, _, err := net.ParseCIDR(n)
+				if err != nil {
+					continue
+				}
+				ips = append(ips, ip.String())
+				l.endpointsByIP[ip.String()] = ep
+			}
+		}
+		l.endpointIPs[id] = ips
+	case *proto.WorkloadEndpointRemove:
+		l.removeEndpoint(types.ProtoToWorkloadEndpointID(msg.GetId()))
+	}
+}
+
+func (l *Logger) CompleteDeferredWork() error {
+	return nil
+}
+
+func policyKey(id types.PolicyID) string {
+	return "pol:" + id.Tier + "/" + id.Name
+}
+
+func profileKey(id types.ProfileID) string {
+	return "prof:" + id.Name
+}
+
+// workloadEndpoint returns the name and namespace of a workload.  Kubernetes workload IDs have
+// the form <namespace>/<pod name>.
+func workloadEndpoint(id types.WorkloadEndpointID) *Endpoint {
+	if ns, name, ok := strings.Cut(id.WorkloadId, "/"); ok {
+		return &Endpoint{Name: name, Namespace: ns}
+	}
+	return &Endpoint{Name: id.WorkloadId}
+}
+
+func (l *Logger) addRules(key string, owner *ruleInfo, inbound, outbound []*proto.Rule) {
+	var or ownerRules
+	add := func(dir string, rs []*proto.Rule) {
+		for i, r := range rs {
+			if !r.Audit {
+				continue
+			}
+			info := *owner
+			info.direction = strings.ToLower(dir)
+			info.index = i
+			info.verdict = verdict(r.Action)
+			if r.RuleId != "" {
+				l.rulesByID[r.RuleId] = &info
+				or.ruleIDs = append(or.ruleIDs, r.RuleId)
+			}
+			if l.matchIDFunc != nil {
+				id := l.matchIDFunc(dir, r.Action, owner.kind, owner.name, i)
+				l.rulesByMatchID[id] = &info
+				or.matchIDs = append(or.matchIDs, id)
+			}
+		}
+	}
+	add("Ingress", inbound)
+	add("Egress", outbound)
+	if len(or.ruleIDs) > 0 || len(or.matchIDs) > 0 {
+		l.rulesByOwner[key] = or
+	}
+}
+
+func (l *Logger) removeRules(key string) {
+	or, ok := l.rulesByOwner[key]
+	if !ok {
+		return
+	}
+	for _, id := range or.ruleIDs {
+		delete(l.rulesByID, id)
+	}
+	for _, id := range or.matchIDs {
+		delete(l.rulesByMatchID, id)
+	}
+	delete(l.rulesByOwner, key)
+}
+
+func (l *Logger) removeEndpoint(id types.WorkloadEndpointID) {
+	for _, ip := range l.endpointIPs[id] {
+		delete(l.endpointsByIP, ip)
+	}
+	delete(l.endpointIPs, id)
+}
+
+func verdict(action string) string {
+	switch action {
+	case "", "allow":
+		return "allow"
+	case "next-tier":
+		return "pass"
+	}
+	return action
+}
+
+// Start writes records for the packets from the NFLOG audit group and the events from the BPF
+// programs, either of which may be nil.  It returns immediately.
+func (l *Logger) Start(packets <-chan *nfnetlink.NflogPacket, events <-chan audit.Event) {
+	go l.loop(packets, events)
+}
+
+func (l *Logger) loop(packets <-chan *nfnetlink.NflogPacket, events <-chan audit.Event) {
+	for packets != nil || events != nil {
+		select {
+		case p, ok := <-packets:
+			if !ok {
+				packets = nil
+				continue
+			}
+			l.write(l.recordForPacket(p))
+		case e, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			l.write(l.recordForEvent(e))
+		}
+	}
+}
+
+// recordForPacket builds the record for a packet copied by an audit NFLOG rule, whose prefix
+// holds the ID of the rule.  It returns nil if the rule is unknown.
+func (l *Logger) recordForPacket(p *nfnetlink.NflogPacket) *Record {
+	prefix := string(p.Prefix.Prefix[:p.Prefix.Len])
+	ruleID, ok := strings.CutPrefix(prefix, rules.AuditNflogPrefix)
+	if !ok {
+		return nil
+	}
+	t := p.Tuple
+	srcIP, dstIP := net.IP(t.Src[:]), net.IP(t.Dst[:])
+	if p.Header.HwProtocol == nfnetlink.IPv4Proto {
+		srcIP, dstIP = srcIP.To4(), dstIP.To4()
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.record(l.rulesByID[ruleID], t.Proto, srcIP, t.L4Src.Port, dstIP, t.L4Dst.Port)
+}
+
+// recordForEvent builds the record for an event written by a BPF policy program.  It returns
+// nil if the rule is unknown.
+func (l *Logger) recordForEvent(e audit.Event) *Record {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.record(l.rulesByMatchID[e.MatchID], int(e.Proto), e.SrcIP, int(e.SrcPort), e.DstIP, int(e.DstPort))
+}
+
+func (l *Logger) record(info *ruleInfo, ipProto int, srcIP net.IP, srcPort int, dstIP net.IP, dstPort int) *Record {
+	if info == nil {
+		counterVecRecords.WithLabelValues(resultUnknownRule).Inc()
+		return nil
+	}
+	r := &Record{
+		Time:        l.nowFunc(),
+		Kind:        info.kind,
+		Tier:        info.tier,
+		Namespace:   info.namespace,
+		Policy:      info.name,
+		Direction:   info.direction,
+		RuleIndex:   info.index,
+		Verdict:     info.verdict,
+		Protocol:    protocolName(ipProto),
+		SrcIP:       srcIP.String(),
+		DstIP:       dstIP.String(),
+		SrcEndpoint: l.endpointsByIP[srcIP.String()],
+		DstEndpoint: l.endpointsByIP[dstIP.String()],
+	}
+	if hasPorts(ipProto) {
+		r.SrcPort = srcPort
+		r.DstPort = dstPort
+	}
+	return r
+}
+
+func protocolName(ipProto int) string {
+	switch ipProto {
+	case 1:
+		return "icmp"
+	case 6:
+		return "tcp"
+	case 17:
+		return "udp"
+	case 58:
+		return "icmpv6"
+	case 132:
+		return "sctp"
+	}
+	return strconv.Itoa(ipProto)
+}
+
+func hasPorts(ipProto int) bool {
+	return ipProto == 6 || ipProto == 17 || ipProto == 132
+}
+
+func (l *Logger) write(r *Record) {
+	if r == nil {
+		return
+	}
+	line, err := json.Marshal(r)
+	if err != nil {
+		log.WithError(err).Error("Failed to encode policy audit record")
+		return
+	}
+	line = append(line, '\n')
+	for _, s := range l.sinks {
+		if _, err := s.Write(line); err != nil {
+			log.WithError(err).Debug("Failed to write policy audit record")
+			counterVecRecords.WithLabelValues(resultWriteFailed).Inc()
+			continue
+		}
+		counterVecRecords.WithLabelValues(resultWritten).Inc()
+	}
+}
+
+// Stop closes the sinks.
+func (l *Logger) Stop() {
+	for _, s := range l.sinks {
+		if err := s.Close(); err != nil {
+			log.WithError(err).Warn("Failed to close policy audit log sink")
+		}
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/bpf/audit"
+	"github.com/projectcalico/calico/felix/nfnetlink"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+var testTime = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+type bufferSink struct {
+	bytes.Buffer
+}
+
+func (s *bufferSink) Close() error {
+	return nil
+}
+
+func testMatchID(dir, action, owner, name string, idx int) uint64 {
+	return uint64(len(dir)*1000 + len(owner)*100 + len(name)*10 + idx)
+}
+
+func newTestLogger() (*Logger, *bufferSink) {
+	sink := &bufferSink{}
+	l := New([]io.WriteCloser{sink}, testMatchID)
+	l.nowFunc = func() time.Time { return testTime }
+
+	l.OnUpdate(&proto.ActivePolicyUpdate{
+		Id: &proto.PolicyID{Tier: "default", Name: "default.allow-dns"},
+		Policy: &proto.Policy{
+			Namespace: "prod",
+			InboundRules: []*proto.Rule{
+				{Action: "allow", RuleId: "in-0"},
+				{Action: "deny", RuleId: "in-1", Audit: true},
+			},
+			OutboundRules: []*proto.Rule{
+				{Action: "allow", RuleId: "out-0", Audit: true},
+			},
+		},
+	})
+	l.OnUpdate(&proto.WorkloadEndpointUpdate{
+		Id: &proto.WorkloadEndpointID{OrchestratorId: "k8s", WorkloadId: "prod/frontend", EndpointId: "eth0"},
+		Endpoint: &proto.WorkloadEndpoint{
+			Ipv4Nets: []string{"10.65.0.2/32"},
+		},
+	})
+	return l, sink
+}
+
+func auditPacket(ruleID string, src, dst string, proto, sport, dport int) *nfnetlink.NflogPacket {
+	p := &nfnetlink.NflogPacket{}
+	prefix := "AUDIT|" + ruleID
+	copy(p.Prefix.Prefix[:], prefix)
+	p.Prefix.Len = len(prefix)
+	p.Header.HwProtocol = nfnetlink.IPv4Proto
+	copy(p.Tuple.Src[:], net.ParseIP(src).To16())
+	copy(p.Tuple.Dst[:], net.ParseIP(dst).To16())
+	p.Tuple.Proto = proto
+	p.Tuple.L4Src.Port = sport
+	p.Tuple.L4Dst.Port = dport
+	return p
+}
+
+func TestRecordForPacket(t *testing.T) {
+	RegisterTestingT(t)
+	l, _ := newTestLogger()
+
+	r := l.recordForPacket(auditPacket("out-0", "10.65.0.2", "10.96.0.10", 17, 40000, 53))
+	Expect(r).To(Equal(&Record{
+		Time:        testTime,
+		Kind:        "Policy",
+		Tier:        "default",
+		Namespace:   "prod",
+		Policy:      "default.allow-dns",
+		Direction:   "egress",
+		RuleIndex:   0,
+		Verdict:     "allow",
+		Protocol:    "udp",
+		SrcIP:       "10.65.0.2",
+		SrcPort:     40000,
+		DstIP:       "10.96.0.10",
+		DstPort:     53,
+		SrcEndpoint: &Endpoint{Name: "frontend", Namespace: "prod"},
+	}))
+
+	r = l.recordForPacket(auditPacket("in-1", "10.0.0.1", "10.65.0.2", 1, 0, 0))
+	Expect(r.Direction).To(Equal("ingress"))
+	Expect(r.RuleIndex).To(Equal(1))
+	Expect(r.Verdict).To(Equal("deny"))
+	Expect(r.Protocol).To(Equal("icmp"))
+	Expect(r.DstEndpoint).To(Equal(&Endpoint{Name: "frontend", Namespace: "prod"}))
+	Expect(r.SrcEndpoint).To(BeNil())
+
+	// Ignoring rules without audit and unknown rules.
+	Expect(l.recordForPacket(auditPacket("in-0", "10.0.0.1", "10.65.0.2", 6, 1, 2))).To(BeNil())
+	Expect(l.recordForPacket(auditPacket("unknown", "10.0.0.1", "10.65.0.2", 6, 1, 2))).To(BeNil())
+}
+
+func TestRecordForEvent(t *testing.T) {
+	RegisterTestingT(t)
+	l, _ := newTestLogger()
+
+	e := audit.Event{
+		MatchID: testMatchID("Ingress", "deny", "Policy", "default.allow-dns", 1),
+		SrcIP:   net.ParseIP("10.0.0.1"),
+		DstIP:   net.ParseIP("10.65.0.2"),
+		SrcPort: 1234,
+		DstPort: 80,
+		Proto:   6,
+	}
+	r := l.recordForEvent(e)
+	Expect(r).NotTo(BeNil())
+	Expect(r.Policy).To(Equal("default.allow-dns"))
+	Expect(r.Direction).To(Equal("ingress"))
+	Expect(r.Verdict).To(Equal("deny"))
+	Expect(r.SrcPort).To(Equal(1234))
+	Expect(r.DstPort).To(Equal(80))
+	Expect(r.DstEndpoint).To(Equal(&Endpoint{Name: "frontend", Namespace: "prod"}))
+}
+
+func TestRemovals(t *testing.T) {
+	RegisterTestingT(t)
+	l, _ := newTestLogger()
+
+	l.OnUpdate(&proto.WorkloadEndpointRemove{
+		Id: &proto.WorkloadEndpointID{OrchestratorId: "k8s", WorkloadId: "prod/frontend", EndpointId: "eth0"},
+	})
+	r := l.recordForPacket(auditPacket("out-0", "10.65.0.2", "10.96.0.10", 17, 40000, 53))
+	Expect(r.SrcEndpoint).To(BeNil())
+
+	l.OnUpdate(&proto.ActivePolicyRemove{
+		Id: &proto.PolicyID{Tier: "default", Name: "default.allow-dns"},
+	})
+	Expect(l.recordForPacket(auditPacket("out-0", "10.65.0.2", "10.96.0.10", 17, 40000, 53))).To(BeNil())
+	Expect(l.rulesByID).To(BeEmpty())
+	Expect(l.rulesByMatchID).To(BeEmpty())
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	RegisterTestingT(t)
+	l, sink := newTestLogger()
+
+	packets := make(chan *nfnetlink.NflogPacket, 2)
+	packets <- auditPacket("out-0", "10.65.0.2", "10.96.0.10", 17, 40000, 53)
+	packets <- auditPacket("in-1", "10.0.0.1", "10.65.0.2", 6, 1234, 80)
+	close(packets)
+	l.loop(packets, nil)
+
+	lines := bytes.Split(bytes.TrimSpace(sink.Bytes()), []byte("\n"))
+	Expect(lines).To(HaveLen(2))
+	var decoded map[string]any
+	Expect(json.Unmarshal(lines[0], &decoded)).To(Succeed())
+	Expect(decoded).To(HaveKeyWithValue("time", "2026-01-02T03:04:05Z"))
+	Expect(decoded).To(HaveKeyWithValue("policy", "default.allow-dns"))
+	Expect(decoded).To(HaveKeyWithValue("verdict", "allow"))
+	Expect(decoded).To(HaveKeyWithValue("dstPort", BeNumerically("==", 53)))
+	Expect(decoded).To(HaveKeyWithValue("srcEndpoint", map[string]any{"name": "frontend", "namespace": "prod"}))
+}
+
+type fakeConn struct {
+	net.Conn
+	written [][]byte
+	failing bool
+}
+
+func (c *fakeConn) Write(b []byte) (int, error) {
+	if c.failing {
+		return 0, errors.New("broken pipe")
+	}
+	c.written = append(c.written, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+func (c *fakeConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func TestCollectorSink(t *testing.T) {
+	RegisterTestingT(t)
+	now := testTime
+	var dials []string
+	conn := &fakeConn{}
+	dialErr := errors.New("connection refused")
+	s := NewCollectorSink("tcp://collector:9000").(*collectorSink)
+	s.nowFunc = func() time.Time { return now }
+	s.dialFunc = func(network, address string, _ time.Duration) (net.Conn, error) {
+		dials = append(dials, network+" "+address)
+		if dialErr != nil {
+			return nil, dialErr
+		}
+		return conn, nil
+	}
+
+	// Dropping records until the retry interval passes.
+	_, err := s.Write([]byte("a\n"))
+	Expect(err).To(MatchError("connection refused"))
+	_, err = s.Write([]byte("b\n"))
+	Expect(err).To(Equal(errCollectorUnavailable))
+	Expect(dials).To(Equal([]string{"tcp collector:9000"}))
+
+	// Connecting once the collector is back.
+	now = now.Add(collectorRetryInterval)
+	dialErr = nil
+	_, err = s.Write([]byte("c\n"))
+	Expect(err).NotTo(HaveOccurred())
+	_, err = s.Write([]byte("d\n"))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(conn.written).To(Equal([][]byte{[]byte("c\n"), []byte("d\n")}))
+	Expect(dials).To(HaveLen(2))
+
+	// Reconnecting after a write fails.
+	conn.failing = true
+	_, err = s.Write([]byte("e\n"))
+	Expect(err).To(HaveOccurred())
+	conn.failing = false
+	_, err = s.Write([]byte("f\n"))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(dials).To(HaveLen(3))
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	collectorDialTimeout   = 5 * time.Second
+	collectorWriteTimeout  = 5 * time.Second
+	collectorRetryInterval = 10 * time.Second
+)
+
+var errCollectorUnavailable = errors.New("policy audit collector is unavailable")
+
+// NewFileSink returns a sink that writes records to the given file, which is rotated once it
+// reaches maxSizeMB.  maxFiles rotated files are kept.
+func NewFileSink(path string, maxSizeMB, maxFiles int) io.WriteCloser {
+	return &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    maxSizeMB,
+		MaxBackups: maxFiles,
+	}
+}
+
+// collectorSink forwards records to a collector over TCP or UDP.  It connects lazily and, if the
+// collector is unavailable, drops records until it is time to retry.
+type collectorSink struct {
+	lock      sync.Mutex
+	network   string
+	address   string
+	conn      net.Conn
+	nextRetry time.Time
+	nowFunc   func() time.Time
+	dialFunc  func(network, address string, timeout time.Duration) (net.Conn, error)
+}
+
+// NewCollectorSink returns a sink that forwards records to the collector at the given address,
+// which has the form tcp://<host>:<port> or udp://<host>:<port>.
+func NewCollectorSink(collector string) io.WriteCloser {
+	network, address, _ := strings.Cut(collector, "://")
+	return &collectorSink{
+		network:  network,
+		address:  address,
+		nowFunc:  time.Now,
+		dialFunc: net.DialTimeout,
+	}
+}
+
+func (s *collectorSink) Write(line []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn == nil {
+		if s.nowFunc().Before(s.nextRetry) {
+			return 0, errCollectorUnavailable
+		}
+		conn, err := s.dialFunc(s.network, s.address, collectorDialTimeout)
+		if err != nil {
+			log.WithError(err).WithField("collector", s.address).Warn(
+				"Failed to connect to policy audit collector, will retry")
+			s.nextRetry = s.nowFunc().Add(collectorRetryInterval)
+			return 0, err
+		}
+		log.WithField("collector", s.address).Info("Connected to policy audit collector")
+		s.conn = conn
+	}
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(collectorWriteTimeout))
+	n, err := s.conn.Write(line)
+	if err != nil {
+		log.WithError(err).WithField("collector", s.address).Warn(
+			"Failed to write to policy audit collector, reconnecting")
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	return n, err
+}
+
+func (s *collectorSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
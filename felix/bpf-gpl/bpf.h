@@ -310,6 +310,7 @@ extern const volatile struct cali_tc_preamble_globals __globals;
 #define WG_PORT		CALI_CONFIGURABLE(wg_port)
 #define NATIN_IFACE	CALI_CONFIGURABLE(natin_idx)
 #define PROFILING	CALI_CONFIGURABLE(profiling)
+#define REJECT_RATE_LIMIT	CALI_CONFIGURABLE(reject_rate_limit)
 
 #ifdef UNITTEST
 #define CALI_PATCH_DEFINE(name, pattern)							\
@@ -9,6 +9,7 @@
 
 #include "bpf.h"
 #include "nat_lookup.h"
+#include "nat_stats.h"
 
 static CALI_BPF_INLINE int do_nat_common(struct bpf_sock_addr *ctx, __u8 proto, ipv46_addr_t *dst, bool connect)
 {
@@ -79,6 +80,10 @@ static CALI_BPF_INLINE int do_nat_common(struct bpf_sock_addr *ctx, __u8 proto,
 		}
 	}
 
+	if (connect && CTLB_NAT_STATS) {
+		nat_stats_ctlb_conn(dst, dport_he, &nat_dest->addr, nat_dest->port, proto);
+	}
+
 	*dst = nat_dest->addr;
 	ctx->user_port = dport_be;
 
@@ -27,44 +27,6 @@ static CALI_BPF_INLINE int psnat_get_port(struct cali_tc_ctx *ctx)
 	return PSNAT_START + (bpf_get_prandom_u32() % PSNAT_LEN);
 }
 
-#ifdef IPVER6
-
-static CALI_BPF_INLINE bool  src_lt_dest(ipv6_addr_t *ip_src, ipv6_addr_t *ip_dst, __u16 sport, __u16 dport)
-{
-	int ret = ipv6_addr_t_cmp(ip_src, ip_dst);
-
-	if (ret != 0) {
-		return ret < 0;
-	}
-
-	return sport < dport;
-}
-
-#else
-
-#define src_lt_dest(ip_src, ip_dst, sport, dport) \
-	(*(ip_src) < *(ip_dst)) || ((*(ip_src) == *(ip_dst)) && (sport) < (dport))
-
-#endif /* IPVER6 */
-
-static CALI_BPF_INLINE void fill_ct_key(struct calico_ct_key *k, bool sltd, __u8 proto,
-					ipv46_addr_t *ipa, ipv46_addr_t *ipb, __u16 pta, __u16 ptb)
-{
-	k->protocol = proto;
-
-	if (sltd) {
-		k->addr_a = *ipa;
-		k->addr_b = *ipb;
-		k->port_a = pta;
-		k->port_b = ptb;
-	} else {
-		k->addr_a = *ipb;
-		k->addr_b = *ipa;
-		k->port_a = ptb;
-		k->port_b = pta;
-	}
-}
-
 #define ct_result_np_node(res)		((res).flags & CALI_CT_FLAG_NP_FWD)
 
 static CALI_BPF_INLINE void dump_ct_key(struct cali_tc_ctx *ctx, struct calico_ct_key *k)
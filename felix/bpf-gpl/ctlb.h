@@ -4,5 +4,6 @@
 const volatile struct cali_ctlb_globals __globals;
 #define CTLB_UDP_NOT_SEEN_TIMEO __globals.udp_not_seen_timeo
 #define CTLB_EXCLUDE_UDP __globals.exclude_udp
+#define CTLB_NAT_STATS __globals.nat_stats
 
 #endif /* _CTLB_H_ */
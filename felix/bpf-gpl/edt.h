@@ -0,0 +1,91 @@
+// Project Calico BPF dataplane programs.
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+#ifndef __CALI_EDT_H__
+#define __CALI_EDT_H__
+
+#include "bpf.h"
+
+/* Egress bandwidth enforcement using earliest departure time (EDT).
+ *
+ * The program on the host side of a workload interface records the id of the
+ * workload in skb->queue_mapping. When the packet leaves the host, the
+ * program on the egress of the host interface looks up the rate of the
+ * workload and stamps the packet with the time when it may depart. The fq
+ * qdisc on the host interface then holds the packet until then.
+ *
+ * The id shares skb->queue_mapping with the kernel, which records the rx queue
+ * in it when a packet is received. Any non-zero queue_mapping at the egress of
+ * a host interface is taken as an id, so the programs on the ingress of the
+ * host interfaces clear it. Packets that reach a host interface from an
+ * interface without our programs may still carry the rx queue, they are then
+ * limited by the workload that has the matching id, if any.
+ */
+
+#define EDT_NSEC_PER_SEC	1000000000ULL
+/* Packets that would have to wait for longer than the horizon are dropped,
+ * which is what fq would do with them anyway.
+ */
+#define EDT_DROP_HORIZON	(2 * EDT_NSEC_PER_SEC)
+
+struct cali_edt_value {
+	__u64 rate;		/* bytes per second, set by felix, 0 means unlimited */
+	__u64 t_last;		/* departure time of the last packet */
+	__u64 throttled_bytes;	/* bytes that had to be delayed */
+	__u64 dropped_packets;	/* packets dropped beyond the horizon */
+};
+
+CALI_MAP_V1(cali_edt,
+		BPF_MAP_TYPE_HASH,
+		__u32, struct cali_edt_value, 64 * 1024,
+		0)
+
+static CALI_BPF_INLINE void edt_set_id(struct __sk_buff *skb, __u32 id)
+{
+	skb->queue_mapping = id;
+}
+
+static CALI_BPF_INLINE int edt_schedule_departure(struct __sk_buff *skb)
+{
+	__u32 id = skb->queue_mapping;
+
+	if (!id) {
+		return TC_ACT_UNSPEC;
+	}
+
+	/* Do not let the id leak into the choice of the tx queue. */
+	skb->queue_mapping = 0;
+
+	struct cali_edt_value *v = cali_edt_lookup_elem(&id);
+	if (!v || !v->rate) {
+		return TC_ACT_UNSPEC;
+	}
+
+	__u64 now = bpf_ktime_get_ns();
+	__u64 t = skb->tstamp;
+	if (t < now) {
+		t = now;
+	}
+
+	__u64 delay = (__u64)skb->len * EDT_NSEC_PER_SEC / v->rate;
+	__u64 t_next = v->t_last + delay;
+
+	if (t_next <= t) {
+		v->t_last = t;
+		return TC_ACT_UNSPEC;
+	}
+
+	if (t_next - now >= EDT_DROP_HORIZON) {
+		__sync_fetch_and_add(&v->dropped_packets, 1);
+		return TC_ACT_SHOT;
+	}
+
+	v->t_last = t_next;
+	skb->tstamp = t_next;
+	__sync_fetch_and_add(&v->throttled_bytes, skb->len);
+
+	return TC_ACT_UNSPEC;
+}
+
+#endif /* __CALI_EDT_H__ */
@@ -67,4 +67,62 @@ static CALI_BPF_INLINE bool is_failsafe_out(__u8 ip_proto, __u16 dport, ipv46_ad
 	return false;
 }
 
+#if CALI_F_XDP
+
+/* Per-source token bucket used to rate limit new flows to inbound failsafe
+ * ports.  The credit is kept in nanoseconds; every packet costs
+ * NSEC_PER_SEC / rate and the credit is capped at one second worth of packets.
+ */
+struct failsafe_rl_val {
+	__u64 last_seen;
+	__u64 credit;
+};
+
+#ifdef IPVER6
+CALI_MAP_NAMED(cali_v6_fsafe_rl, cali_fsafe_rl,,
+#else
+CALI_MAP_NAMED(cali_v4_fsafe_rl, cali_fsafe_rl,,
+#endif
+		BPF_MAP_TYPE_LRU_HASH,
+		ipv46_addr_t, struct failsafe_rl_val,
+		65536, 0)
+
+#define FSAFE_RL_MAX_CREDIT	1000000000ull /* 1s */
+
+static CALI_BPF_INLINE bool failsafe_rate_limited(ipv46_addr_t *ip, __u32 rate)
+{
+	if (rate == 0) {
+		return false;
+	}
+
+	__u64 cost = FSAFE_RL_MAX_CREDIT / rate;
+	__u64 now = bpf_ktime_get_ns();
+	struct failsafe_rl_val *v = cali_fsafe_rl_lookup_elem(ip);
+
+	if (!v) {
+		struct failsafe_rl_val nv = {
+			.last_seen = now,
+			.credit = FSAFE_RL_MAX_CREDIT - cost,
+		};
+		cali_fsafe_rl_update_elem(ip, &nv, BPF_ANY);
+		return false;
+	}
+
+	__u64 credit = v->credit + (now - v->last_seen);
+	if (credit > FSAFE_RL_MAX_CREDIT) {
+		credit = FSAFE_RL_MAX_CREDIT;
+	}
+	v->last_seen = now;
+
+	if (credit < cost) {
+		v->credit = credit;
+		return true;
+	}
+	v->credit = credit - cost;
+
+	return false;
+}
+
+#endif /* CALI_F_XDP */
+
 #endif /* __CALI_BPF_FAILSAFE_H__ */
@@ -24,6 +24,8 @@ struct name {				\
 	__u32 natout_idx;		\
 	__u8 iface_name[16];		\
 	__u32 log_filter_jmp;		\
+	__u32 edt_id;			\
+	__u32 reject_rate_limit;	\
 	__u32 jumps[40];		\
 }
 
@@ -54,16 +56,33 @@ enum cali_globals_flags {
 	CALI_GLOBALS_LO_UDP_ONLY		= 0x00000100,
 	CALI_GLOBALS_RESERVED10			= 0x00000200,
 	CALI_GLOBALS_REDIRECT_PEER		= 0x00000400,
+	CALI_GLOBALS_NAT_STATS			= 0x00000800,
+	/* Set on the egress of host interfaces that enforce egress bandwidth of workloads. */
+	CALI_GLOBALS_EDT_SCHEDULE		= 0x00001000,
+	/* Set on the ingress of host interfaces so that packets arriving from
+	 * the outside do not carry an id into the workloads.
+	 */
+	CALI_GLOBALS_EDT_RESET			= 0x00002000,
+	/* Set when Felix snoops on DNS responses to learn the IPs of domains. */
+	CALI_GLOBALS_DNS_SNOOP			= 0x00004000,
 };
 
 struct cali_ctlb_globals {
 	__be32 udp_not_seen_timeo;
 	bool exclude_udp;
+	bool nat_stats;
 };
 
 struct cali_xdp_globals {
 	__u8 iface_name[16];
 	__u32 jumps[16];
+	__u32 flags;
+	__u32 fsafe_rate_limit; /* packets per second per source, 0 means unlimited */
+};
+
+enum cali_xdp_globals_flags {
+	/* Let packets of established flows skip XDP policy. */
+	CALI_XDP_GLOBALS_CT_FAST_PATH		= 0x00000001,
 };
 
 struct cali_xdp_preamble_globals {
@@ -0,0 +1,92 @@
+// Project Calico BPF dataplane programs.
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+#ifndef __CALI_NAT_STATS_H__
+#define __CALI_NAT_STATS_H__
+
+#include "bpf.h"
+#include "nat_types.h"
+
+/* nat_stats_get returns the counters of a frontend and backend pair, creating
+ * them if needed.  The map is per-CPU so we do not need atomic operations to
+ * update them.
+ */
+static CALI_BPF_INLINE struct calico_nat_stats_value *nat_stats_get(ipv46_addr_t *fe_addr, __u16 fe_port,
+								     ipv46_addr_t *be_addr, __u16 be_port,
+								     __u8 proto)
+{
+	struct calico_nat_stats_key k = {
+		.fe_addr = *fe_addr,
+		.be_addr = *be_addr,
+		.fe_port = fe_port,
+		.be_port = be_port,
+		.protocol = proto,
+	};
+	struct calico_nat_stats_value *v = cali_nat_stats_lookup_elem(&k);
+
+	if (v) {
+		return v;
+	}
+
+	struct calico_nat_stats_value nv = {};
+	/* The entry may have been created by another CPU in the meantime, the
+	 * lookup below finds it either way.
+	 */
+	cali_nat_stats_update_elem(&k, &nv, BPF_NOEXIST);
+
+	return cali_nat_stats_lookup_elem(&k);
+}
+
+/* nat_stats_to_backend counts a packet that is DNATed to a backend and, if it
+ * starts a connection, the connection.
+ */
+static CALI_BPF_INLINE void nat_stats_to_backend(ipv46_addr_t *fe_addr, __u16 fe_port,
+						 ipv46_addr_t *be_addr, __u16 be_port,
+						 __u8 proto, __u32 bytes, bool new_conn)
+{
+	struct calico_nat_stats_value *v = nat_stats_get(fe_addr, fe_port, be_addr, be_port, proto);
+
+	if (!v) {
+		return;
+	}
+	v->packets_to_be++;
+	v->bytes_to_be += bytes;
+	if (new_conn) {
+		v->conns++;
+	}
+}
+
+/* nat_stats_from_backend counts a reply packet from a backend that is SNATed
+ * back to the frontend.
+ */
+static CALI_BPF_INLINE void nat_stats_from_backend(ipv46_addr_t *fe_addr, __u16 fe_port,
+						   ipv46_addr_t *be_addr, __u16 be_port,
+						   __u8 proto, __u32 bytes)
+{
+	struct calico_nat_stats_value *v = nat_stats_get(fe_addr, fe_port, be_addr, be_port, proto);
+
+	if (!v) {
+		return;
+	}
+	v->packets_from_be++;
+	v->bytes_from_be += bytes;
+}
+
+/* nat_stats_ctlb_conn counts a connection that is load balanced at connect
+ * time.  Its packets never hit the NAT in the TC programs so they are not
+ * counted.
+ */
+static CALI_BPF_INLINE void nat_stats_ctlb_conn(ipv46_addr_t *fe_addr, __u16 fe_port,
+						ipv46_addr_t *be_addr, __u16 be_port,
+						__u8 proto)
+{
+	struct calico_nat_stats_value *v = nat_stats_get(fe_addr, fe_port, be_addr, be_port, proto);
+
+	if (!v) {
+		return;
+	}
+	v->ctlb_conns++;
+}
+
+#endif /* __CALI_NAT_STATS_H__ */
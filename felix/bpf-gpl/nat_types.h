@@ -117,6 +117,34 @@ CALI_MAP_NAMED(cali_v4_nat_aff, cali_nat_aff,,
 		struct calico_nat_affinity_key, struct calico_nat_affinity_val,
 		64*1024, 0)
 
+/* Map: NAT traffic counters per frontend and backend pair. */
+struct calico_nat_stats_key {
+	ipv46_addr_t fe_addr; // NBO
+	ipv46_addr_t be_addr; // NBO
+	__u16 fe_port; // HBO
+	__u16 be_port; // HBO
+	__u8 protocol;
+	__u8 pad[3];
+};
+
+struct calico_nat_stats_value {
+	__u64 packets_to_be;
+	__u64 bytes_to_be;
+	__u64 packets_from_be;
+	__u64 bytes_from_be;
+	__u64 conns; /* load balanced by the TC programs */
+	__u64 ctlb_conns; /* load balanced at connect time */
+};
+
+#ifdef IPVER6
+CALI_MAP_NAMED(cali_v6_nat_stats, cali_nat_stats,,
+#else
+CALI_MAP_NAMED(cali_v4_nat_stats, cali_nat_stats,,
+#endif
+		BPF_MAP_TYPE_LRU_PERCPU_HASH,
+		struct calico_nat_stats_key, struct calico_nat_stats_value,
+		64*1024, 0)
+
 struct vxlanhdr {
 	__be32 flags;
 	__be32 vni;
@@ -9,6 +9,7 @@ enum calico_policy_result {
 	CALI_POL_NO_MATCH,
 	CALI_POL_ALLOW,
 	CALI_POL_DENY,
+	CALI_POL_REJECT,
 };
 
 struct port_range {
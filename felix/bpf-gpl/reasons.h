@@ -26,6 +26,7 @@ enum calico_reason {
 	CALI_REASON_SOURCE_COLLISION,
 	CALI_REASON_SOURCE_COLLISION_FAILED,
 	CALI_REASON_CT_CREATE_FAILED,
+	CALI_REASON_DROPPED_BY_RATE_LIMIT,
 	CALI_REASON_ACCEPTED_BY_XDP, // Not used by counters map
 	CALI_REASON_WEP_NOT_READY,
 	CALI_REASON_NATIFACE,
@@ -0,0 +1,69 @@
+// Project Calico BPF dataplane programs.
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+#ifndef __CALI_BPF_REJECT_H__
+#define __CALI_BPF_REJECT_H__
+
+#include "bpf.h"
+#include "types.h"
+
+/* Per-source token bucket used to rate limit the ICMP errors that we send
+ * back for packets rejected by policy.  The credit is kept in nanoseconds;
+ * every reply costs NSEC_PER_SEC / rate and the credit is capped at one second
+ * worth of replies.
+ */
+struct reject_rl_val {
+	__u64 last_seen;
+	__u64 credit;
+};
+
+#ifdef IPVER6
+CALI_MAP_NAMED(cali_v6_rej_rl, cali_rej_rl,,
+#else
+CALI_MAP_NAMED(cali_v4_rej_rl, cali_rej_rl,,
+#endif
+		BPF_MAP_TYPE_LRU_HASH,
+		ipv46_addr_t, struct reject_rl_val,
+		65536, 0)
+
+#define REJECT_RL_MAX_CREDIT	1000000000ull /* 1s */
+
+/* reject_rate_limited returns true if the source has used up its budget of
+ * replies.  Unlike the failsafe limit, a rate of 0 means that we never reply.
+ */
+static CALI_BPF_INLINE bool reject_rate_limited(ipv46_addr_t *ip, __u32 rate)
+{
+	if (rate == 0) {
+		return true;
+	}
+
+	__u64 cost = REJECT_RL_MAX_CREDIT / rate;
+	__u64 now = bpf_ktime_get_ns();
+	struct reject_rl_val *v = cali_rej_rl_lookup_elem(ip);
+
+	if (!v) {
+		struct reject_rl_val nv = {
+			.last_seen = now,
+			.credit = REJECT_RL_MAX_CREDIT - cost,
+		};
+		cali_rej_rl_update_elem(ip, &nv, BPF_ANY);
+		return false;
+	}
+
+	__u64 credit = v->credit + (now - v->last_seen);
+	if (credit > REJECT_RL_MAX_CREDIT) {
+		credit = REJECT_RL_MAX_CREDIT;
+	}
+	v->last_seen = now;
+
+	if (credit < cost) {
+		v->credit = credit;
+		return true;
+	}
+	v->credit = credit - cost;
+
+	return false;
+}
+
+#endif /* __CALI_BPF_REJECT_H__ */
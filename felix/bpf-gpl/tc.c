@@ -40,6 +40,7 @@
 #include "conntrack.h"
 #include "nat.h"
 #include "nat_lookup.h"
+#include "nat_stats.h"
 #include "routes.h"
 #include "jump.h"
 #include "reasons.h"
@@ -51,6 +52,7 @@
 #include "parsing.h"
 #include "tc.h"
 #include "failsafe.h"
+#include "reject.h"
 #include "metadata.h"
 #include "bpf_helpers.h"
 #include "rule_counters.h"
@@ -381,6 +383,14 @@ static CALI_BPF_INLINE void calico_tc_process_ct_lookup(struct cali_tc_ctx *ctx)
 		if (ctx->state->ct_result.flags & CALI_CT_FLAG_SKIP_FIB) {
 			ctx->state->flags |= CALI_ST_SKIP_FIB;
 		}
+		if (CALI_F_TO_HOST && (GLOBAL_FLAGS & CALI_GLOBALS_DNS_SNOOP) &&
+				ctx->state->ip_proto == IPPROTO_UDP && ctx->state->sport == 53) {
+			/* DNS responses must go through the host stack so that
+			 * iptables can copy them to Felix.
+			 */
+			CALI_DEBUG("DNS response, skip FIB to snoop it.");
+			ctx->state->flags |= CALI_ST_SKIP_FIB;
+		}
 		CALI_DEBUG("CT Hit");
 
 		if (ctx->state->ip_proto == IPPROTO_TCP && ct_result_is_syn(ctx->state->ct_result.rc)) {
@@ -742,6 +752,12 @@ static CALI_BPF_INLINE enum do_nat_res do_nat(struct cali_tc_ctx *ctx,
 		CALI_DEBUG("CT: DNAT to " IP_FMT ":%d",
 				debug_ip(STATE->post_nat_ip_dst), STATE->post_nat_dport);
 
+		if ((GLOBAL_FLAGS & CALI_GLOBALS_NAT_STATS) && *is_dnat && !ct_related && !inner_icmp) {
+			nat_stats_to_backend(&STATE->ip_dst, STATE->dport,
+					     &STATE->post_nat_ip_dst, STATE->post_nat_dport,
+					     STATE->ip_proto, ctx->skb->len, ct_ctx_nat != NULL);
+		}
+
 		encap_needed = dnat_should_encap();
 
 		/* We have not created the conntrack yet since we did not know
@@ -945,6 +961,12 @@ static CALI_BPF_INLINE enum do_nat_res do_nat(struct cali_tc_ctx *ctx,
 		CALI_DEBUG("CT: SNAT from " IP_FMT ":%d",
 				debug_ip(STATE->ct_result.nat_ip), STATE->ct_result.nat_port);
 
+		if ((GLOBAL_FLAGS & CALI_GLOBALS_NAT_STATS) && !ct_related && !inner_icmp) {
+			nat_stats_from_backend(&STATE->ct_result.nat_ip, STATE->ct_result.nat_port,
+					       &STATE->ip_src, STATE->sport,
+					       STATE->ip_proto, ctx->skb->len);
+		}
+
 		if (dnat_return_should_encap() && !ip_void(STATE->ct_result.tun_ip)) {
 			if (CALI_F_DSR && !(STATE->ct_result.flags & CALI_CT_FLAG_NP_NO_DSR)) {
 				/* SNAT will be done after routing, when leaving HEP */
@@ -2003,6 +2025,10 @@ int calico_tc_skb_drop(struct __sk_buff *skb)
 		}
 	}
 
+	if (ctx->state->pol_rc == CALI_POL_REJECT) {
+		goto reject;
+	}
+
 	goto deny;
 
 allow:
@@ -2011,6 +2037,28 @@ allow:
 	CALI_JUMP_TO(ctx, PROG_INDEX_ALLOWED);
 	/* should not reach here */
 	CALI_DEBUG("Failed to jump to allow program.");
+	goto deny;
+
+reject:
+	/* Never reply to ICMP, we could end up ping-ponging errors. */
+	if (ctx->state->ip_proto == IPPROTO_ICMP_46) {
+		goto deny;
+	}
+	if (reject_rate_limited(&ctx->state->ip_src, REJECT_RATE_LIMIT)) {
+		CALI_DEBUG("REJECT rate limited, dropping silently.");
+		goto deny;
+	}
+	CALI_DEBUG("REJECT due to policy, sending ICMP error.");
+#ifdef IPVER6
+	ctx->state->icmp_type = ICMPV6_DEST_UNREACH;
+	ctx->state->icmp_code = ICMPV6_ADM_PROHIBITED;
+#else
+	ctx->state->icmp_type = ICMP_DEST_UNREACH;
+	ctx->state->icmp_code = ICMP_PKT_FILTERED;
+#endif
+	CALI_JUMP_TO(ctx, PROG_INDEX_ICMP);
+	/* should not reach here */
+	CALI_DEBUG("Failed to jump to ICMP program.");
 
 deny:
 	CALI_DEBUG("DENY due to policy");
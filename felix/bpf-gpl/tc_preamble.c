@@ -12,6 +12,7 @@
 #include "globals.h"
 #include "jump.h"
 #include "log.h"
+#include "edt.h"
 
 const volatile struct cali_tc_preamble_globals __globals;
 
@@ -55,6 +56,18 @@ int  cali_tc_preamble(struct __sk_buff *skb)
 	/* We do the copy once here so keep the program smaller */
 	globals->data = *globals_data;
 
+	if (globals->data.flags & CALI_GLOBALS_EDT_SCHEDULE) {
+		if (edt_schedule_departure(skb) == TC_ACT_SHOT) {
+			CALI_LOG("tc_preamble iface %s packet beyond EDT horizon, DROP",
+					globals->data.iface_name);
+			return TC_ACT_SHOT;
+		}
+	} else if (globals->data.flags & CALI_GLOBALS_EDT_RESET) {
+		edt_set_id(skb, 0);
+	} else if (globals->data.edt_id) {
+		edt_set_id(skb, globals->data.edt_id);
+	}
+
 #if EMIT_LOGS
 	CALI_LOG("tc_preamble iface %s", globals->data.iface_name);
 #endif
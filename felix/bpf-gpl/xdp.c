@@ -31,6 +31,33 @@
 #include "metadata.h"
 #include "globals.h"
 
+/* xdp_ct_established returns true if the packet belongs to a TCP or UDP flow that
+ * already has a conntrack entry.  TCP SYN packets are never considered established
+ * as they can (re)open a connection.
+ */
+static CALI_BPF_INLINE bool xdp_ct_established(struct cali_tc_ctx *ctx)
+{
+	struct cali_tc_state *s = ctx->state;
+
+	switch (s->ip_proto) {
+	case IPPROTO_TCP:
+		if (tcp_hdr(ctx)->syn && !tcp_hdr(ctx)->ack) {
+			return false;
+		}
+		break;
+	case IPPROTO_UDP:
+		break;
+	default:
+		return false;
+	}
+
+	struct calico_ct_key k = {};
+	bool srcLTDest = src_lt_dest(&s->ip_src, &s->ip_dst, s->sport, s->dport);
+	fill_ct_key(&k, srcLTDest, s->ip_proto, &s->ip_src, &s->ip_dst, s->sport, s->dport);
+
+	return cali_ct_lookup_elem(&k) != NULL;
+}
+
 /* calico_xdp is the main function used in all of the xdp programs */
 SEC("xdp")
 int calico_xdp_main(struct xdp_md *xdp)
@@ -90,10 +117,30 @@ int calico_xdp_main(struct xdp_md *xdp)
 		goto allow;
 	}
 
+	bool ct_fast_path = ctx->xdp_globals->flags & CALI_XDP_GLOBALS_CT_FAST_PATH;
+
+	// When XDP only enforces the deny rules of the host endpoint policy, packets of
+	// flows that were already approved by the TC programs skip it.  TC enforces the
+	// full policy, so there is nothing to gain by evaluating the deny rules again.
+	if (ct_fast_path && xdp_ct_established(ctx)) {
+		CALI_DEBUG("Established flow. Skip policy");
+		goto allow;
+	}
+
 	// Skip XDP policy, and hence fall through to TC processing, if packet hits an
 	// entry in the inbound ports failsafe map.  The point here is that flows through
 	// configured failsafe ports should be allowed and NOT be accidentally untracked.
 	if (is_failsafe_in(ctx->state->ip_proto, ctx->state->dport, ctx->state->ip_src)) {
+		// Failsafe ports are always open so protect them from floods of new
+		// connections from a single source.  With the fast path, packets of
+		// established flows have already been let through above.
+		if (ctx->xdp_globals->fsafe_rate_limit &&
+				(ct_fast_path || !xdp_ct_established(ctx)) &&
+				failsafe_rate_limited(&ctx->state->ip_src, ctx->xdp_globals->fsafe_rate_limit)) {
+			CALI_DEBUG("Inbound failsafe port: %d. Source over the rate limit", ctx->state->dport);
+			counter_inc(ctx, CALI_REASON_DROPPED_BY_RATE_LIMIT);
+			goto deny;
+		}
 		CALI_DEBUG("Inbound failsafe port: %d. Skip policy", ctx->state->dport);
 		counter_inc(ctx, CALI_REASON_ACCEPTED_BY_FAILSAFE);
 		ctx->state->pol_rc = CALI_POL_ALLOW;
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+// The policy programs write an event with the following layout to the events
+// map when a new connection matches a rule with audit enabled:
+//
+//	struct cali_audit_event {
+//	   __u64 match_id;
+//	   __be32 ip_src[4];
+//	   __be32 ip_dst[4];
+//	   __u16 sport;
+//	   __u16 dport;
+//	   __u8 ip_proto;
+//	   __u8 flags;
+//	   __u16 pad;
+//	};
+//
+// The destination IP and port are those from before any DNAT.  Ports are in
+// host byte order.
+const (
+	EventSize = 48
+
+	EventOffMatchID = 0
+	EventOffIPSrc   = 8
+	EventOffIPDst   = 24
+	EventOffSrcPort = 40
+	EventOffDstPort = 42
+	EventOffIPProto = 44
+	EventOffFlags   = 45
+
+	// EventFlagIPv6 is set in the flags of events from the IPv6 programs.
+	EventFlagIPv6 = 1
+)
+
+var EventsMapParameters = maps.MapParameters{
+	Type:      "perf_event_array",
+	KeySize:   4,
+	ValueSize: 4,
+	Name:      "cali_audit_evts",
+}
+
+// EventsMap returns the perf event array that the policy programs write audit
+// events to.  It has an entry for each possible CPU, which felix fills with the
+// perf event that it reads the events of that CPU from.
+func EventsMap() maps.Map {
+	params := EventsMapParameters
+	params.MaxEntries = maps.NumPossibleCPUs()
+	return maps.NewPinnedMap(params)
+}
+
+// Event is an audit event written by a policy program.
+type Event struct {
+	MatchID uint64
+	SrcIP   net.IP
+	DstIP   net.IP
+	SrcPort uint16
+	DstPort uint16
+	Proto   uint8
+}
+
+// ParseEvent decodes an event from the raw perf sample.  The sample may be
+// padded to a multiple of 8 bytes.
+func ParseEvent(raw []byte) (Event, error) {
+	if len(raw) < EventSize {
+		return Event{}, fmt.Errorf("audit event too short: %d bytes", len(raw))
+	}
+	e := Event{
+		MatchID: binary.LittleEndian.Uint64(raw[EventOffMatchID:]),
+		SrcPort: binary.LittleEndian.Uint16(raw[EventOffSrcPort:]),
+		DstPort: binary.LittleEndian.Uint16(raw[EventOffDstPort:]),
+		Proto:   raw[EventOffIPProto],
+	}
+	if raw[EventOffFlags]&EventFlagIPv6 != 0 {
+		e.SrcIP = net.IP(append([]byte(nil), raw[EventOffIPSrc:EventOffIPSrc+16]...))
+		e.DstIP = net.IP(append([]byte(nil), raw[EventOffIPDst:EventOffIPDst+16]...))
+	} else {
+		e.SrcIP = net.IPv4(raw[EventOffIPSrc], raw[EventOffIPSrc+1], raw[EventOffIPSrc+2], raw[EventOffIPSrc+3])
+		e.DstIP = net.IPv4(raw[EventOffIPDst], raw[EventOffIPDst+1], raw[EventOffIPDst+2], raw[EventOffIPDst+3])
+	}
+	return e, nil
+}
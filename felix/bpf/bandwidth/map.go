@@ -0,0 +1,154 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bandwidth manages the map that holds the egress bandwidth limits of
+// workloads, which the BPF programs enforce by stamping packets with their
+// earliest departure time.
+package bandwidth
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+func init() {
+	maps.SetSize(MapParams.VersionedName(), MapParams.MaxEntries)
+}
+
+const (
+	KeySize   = 4
+	ValueSize = 4 * 8
+
+	// MaxID is the largest id that skb->queue_mapping, which carries the id
+	// from the workload to the host interface, can hold.  The kernel reserves
+	// 0xffff for "no queue mapping".
+	MaxID = 0xfffe
+)
+
+var MapParams = maps.MapParameters{
+	Type:         "hash",
+	KeySize:      KeySize,
+	ValueSize:    ValueSize,
+	MaxEntries:   64 * 1024,
+	Name:         "cali_edt",
+	UpdatedByBPF: true,
+}
+
+func Map() maps.Map {
+	return maps.NewPinnedMap(MapParams)
+}
+
+type Key [KeySize]byte
+
+func NewKey(id uint32) Key {
+	var k Key
+	binary.LittleEndian.PutUint32(k[:], id)
+	return k
+}
+
+func (k Key) AsBytes() []byte {
+	return k[:]
+}
+
+func (k Key) ID() uint32 {
+	return binary.LittleEndian.Uint32(k[:])
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("{id: %d}", k.ID())
+}
+
+func KeyFromBytes(b []byte) Key {
+	var k Key
+	copy(k[:], b)
+	return k
+}
+
+//	struct cali_edt_value {
+//	   __u64 rate;
+//	   __u64 t_last;
+//	   __u64 throttled_bytes;
+//	   __u64 dropped_packets;
+//	};
+type Value [ValueSize]byte
+
+// NewValue returns a value with the given rate in bits per second.  The
+// counters are zero.
+func NewValue(bitsPerSec uint64) Value {
+	var v Value
+	return v.WithRate(bitsPerSec)
+}
+
+// WithRate returns a copy of the value with the rate updated and the state
+// kept by the BPF programs preserved.
+func (v Value) WithRate(bitsPerSec uint64) Value {
+	binary.LittleEndian.PutUint64(v[0:8], bitsPerSec/8)
+	return v
+}
+
+func (v Value) AsBytes() []byte {
+	return v[:]
+}
+
+// Rate returns the rate in bytes per second.
+func (v Value) Rate() uint64 {
+	return binary.LittleEndian.Uint64(v[0:8])
+}
+
+func (v Value) LastDeparture() uint64 {
+	return binary.LittleEndian.Uint64(v[8:16])
+}
+
+func (v Value) ThrottledBytes() uint64 {
+	return binary.LittleEndian.Uint64(v[16:24])
+}
+
+func (v Value) DroppedPackets() uint64 {
+	return binary.LittleEndian.Uint64(v[24:32])
+}
+
+func (v Value) String() string {
+	return fmt.Sprintf("{rate: %dB/s, throttled: %dB, dropped: %d}",
+		v.Rate(), v.ThrottledBytes(), v.DroppedPackets())
+}
+
+func ValueFromBytes(b []byte) Value {
+	var v Value
+	copy(v[:], b)
+	return v
+}
+
+// MapMem represents the map loaded into memory.
+type MapMem map[Key]Value
+
+// LoadMap loads the map into a go map or returns an error.
+func LoadMap(m maps.Map) (MapMem, error) {
+	ret := make(MapMem)
+
+	if err := m.Open(); err != nil {
+		return nil, err
+	}
+
+	err := m.Iter(func(k, v []byte) maps.IteratorAction {
+		ret[KeyFromBytes(k)] = ValueFromBytes(v)
+		return maps.IterNone
+	})
+	if err != nil {
+		ret = nil
+	}
+
+	return ret, err
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bandwidth
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+var endpointLabels = []string{"workload", "endpoint"}
+
+// EndpointLookup returns the workload and endpoint names of the endpoint with
+// the given id.
+type EndpointLookup func(id uint32) (workload, endpoint string, ok bool)
+
+// Collector exports the counters of the bandwidth map as prometheus metrics.
+// The counters in the map are per id.  An id is reset when it is reallocated,
+// for instance when the interface of the workload is recreated, so the
+// collector accumulates them per workload to keep the metrics monotonic.
+type Collector struct {
+	m      maps.Map
+	lookup EndpointLookup
+
+	lock   sync.Mutex
+	last   map[uint32]idCounters
+	totals map[endpointKey]counters
+
+	throttledBytes, droppedPackets *prometheus.Desc
+}
+
+type endpointKey struct {
+	workload, endpoint string
+}
+
+type counters struct {
+	throttledBytes, droppedPackets uint64
+}
+
+type idCounters struct {
+	endpoint endpointKey
+	counters
+}
+
+func NewCollector(m maps.Map, lookup EndpointLookup) *Collector {
+	return &Collector{
+		m:      m,
+		lookup: lookup,
+		last:   map[uint32]idCounters{},
+		totals: map[endpointKey]counters{},
+
+		throttledBytes: prometheus.NewDesc("felix_bpf_egress_bandwidth_throttled_bytes",
+			"Number of bytes sent by a workload that were delayed to enforce its egress bandwidth limit.",
+			endpointLabels, nil),
+		droppedPackets: prometheus.NewDesc("felix_bpf_egress_bandwidth_dropped_packets",
+			"Number of packets sent by a workload that were dropped because they exceeded its egress bandwidth limit.",
+			endpointLabels, nil),
+	}
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.throttledBytes
+	ch <- c.droppedPackets
+}
+
+// update adds what the counters of each id grew by since the last update to
+// the totals of its workload.  It forgets the workloads that no longer have
+// an id.
+func (c *Collector) update(mem MapMem) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	last := make(map[uint32]idCounters, len(mem))
+	totals := make(map[endpointKey]counters, len(mem))
+
+	for k, v := range mem {
+		workload, endpoint, ok := c.lookup(k.ID())
+		if !ok {
+			continue
+		}
+		cur := idCounters{
+			endpoint: endpointKey{workload: workload, endpoint: endpoint},
+			counters: counters{throttledBytes: v.ThrottledBytes(), droppedPackets: v.DroppedPackets()},
+		}
+
+		delta := cur.counters
+		if prev, ok := c.last[k.ID()]; ok && prev.endpoint == cur.endpoint &&
+			prev.throttledBytes <= cur.throttledBytes && prev.droppedPackets <= cur.droppedPackets {
+			delta.throttledBytes -= prev.throttledBytes
+			delta.droppedPackets -= prev.droppedPackets
+		}
+
+		t, ok := totals[cur.endpoint]
+		if !ok {
+			t = c.totals[cur.endpoint]
+		}
+		t.throttledBytes += delta.throttledBytes
+		t.droppedPackets += delta.droppedPackets
+		totals[cur.endpoint] = t
+		last[k.ID()] = cur
+	}
+
+	c.last = last
+	c.totals = totals
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	mem, err := LoadMap(c.m)
+	if err != nil {
+		log.WithError(err).Warn("Failed to load egress bandwidth map.")
+		return
+	}
+	c.update(mem)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for ep, t := range c.totals {
+		ch <- prometheus.MustNewConstMetric(c.throttledBytes, prometheus.CounterValue,
+			float64(t.throttledBytes), ep.workload, ep.endpoint)
+		ch <- prometheus.MustNewConstMetric(c.droppedPackets, prometheus.CounterValue,
+			float64(t.droppedPackets), ep.workload, ep.endpoint)
+	}
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bandwidth
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func valueWithCounters(throttledBytes, droppedPackets uint64) Value {
+	v := NewValue(8000)
+	binary.LittleEndian.PutUint64(v[16:24], throttledBytes)
+	binary.LittleEndian.PutUint64(v[24:32], droppedPackets)
+	return v
+}
+
+func TestCollectorKeepsCountersMonotonic(t *testing.T) {
+	owners := map[uint32]string{1: "default/a", 2: "default/b"}
+	c := NewCollector(nil, func(id uint32) (string, string, bool) {
+		wl, ok := owners[id]
+		return wl, "eth0", ok
+	})
+
+	expect := func(workload string, throttledBytes, droppedPackets uint64) {
+		t.Helper()
+		got := c.totals[endpointKey{workload: workload, endpoint: "eth0"}]
+		if got.throttledBytes != throttledBytes || got.droppedPackets != droppedPackets {
+			t.Errorf("%s: got %+v, expected throttled %d dropped %d", workload, got, throttledBytes, droppedPackets)
+		}
+	}
+
+	c.update(MapMem{NewKey(1): valueWithCounters(100, 1), NewKey(2): valueWithCounters(50, 0)})
+	expect("default/a", 100, 1)
+	expect("default/b", 50, 0)
+
+	c.update(MapMem{NewKey(1): valueWithCounters(150, 2), NewKey(2): valueWithCounters(50, 0)})
+	expect("default/a", 150, 2)
+
+	// The interface of a was recreated and got another id, whose counters
+	// start from zero.
+	delete(owners, 1)
+	owners[3] = "default/a"
+	c.update(MapMem{NewKey(3): valueWithCounters(10, 0), NewKey(2): valueWithCounters(60, 0)})
+	expect("default/a", 160, 2)
+	expect("default/b", 60, 0)
+
+	// b is gone.
+	c.update(MapMem{NewKey(3): valueWithCounters(20, 0)})
+	expect("default/a", 170, 2)
+	if _, ok := c.totals[endpointKey{workload: "default/b", endpoint: "eth0"}]; ok {
+		t.Error("totals of default/b were not removed")
+	}
+}
@@ -123,7 +123,13 @@ func tryLoadBPFProgramFromInsns(insns asm.Insns, name, license string, logSize u
 }
 
 func RunBPFProgram(fd ProgFD, dataIn []byte, repeat int) (pr ProgResult, err error) {
-	log.Debugf("RunBPFProgram(%v, ..., %v)", fd, repeat)
+	return RunBPFProgramWithCtx(fd, dataIn, nil, repeat)
+}
+
+// RunBPFProgramWithCtx is like RunBPFProgram, but it also passes ctxIn as the
+// context of the program, for instance a struct __sk_buff for a tc program.
+func RunBPFProgramWithCtx(fd ProgFD, dataIn, ctxIn []byte, repeat int) (pr ProgResult, err error) {
+	log.Debugf("RunBPFProgramWithCtx(%v, ..., %v)", fd, repeat)
 	bpfAttr := C.bpf_attr_alloc()
 	defer C.free(unsafe.Pointer(bpfAttr))
 
@@ -133,9 +139,16 @@ func RunBPFProgram(fd ProgFD, dataIn []byte, repeat int) (pr ProgResult, err err
 	cDataOut := C.malloc(dataOutBufSize)
 	defer C.free(cDataOut)
 
+	var cCtxIn unsafe.Pointer
+	if len(ctxIn) > 0 {
+		cCtxIn = C.CBytes(ctxIn)
+		defer C.free(cCtxIn)
+	}
+
 	var errno syscall.Errno
 	for attempts := 3; attempts > 0; attempts-- {
 		C.bpf_attr_setup_prog_run(bpfAttr, C.uint(fd), C.uint(len(dataIn)), cDataIn, C.uint(dataOutBufSize), cDataOut, C.uint(repeat))
+		C.bpf_attr_setup_prog_run_ctx(bpfAttr, C.uint(len(ctxIn)), cCtxIn)
 		_, _, errno = unix.Syscall(unix.SYS_BPF, unix.BPF_PROG_TEST_RUN, uintptr(unsafe.Pointer(bpfAttr)), C.sizeof_union_bpf_attr)
 		if errno == unix.EINTR {
 			// We hit this if a Go profiling timer pops while we're in the syscall.
@@ -156,6 +169,22 @@ func RunBPFProgram(fd ProgFD, dataIn []byte, repeat int) (pr ProgResult, err err
 	return
 }
 
+// GetProgFDByID returns a new file descriptor of the loaded program with the
+// given ID.  The caller is responsible for closing it.
+func GetProgFDByID(progID int) (ProgFD, error) {
+	log.Debugf("GetProgFDByID(%v)", progID)
+	bpfAttr := C.bpf_attr_alloc()
+	defer C.free(unsafe.Pointer(bpfAttr))
+
+	C.bpf_attr_setup_obj_get_id(bpfAttr, C.uint(progID), 0)
+	fd, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_PROG_GET_FD_BY_ID, uintptr(unsafe.Pointer(bpfAttr)), C.sizeof_union_bpf_attr)
+	if errno != 0 {
+		return 0, errno
+	}
+
+	return ProgFD(fd), nil
+}
+
 func PinBPFProgram(fd ProgFD, filename string) error {
 	bpfAttr := C.bpf_attr_alloc()
 	defer C.free(unsafe.Pointer(bpfAttr))
@@ -21,6 +21,8 @@ import (
 	"os"
 
 	"github.com/projectcalico/calico/felix/bpf/arp"
+	"github.com/projectcalico/calico/felix/bpf/audit"
+	"github.com/projectcalico/calico/felix/bpf/bandwidth"
 	"github.com/projectcalico/calico/felix/bpf/conntrack"
 	"github.com/projectcalico/calico/felix/bpf/counters"
 	"github.com/projectcalico/calico/felix/bpf/failsafes"
@@ -31,6 +33,7 @@ import (
 	"github.com/projectcalico/calico/felix/bpf/maps"
 	"github.com/projectcalico/calico/felix/bpf/nat"
 	"github.com/projectcalico/calico/felix/bpf/profiling"
+	"github.com/projectcalico/calico/felix/bpf/reject"
 	"github.com/projectcalico/calico/felix/bpf/routes"
 	"github.com/projectcalico/calico/felix/bpf/state"
 )
@@ -39,9 +42,12 @@ type IPMaps struct {
 	IpsetsMap    maps.Map
 	ArpMap       maps.Map
 	FailsafesMap maps.Map
+	FsafeRLMap   maps.Map
+	RejectRLMap  maps.Map
 	FrontendMap  maps.Map
 	BackendMap   maps.Map
 	AffinityMap  maps.Map
+	NATStatsMap  maps.Map
 	RouteMap     maps.Map
 	CtMap        maps.Map
 	SrMsgMap     maps.Map
@@ -58,6 +64,8 @@ type CommonMaps struct {
 	XDPProgramsMap  maps.Map
 	XDPJumpMap      maps.MapWithDeleteIfExists
 	ProfilingMap    maps.Map
+	BandwidthMap    maps.Map
+	AuditEventsMap  maps.Map
 }
 
 type Maps struct {
@@ -90,6 +98,8 @@ func getCommonMaps() *CommonMaps {
 		XDPProgramsMap:  hook.NewXDPProgramsMap(),
 		XDPJumpMap:      jump.XDPMap().(maps.MapWithDeleteIfExists),
 		ProfilingMap:    profiling.Map(),
+		BandwidthMap:    bandwidth.Map(),
+		AuditEventsMap:  audit.EventsMap(),
 	}
 }
 
@@ -112,9 +122,12 @@ func getIPMaps(ipFamily int) *IPMaps {
 		IpsetsMap:    getmap(ipsets.Map, ipsets.MapV6),
 		ArpMap:       getmap(arp.Map, arp.MapV6),
 		FailsafesMap: getmap(failsafes.Map, failsafes.MapV6),
+		FsafeRLMap:   getmap(failsafes.RateLimitMap, failsafes.RateLimitMapV6),
+		RejectRLMap:  getmap(reject.RateLimitMap, reject.RateLimitMapV6),
 		FrontendMap:  getmapWithExistsCheck(nat.FrontendMap, nat.FrontendMapV6),
 		BackendMap:   getmapWithExistsCheck(nat.BackendMap, nat.BackendMapV6),
 		AffinityMap:  getmap(nat.AffinityMap, nat.AffinityMapV6),
+		NATStatsMap:  getmap(nat.StatsMap, nat.StatsMapV6),
 		RouteMap:     getmap(routes.Map, routes.MapV6),
 		CtMap:        getmap(conntrack.Map, conntrack.MapV6),
 		SrMsgMap:     getmap(nat.SendRecvMsgMap, nat.SendRecvMsgMapV6),
@@ -172,6 +185,8 @@ func (c *CommonMaps) slice() []maps.Map {
 		c.XDPProgramsMap,
 		c.XDPJumpMap,
 		c.ProfilingMap,
+		c.BandwidthMap,
+		c.AuditEventsMap,
 	}
 }
 
@@ -180,9 +195,12 @@ func (i *IPMaps) slice() []maps.Map {
 		i.IpsetsMap,
 		i.ArpMap,
 		i.FailsafesMap,
+		i.FsafeRLMap,
+		i.RejectRLMap,
 		i.FrontendMap,
 		i.BackendMap,
 		i.AffinityMap,
+		i.NATStatsMap,
 		i.RouteMap,
 		i.CtMap,
 		i.SrMsgMap,
@@ -136,12 +136,16 @@ func Map() maps.Map {
 	b.UpgradeFn = maps.Upgrade
 	b.GetMapParams = GetMapParams
 	b.KVasUpgradable = GetKeyValueTypeFromVersion
+	b.LiveResize = true
+	b.KeepLargerSize = keepGrownMaps
 	return b
 }
 
 func MapV6() maps.Map {
 	b := maps.NewPinnedMap(MapParamsV6)
 	b.GetMapParams = GetMapParams
+	b.LiveResize = true
+	b.KeepLargerSize = keepGrownMaps
 	return b
 }
 
@@ -0,0 +1,365 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conntrack
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+const (
+	// MigrationBatchSize is the number of entries that the LiveResizeMigrator
+	// copies from the old map before it yields.
+	MigrationBatchSize = 1000
+	// MigrationBatchInterval is how long the LiveResizeMigrator waits between
+	// batches so that a large migration does not hog the CPU.
+	MigrationBatchInterval = 10 * time.Millisecond
+)
+
+var (
+	registerResizeOnce sync.Once
+
+	gaugeVecResizeInProgress = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_bpf_conntrack_resize_in_progress",
+		Help: "1 if entries are being migrated from the old conntrack map after a resize, 0 otherwise.",
+	}, []string{"ip_version"})
+	gaugeVecResizeEntries = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_bpf_conntrack_resize_entries",
+		Help: "Number of entries in the old conntrack map when the current migration started.",
+	}, []string{"ip_version"})
+	counterVecResizeEntriesMigrated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_bpf_conntrack_resize_entries_migrated_total",
+		Help: "Cumulative number of entries copied from the old conntrack map to the resized one in the background.",
+	}, []string{"ip_version"})
+	counterVecResizeEntriesSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_bpf_conntrack_resize_entries_skipped_total",
+		Help: "Cumulative number of entries from the old conntrack map that were already up to date in the resized one.",
+	}, []string{"ip_version"})
+	gaugeVecMapSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_bpf_conntrack_map_size",
+		Help: "Maximum number of entries of the conntrack map.",
+	}, []string{"ip_version"})
+	gaugeVecMapOccupancy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_bpf_conntrack_map_occupancy",
+		Help: "Fraction of the conntrack map that was in use at the end of the last scan.",
+	}, []string{"ip_version"})
+)
+
+func registerResizeMetrics() {
+	registerResizeOnce.Do(func() {
+		prometheus.MustRegister(
+			gaugeVecResizeInProgress,
+			gaugeVecResizeEntries,
+			counterVecResizeEntriesMigrated,
+			counterVecResizeEntriesSkipped,
+			gaugeVecMapSize,
+			gaugeVecMapOccupancy,
+		)
+	})
+}
+
+var keepGrownMaps bool
+
+// SetMapAutoScaling makes the conntrack maps keep their size when they were
+// grown beyond the configured size by an AutoScaler.
+func SetMapAutoScaling(enabled bool) {
+	keepGrownMaps = enabled
+}
+
+// LiveResizeMigrator finishes the migration of a conntrack map that was
+// resized.  EnsureExists copies all the entries to the new map before the BPF
+// programs start using it, but the programs keep creating and updating entries
+// in the old map until they are all replaced.  Once they use the new map, the
+// migrator copies those entries in the background.  An entry of the old map
+// replaces the one in the new map only if it was seen more recently.
+type LiveResizeMigrator struct {
+	ctMap          *maps.PinnedMap
+	ipVersion      string
+	valueFromBytes func([]byte) ValueInterface
+
+	batchSize     int
+	batchInterval time.Duration
+
+	wg       sync.WaitGroup
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewLiveResizeMigrator returns a migrator for the given conntrack map.  The
+// map must be a pinned map that was opened with LiveResize enabled.
+func NewLiveResizeMigrator(ctMap maps.Map, ipVersion int) (*LiveResizeMigrator, error) {
+	pm, ok := ctMap.(*maps.PinnedMap)
+	if !ok {
+		return nil, fmt.Errorf("unsupported conntrack map type %T", ctMap)
+	}
+	registerResizeMetrics()
+	valueFromBytes := ValueFromBytes
+	if ipVersion == 6 {
+		valueFromBytes = ValueV6FromBytes
+	}
+	return &LiveResizeMigrator{
+		ctMap:          pm,
+		ipVersion:      fmt.Sprint(ipVersion),
+		valueFromBytes: valueFromBytes,
+		batchSize:      MigrationBatchSize,
+		batchInterval:  MigrationBatchInterval,
+		stopCh:         make(chan struct{}),
+	}, nil
+}
+
+// Start runs the migration in the background if the map is being resized.
+func (m *LiveResizeMigrator) Start() {
+	if !m.ctMap.LiveResizeInProgress() {
+		return
+	}
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		if err := m.Migrate(); err != nil {
+			log.WithError(err).WithField("map", m.ctMap.GetName()).Error(
+				"Failed to migrate entries from the old conntrack map.")
+		}
+	}()
+}
+
+// Stop stops the migration and waits for it to finish.  An interrupted
+// migration is resumed when felix restarts.
+func (m *LiveResizeMigrator) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+		m.wg.Wait()
+	})
+}
+
+var errMigrationStopped = errors.New("migration stopped")
+
+// Migrate copies the entries that changed in the old map and releases it.
+func (m *LiveResizeMigrator) Migrate() error {
+	if !m.ctMap.LiveResizeInProgress() {
+		return nil
+	}
+	oldFD, oldSize := m.ctMap.OldMapFD()
+
+	logCxt := log.WithFields(log.Fields{
+		"map":     m.ctMap.GetName(),
+		"oldSize": oldSize,
+		"newSize": m.ctMap.MaxEntries,
+	})
+
+	gaugeVecResizeInProgress.WithLabelValues(m.ipVersion).Set(1)
+	defer gaugeVecResizeInProgress.WithLabelValues(m.ipVersion).Set(0)
+	gaugeVecMapSize.WithLabelValues(m.ipVersion).Set(float64(m.ctMap.MaxEntries))
+
+	total := 0
+	err := m.iterOld(oldFD, oldSize, func(k, v []byte) error {
+		total++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	gaugeVecResizeEntries.WithLabelValues(m.ipVersion).Set(float64(total))
+	logCxt.WithField("entries", total).Info("Migrating entries from the old conntrack map.")
+
+	migrated, skipped := 0, 0
+	err = m.iterOld(oldFD, oldSize, func(k, v []byte) error {
+		skip := func() error {
+			skipped++
+			counterVecResizeEntriesSkipped.WithLabelValues(m.ipVersion).Inc()
+			return nil
+		}
+
+		cur, err := m.ctMap.Get(k)
+		if err == nil {
+			if m.valueFromBytes(cur).LastSeen() >= m.valueFromBytes(v).LastSeen() {
+				return skip()
+			}
+			err = m.ctMap.Update(k, v)
+		} else if maps.IsNotExists(err) {
+			// Do not overwrite an entry that the programs created since.
+			err = m.ctMap.UpdateWithFlags(k, v, unix.BPF_NOEXIST)
+			if errors.Is(err, unix.EEXIST) {
+				return skip()
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to copy conntrack entry: %w", err)
+		}
+		migrated++
+		counterVecResizeEntriesMigrated.WithLabelValues(m.ipVersion).Inc()
+		return nil
+	})
+	if err != nil {
+		if err == errMigrationStopped {
+			logCxt.Info("Migration of the old conntrack map stopped, it will resume after restart.")
+			return nil
+		}
+		return err
+	}
+
+	logCxt.WithFields(log.Fields{
+		"migrated": migrated,
+		"skipped":  skipped,
+	}).Info("Finished migrating entries from the old conntrack map.")
+	return m.ctMap.FinishLiveResize()
+}
+
+// iterOld calls f for each entry of the old map, pausing after every batch.
+func (m *LiveResizeMigrator) iterOld(fd maps.FD, size int, f func(k, v []byte) error) error {
+	it, err := maps.NewIterator(fd, m.ctMap.KeySize, m.ctMap.ValueSize, size)
+	if err != nil {
+		return fmt.Errorf("failed to create BPF map iterator: %w", err)
+	}
+	defer func() {
+		err := it.Close()
+		if err != nil {
+			log.WithError(err).Panic("Unexpected error from map iterator Close().")
+		}
+	}()
+
+	n := 0
+	for {
+		k, v, err := it.Next()
+		if err != nil {
+			if err == maps.ErrIterationFinished {
+				return nil
+			}
+			return fmt.Errorf("iterating the old map failed: %w", err)
+		}
+		if err := f(k, v); err != nil {
+			return err
+		}
+		n++
+		if n%m.batchSize == 0 {
+			select {
+			case <-m.stopCh:
+				return errMigrationStopped
+			case <-time.After(m.batchInterval):
+			}
+		}
+	}
+}
+
+// AutoScaler is an EntryScanner that keeps track of how many entries are in
+// the conntrack map and grows the map when the occupancy at the end of a scan
+// reaches the threshold.  Growing the map pins a new map of double the size and
+// restarts felix.  The new map is filled with the entries of the current map
+// when felix starts again, before the BPF programs switch to it, and a
+// LiveResizeMigrator copies the entries that changed during the switch.
+type AutoScaler struct {
+	ctMap     GrowableMap
+	ipVersion string
+
+	thresholdPercent int
+	maxSize          int
+	onGrow           func()
+
+	count   int
+	growing bool
+}
+
+// GrowableMap is a map that an AutoScaler can grow, such as a pinned map that
+// was opened with LiveResize enabled.
+type GrowableMap interface {
+	GetName() string
+	Size() int
+	LiveResizeInProgress() bool
+	Grow(size int) error
+}
+
+// NewAutoScaler returns an AutoScaler for the given conntrack map, which must
+// be a GrowableMap.  onGrow is called once the map was grown and felix needs
+// to restart to use it.
+func NewAutoScaler(ctMap maps.Map, ipVersion int, thresholdPercent, maxSize int, onGrow func()) (*AutoScaler, error) {
+	gm, ok := ctMap.(GrowableMap)
+	if !ok {
+		return nil, fmt.Errorf("unsupported conntrack map type %T", ctMap)
+	}
+	if thresholdPercent <= 0 || thresholdPercent > 100 {
+		return nil, fmt.Errorf("invalid occupancy threshold %d%%", thresholdPercent)
+	}
+	registerResizeMetrics()
+	return &AutoScaler{
+		ctMap:            gm,
+		ipVersion:        fmt.Sprint(ipVersion),
+		thresholdPercent: thresholdPercent,
+		maxSize:          maxSize,
+		onGrow:           onGrow,
+	}, nil
+}
+
+func (s *AutoScaler) IterationStart() {
+	s.count = 0
+}
+
+func (s *AutoScaler) Check(KeyInterface, ValueInterface, EntryGet) ScanVerdict {
+	s.count++
+	return ScanVerdictOK
+}
+
+func (s *AutoScaler) IterationEnd() {
+	size := s.ctMap.Size()
+	gaugeVecMapSize.WithLabelValues(s.ipVersion).Set(float64(size))
+	gaugeVecMapOccupancy.WithLabelValues(s.ipVersion).Set(float64(s.count) / float64(size))
+
+	if s.growing || s.count*100 < size*s.thresholdPercent {
+		return
+	}
+
+	logCxt := log.WithFields(log.Fields{
+		"map":     s.ctMap.GetName(),
+		"entries": s.count,
+		"size":    size,
+	})
+
+	if s.ctMap.LiveResizeInProgress() {
+		logCxt.Debug("Conntrack map is over the threshold but a resize is in progress.")
+		return
+	}
+	newSize := NextMapSize(size, s.maxSize)
+	if newSize <= size {
+		logCxt.Warn("Conntrack map is over the threshold but it already has the maximum size.")
+		return
+	}
+
+	logCxt.WithField("newSize", newSize).Info("Conntrack map is over the threshold, growing it.")
+	if err := s.ctMap.Grow(newSize); err != nil {
+		logCxt.WithError(err).Error("Failed to grow conntrack map.")
+		return
+	}
+	s.growing = true
+	if s.onGrow != nil {
+		s.onGrow()
+	}
+}
+
+// NextMapSize returns the size that a full map of the given size grows to.
+func NextMapSize(size, maxSize int) int {
+	newSize := size * 2
+	if maxSize > 0 && newSize > maxSize {
+		newSize = maxSize
+	}
+	return newSize
+}
+
+var _ EntryScannerSynced = (*AutoScaler)(nil)
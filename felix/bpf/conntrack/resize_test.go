@@ -0,0 +1,158 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conntrack_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/bpf/conntrack"
+	"github.com/projectcalico/calico/felix/bpf/maps"
+	"github.com/projectcalico/calico/felix/bpf/mock"
+)
+
+var _ = Describe("BPF conntrack map resize", func() {
+	DescribeTable("NextMapSize",
+		func(size, maxSize, expected int) {
+			Expect(conntrack.NextMapSize(size, maxSize)).To(Equal(expected))
+		},
+		Entry("doubles the size", 1000, 4000, 2000),
+		Entry("caps the size", 3000, 4000, 4000),
+		Entry("does not grow at the maximum", 4000, 4000, 4000),
+		Entry("has no cap with zero maximum", 4000, 0, 8000),
+	)
+
+	It("should only scale growable maps", func() {
+		_, err := conntrack.NewAutoScaler(mock.NewMockMap(conntrack.MapParams), 4, 90, 0, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject an invalid threshold", func() {
+		_, err := conntrack.NewAutoScaler(maps.NewPinnedMap(conntrack.MapParams), 4, 0, 0, nil)
+		Expect(err).To(HaveOccurred())
+		_, err = conntrack.NewAutoScaler(maps.NewPinnedMap(conntrack.MapParams), 4, 101, 0, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("AutoScaler", func() {
+		var (
+			ctMap   *growableMap
+			scaler  *conntrack.AutoScaler
+			restart int
+		)
+
+		BeforeEach(func() {
+			ctMap = &growableMap{
+				Map:  mock.NewMockMap(conntrack.MapParams),
+				size: 1000,
+			}
+			restart = 0
+			var err error
+			scaler, err = conntrack.NewAutoScaler(ctMap, 4, 90, 4000, func() { restart++ })
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		scan := func(entries int) {
+			scaler.IterationStart()
+			for i := 0; i < entries; i++ {
+				scaler.Check(nil, nil, nil)
+			}
+			scaler.IterationEnd()
+		}
+
+		It("should not grow the map below the threshold", func() {
+			scan(899)
+			Expect(ctMap.grownTo).To(BeEmpty())
+			Expect(restart).To(Equal(0))
+		})
+
+		It("should double the map at the threshold and restart", func() {
+			scan(900)
+			Expect(ctMap.grownTo).To(Equal([]int{2000}))
+			Expect(restart).To(Equal(1))
+		})
+
+		It("should only grow the map once", func() {
+			scan(1000)
+			scan(1000)
+			Expect(ctMap.grownTo).To(Equal([]int{2000}))
+			Expect(restart).To(Equal(1))
+		})
+
+		It("should not grow the map while a resize is in progress", func() {
+			ctMap.resizing = true
+			scan(1000)
+			Expect(ctMap.grownTo).To(BeEmpty())
+			Expect(restart).To(Equal(0))
+		})
+
+		It("should cap the size at the maximum", func() {
+			ctMap.size = 3000
+			scan(3000)
+			Expect(ctMap.grownTo).To(Equal([]int{4000}))
+		})
+
+		It("should not grow the map beyond the maximum", func() {
+			ctMap.size = 4000
+			scan(4000)
+			Expect(ctMap.grownTo).To(BeEmpty())
+			Expect(restart).To(Equal(0))
+		})
+
+		It("should retry if growing the map failed", func() {
+			ctMap.growErr = errors.New("bpftool failed")
+			scan(1000)
+			Expect(restart).To(Equal(0))
+			ctMap.growErr = nil
+			scan(1000)
+			Expect(ctMap.grownTo).To(Equal([]int{2000, 2000}))
+			Expect(restart).To(Equal(1))
+		})
+	})
+
+	It("should only migrate pinned maps", func() {
+		_, err := conntrack.NewLiveResizeMigrator(mock.NewMockMap(conntrack.MapParams), 4)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should create conntrack maps that support live resize", func() {
+		Expect(conntrack.Map().(*maps.PinnedMap).LiveResize).To(BeTrue())
+		Expect(conntrack.MapV6().(*maps.PinnedMap).LiveResize).To(BeTrue())
+	})
+})
+
+type growableMap struct {
+	*mock.Map
+	size     int
+	resizing bool
+	growErr  error
+	grownTo  []int
+}
+
+func (m *growableMap) Size() int {
+	return m.size
+}
+
+func (m *growableMap) LiveResizeInProgress() bool {
+	return m.resizing
+}
+
+func (m *growableMap) Grow(size int) error {
+	m.grownTo = append(m.grownTo, size)
+	return m.growErr
+}
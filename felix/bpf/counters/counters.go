@@ -27,7 +27,7 @@ import (
 )
 
 const (
-	MaxCounterNumber    int = 17
+	MaxCounterNumber    int = 18
 	counterMapKeySize   int = 8
 	counterMapValueSize int = 8
 )
@@ -76,6 +76,7 @@ const (
 	SourceCollisionHit
 	SourceCollisionResolutionFailed
 	ConntrackCreateFailed
+	DroppedByRateLimit
 )
 
 type Description struct {
@@ -170,6 +171,10 @@ var descriptions DescList = DescList{
 		Counter:  SourceCollisionResolutionFailed,
 		Category: "Dropped", Caption: "NAT source collision resolution failed",
 	},
+	{
+		Counter:  DroppedByRateLimit,
+		Category: "Dropped", Caption: "by failsafe rate limit",
+	},
 }
 
 func Descriptions() DescList {
@@ -382,6 +382,13 @@ const (
 	GlobalsNoDSRCidrs       uint32 = C.CALI_GLOBALS_NO_DSR_CIDRS
 	GlobalsLoUDPOnly        uint32 = C.CALI_GLOBALS_LO_UDP_ONLY
 	GlobalsRedirectPeer     uint32 = C.CALI_GLOBALS_REDIRECT_PEER
+	GlobalsNATStats         uint32 = C.CALI_GLOBALS_NAT_STATS
+	GlobalsEDTSchedule      uint32 = C.CALI_GLOBALS_EDT_SCHEDULE
+	GlobalsEDTReset         uint32 = C.CALI_GLOBALS_EDT_RESET
+	GlobalsDNSSnoop         uint32 = C.CALI_GLOBALS_DNS_SNOOP
+
+	// Set when packets of established flows should skip XDP policy.
+	XDPGlobalsCTFastPath uint32 = C.CALI_XDP_GLOBALS_CT_FAST_PATH
 )
 
 func (t *TcGlobalData) Set(m *Map) error {
@@ -420,6 +427,8 @@ func (t *TcGlobalData) Set(m *Map) error {
 		C.uint(t.NatIn),
 		C.uint(t.NatOut),
 		C.uint(t.LogFilterJmp),
+		C.uint(t.EDTID),
+		C.uint(t.RejectRateLimit),
 		&cJumps[0], // it is safe because we hold the reference here until we return.
 		&cJumpsV6[0],
 	)
@@ -446,7 +455,7 @@ func (c *CTCleanupGlobalData) Set(m *Map) error {
 
 func (c *CTLBGlobalData) Set(m *Map) error {
 	udpNotSeen := c.UDPNotSeen / time.Second // Convert to seconds
-	_, err := C.bpf_ctlb_set_globals(m.bpfMap, C.uint(udpNotSeen), C.bool(c.ExcludeUDP))
+	_, err := C.bpf_ctlb_set_globals(m.bpfMap, C.uint(udpNotSeen), C.bool(c.ExcludeUDP), C.bool(c.NATStats))
 
 	return err
 }
@@ -469,6 +478,8 @@ func (x *XDPGlobalData) Set(m *Map) error {
 		cName,
 		&cJumps[0],
 		&cJumpsV6[0],
+		C.uint(x.Flags),
+		C.uint(x.FailsafeRateLimit),
 	)
 
 	return err
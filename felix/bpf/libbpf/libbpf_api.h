@@ -157,6 +157,8 @@ void bpf_tc_set_globals(struct bpf_map *map,
 			uint natin,
 			uint natout,
 			uint log_filter_jmp,
+			uint edt_id,
+			uint reject_rate_limit,
 			uint *jumps,
 			uint *jumps6)
 {
@@ -172,6 +174,8 @@ void bpf_tc_set_globals(struct bpf_map *map,
 		.natin_idx = natin,
 		.natout_idx = natout,
 		.log_filter_jmp = log_filter_jmp,
+		.edt_id = edt_id,
+		.reject_rate_limit = reject_rate_limit,
 	};
 
 	strncpy(v4.iface_name, iface_name, sizeof(v4.iface_name));
@@ -318,23 +322,27 @@ out:
 	return err;
 }
 
-void bpf_ctlb_set_globals(struct bpf_map *map, uint udp_not_seen_timeo, bool exclude_udp)
+void bpf_ctlb_set_globals(struct bpf_map *map, uint udp_not_seen_timeo, bool exclude_udp, bool nat_stats)
 {
 	struct cali_ctlb_globals data = {
 		.udp_not_seen_timeo = udp_not_seen_timeo,
 		.exclude_udp = exclude_udp,
+		.nat_stats = nat_stats,
 	};
 
 	set_errno(bpf_map__set_initial_value(map, (void*)(&data), sizeof(data)));
 }
 
-void bpf_xdp_set_globals(struct bpf_map *map, char *iface_name, uint *jumps, uint *jumpsV6)
+void bpf_xdp_set_globals(struct bpf_map *map, char *iface_name, uint *jumps, uint *jumpsV6,
+			 uint flags, uint fsafe_rate_limit)
 {
 	struct cali_xdp_preamble_globals data = {
 	};
 
 	strncpy(data.v4.iface_name, iface_name, sizeof(data.v4.iface_name));
 	data.v4.iface_name[sizeof(data.v4.iface_name)-1] = '\0';
+	data.v4.flags = flags;
+	data.v4.fsafe_rate_limit = fsafe_rate_limit;
 	data.v6 = data.v4;
 
 	int i;
@@ -23,23 +23,25 @@ type GlobalData interface {
 }
 
 type TcGlobalData struct {
-	IfaceName      string
-	HostIPv4       [16]byte
-	IntfIPv4       [16]byte
-	ExtToSvcMark   uint32
-	Tmtu           uint16
-	VxlanPort      uint16
-	PSNatStart     uint16
-	PSNatLen       uint16
-	HostTunnelIPv4 [16]byte
-	Flags          uint32
-	WgPort         uint16
-	Wg6Port        uint16
-	Profiling      uint16
-	NatIn          uint32
-	NatOut         uint32
-	LogFilterJmp   uint32
-	Jumps          [40]uint32
+	IfaceName       string
+	HostIPv4        [16]byte
+	IntfIPv4        [16]byte
+	ExtToSvcMark    uint32
+	Tmtu            uint16
+	VxlanPort       uint16
+	PSNatStart      uint16
+	PSNatLen        uint16
+	HostTunnelIPv4  [16]byte
+	Flags           uint32
+	WgPort          uint16
+	Wg6Port         uint16
+	Profiling       uint16
+	NatIn           uint32
+	NatOut          uint32
+	LogFilterJmp    uint32
+	EDTID           uint32
+	RejectRateLimit uint32
+	Jumps           [40]uint32
 
 	HostIPv6       [16]byte
 	IntfIPv6       [16]byte
@@ -48,9 +50,11 @@ type TcGlobalData struct {
 }
 
 type XDPGlobalData struct {
-	IfaceName string
-	Jumps     [16]uint32
-	JumpsV6   [16]uint32
+	IfaceName         string
+	Jumps             [16]uint32
+	JumpsV6           [16]uint32
+	Flags             uint32
+	FailsafeRateLimit uint32
 }
 
 type CTCleanupGlobalData struct {
@@ -67,4 +71,5 @@ type CTCleanupGlobalData struct {
 type CTLBGlobalData struct {
 	UDPNotSeen time.Duration
 	ExcludeUDP bool
+	NATStats   bool
 }
@@ -136,6 +136,12 @@ const (
 	GlobalsNoDSRCidrs       uint32 = 12345
 	GlobalsLoUDPOnly        uint32 = 12345
 	GlobalsRedirectPeer     uint32 = 12345
+	GlobalsNATStats         uint32 = 12345
+	GlobalsEDTSchedule      uint32 = 12345
+	GlobalsEDTReset         uint32 = 12345
+	GlobalsDNSSnoop         uint32 = 12345
+
+	XDPGlobalsCTFastPath uint32 = 1
 )
 
 func (m *Map) SetSize(size int) error {
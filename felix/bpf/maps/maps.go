@@ -242,6 +242,21 @@ type PinnedMap struct {
 	oldfd    FD
 	perCPU   bool
 	oldSize  int
+
+	// LiveResize makes EnsureExists keep the old map pinned with the "_old"
+	// suffix when the size of the map changes.  The entries are copied to the
+	// new map before it is used and the owner of the map copies the entries
+	// that changed in the meantime in the background, calling
+	// FinishLiveResize() once it is done.
+	LiveResize bool
+	// liveResizeLock protects oldfd and oldSize while the entries of a live
+	// resize are migrated in the background.
+	liveResizeLock sync.Mutex
+	// KeepLargerSize makes EnsureExists keep an existing map that is larger
+	// than MaxEntries rather than shrinking it.  It is used for maps that
+	// felix grows at runtime.
+	KeepLargerSize bool
+
 	// Callbacks to handle upgrade
 	UpgradeFn      func(*PinnedMap, *PinnedMap) error
 	GetMapParams   func(int) MapParameters
@@ -254,7 +269,7 @@ func (b *PinnedMap) GetName() string {
 
 func (b *PinnedMap) MapFD() FD {
 	if !b.fdLoaded {
-		log.WithField("map", *b).Panic("MapFD() called without first calling EnsureExists()")
+		log.WithField("map", b.Name).Panic("MapFD() called without first calling EnsureExists()")
 	}
 	return b.fd
 }
@@ -265,11 +280,13 @@ func (b *PinnedMap) Path() string {
 
 func (b *PinnedMap) Close() error {
 	err := b.fd.Close()
+	b.liveResizeLock.Lock()
 	if b.oldfd > 0 {
 		b.oldfd.Close()
 	}
-	b.fdLoaded = false
 	b.oldfd = 0
+	b.liveResizeLock.Unlock()
+	b.fdLoaded = false
 	b.fd = 0
 	return err
 }
@@ -486,7 +503,13 @@ func (b *PinnedMap) updateDeltaEntries() error {
 	return nil
 }
 
-func (b *PinnedMap) copyFromOldMap() error {
+// copyFromOldMap copies all the entries of the old map to the new map.  With
+// keepExisting, entries that are already in the new map are left alone.
+func (b *PinnedMap) copyFromOldMap(keepExisting bool) error {
+	flags := unix.BPF_ANY
+	if keepExisting {
+		flags = unix.BPF_NOEXIST
+	}
 	numEntriesCopied := 0
 	mapMem := make(map[string]struct{})
 	it, err := NewIterator(b.oldfd, b.KeySize, b.ValueSize, b.oldSize)
@@ -517,8 +540,8 @@ func (b *PinnedMap) copyFromOldMap() error {
 			continue
 		}
 
-		err = b.Update(k, v)
-		if err != nil {
+		err = b.UpdateWithFlags(k, v, flags)
+		if err != nil && !(keepExisting && errors.Is(err, unix.EEXIST)) {
 			return fmt.Errorf("error copying data from the old map")
 		}
 		log.WithField("name", b.Name).Debugf("copied data from old map to new map key=%v, value=%v", k, v)
@@ -597,6 +620,157 @@ func (b *PinnedMap) oldMapExists() bool {
 	return true
 }
 
+// useSize records the actual size of the map so that the programs that use
+// the map are loaded with a matching definition.
+func (b *PinnedMap) useSize(size int) {
+	b.MaxEntries = size
+	SetSize(b.VersionedName(), size)
+}
+
+// resumeLiveResize reopens both maps of a live resize that was interrupted by
+// a restart, or that was started by Grow(), and copies the entries that are
+// not in the new map yet so that the programs find them once they switch to
+// the new map.  It returns false if the maps do not match the current
+// parameters and the resize cannot be resumed.
+func (b *PinnedMap) resumeLiveResize() (bool, error) {
+	oldMapPath := b.Path() + "_old"
+	if _, err := os.Stat(b.Path()); err != nil {
+		return false, nil
+	}
+
+	if err := b.Open(); err != nil {
+		return false, err
+	}
+	mapInfo, err := GetMapInfo(b.fd)
+	if err != nil {
+		return false, fmt.Errorf("error getting map info of the pinned map %w", err)
+	}
+	size := b.MaxEntries
+	if b.KeepLargerSize && mapInfo.MaxEntries > size {
+		size = mapInfo.MaxEntries
+	}
+	if b.KeySize != mapInfo.KeySize || b.ValueSize != mapInfo.ValueSize || size != mapInfo.MaxEntries {
+		log.WithField("name", b.Name).Info("Partially-migrated map does not match parameters, cannot resume live resize.")
+		b.fd.Close()
+		b.fd = 0
+		b.fdLoaded = false
+		return false, nil
+	}
+
+	fd, err := libbpf.ObjGet(oldMapPath)
+	if err != nil {
+		b.fd.Close()
+		b.fd = 0
+		b.fdLoaded = false
+		return false, fmt.Errorf("cannot get old map at %s: %w", oldMapPath, err)
+	}
+	b.oldfd = FD(fd)
+	oldInfo, err := GetMapInfo(b.oldfd)
+	if err != nil {
+		return false, fmt.Errorf("error getting map info of the old map %w", err)
+	}
+	b.oldSize = oldInfo.MaxEntries
+	b.useSize(size)
+
+	if err := b.copyFromOldMap(true); err != nil {
+		return false, fmt.Errorf("error copying data from the old map %s: %w", b.GetName(), err)
+	}
+
+	log.WithFields(log.Fields{
+		"name":    b.Name,
+		"oldSize": b.oldSize,
+		"newSize": size,
+	}).Info("Resuming live resize of BPF map.")
+	return true, nil
+}
+
+// LiveResizeInProgress returns true if the map was resized and the entries
+// from the old map have not been migrated yet.
+func (b *PinnedMap) LiveResizeInProgress() bool {
+	b.liveResizeLock.Lock()
+	defer b.liveResizeLock.Unlock()
+	return b.LiveResize && b.oldfd != 0
+}
+
+// Size returns the maximum number of entries of the map.
+func (b *PinnedMap) Size() int {
+	return b.MaxEntries
+}
+
+// OldMapFD returns the file descriptor of the map that is being replaced by a
+// live resize and its size.
+func (b *PinnedMap) OldMapFD() (FD, int) {
+	b.liveResizeLock.Lock()
+	defer b.liveResizeLock.Unlock()
+	return b.oldfd, b.oldSize
+}
+
+// FinishLiveResize releases the old map once its entries were migrated.
+func (b *PinnedMap) FinishLiveResize() error {
+	b.liveResizeLock.Lock()
+	defer b.liveResizeLock.Unlock()
+	if b.oldfd == 0 {
+		return nil
+	}
+	err := b.oldfd.Close()
+	if err != nil {
+		log.WithError(err).Warn("Error closing old map fd. Ignoring.")
+	}
+	b.oldfd = 0
+	b.oldSize = 0
+	err = os.Remove(b.Path() + "_old")
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing the pin of the old map %s: %w", b.GetName(), err)
+	}
+	log.WithField("name", b.Name).Info("Live resize of BPF map finished.")
+	return nil
+}
+
+// Grow replaces the pinned map with an empty map of the given size and pins
+// the current map with the "_old" suffix.  Programs that are already loaded
+// keep using the current map until they are reloaded, which is expected to
+// happen on the next start of felix, at which point EnsureExists copies the
+// entries to the grown map before the programs switch to it.
+func (b *PinnedMap) Grow(size int) error {
+	if !b.LiveResize {
+		return fmt.Errorf("map %s does not support live resize", b.GetName())
+	}
+	if size <= b.MaxEntries {
+		return fmt.Errorf("map %s cannot grow from %d to %d entries", b.GetName(), b.MaxEntries, size)
+	}
+	if b.oldMapExists() {
+		return fmt.Errorf("resize of map %s already in progress", b.GetName())
+	}
+
+	err := b.repinAt(int(b.MapFD()), b.Path(), b.Path()+"_old")
+	if err != nil {
+		return fmt.Errorf("error repinning the map %w", err)
+	}
+
+	cmd := exec.Command("bpftool", "map", "create", b.VersionedFilename(),
+		"type", b.Type,
+		"key", fmt.Sprint(b.KeySize),
+		"value", fmt.Sprint(b.ValueSize),
+		"entries", fmt.Sprint(size),
+		"name", b.VersionedName(),
+		"flags", fmt.Sprint(b.Flags),
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		log.WithField("out", string(out)).Error("Failed to run bpftool")
+		if err := b.repinAt(int(b.MapFD()), b.Path()+"_old", b.Path()); err != nil {
+			log.WithError(err).Error("Failed to restore the pin of the map.")
+		}
+		return err
+	}
+	log.WithFields(log.Fields{
+		"name":    b.Name,
+		"oldSize": b.MaxEntries,
+		"newSize": size,
+	}).Info("Created larger BPF map, entries will be migrated after restart.")
+	return nil
+}
+
 func (b *PinnedMap) EnsureExists() error {
 	oldMapPath := b.Path() + "_old"
 	copyData := false
@@ -604,6 +778,18 @@ func (b *PinnedMap) EnsureExists() error {
 		return nil
 	}
 
+	// A live resize keeps the new map in use while the entries are migrated,
+	// so, if felix restarted in the middle of one, pick up where it left off.
+	if b.LiveResize && b.oldMapExists() {
+		resumed, err := b.resumeLiveResize()
+		if err != nil {
+			return err
+		}
+		if resumed {
+			return nil
+		}
+	}
+
 	// In case felix restarts in the middle of migration, the in-use map
 	// will be pinned with suffix "_old" and the map in the normal place
 	// wil be a partially-migrated map.  Clean up the partial map and move
@@ -652,6 +838,12 @@ func (b *PinnedMap) EnsureExists() error {
 				log.WithField("name", b.Name).Info("Map already exists with correct parameters.")
 				return nil
 			}
+			if b.KeepLargerSize && mapInfo.MaxEntries > b.MaxEntries {
+				log.WithField("name", b.Name).Infof("Map already exists and is larger than configured; keeping it (%d > %d)",
+					mapInfo.MaxEntries, b.MaxEntries)
+				b.useSize(mapInfo.MaxEntries)
+				return nil
+			}
 			log.WithField("name", b.Name).Infof("BPF map size changed; need to migrate %d -> %d", mapInfo.MaxEntries, b.MaxEntries)
 
 			// store the old fd
@@ -700,7 +892,7 @@ func (b *PinnedMap) EnsureExists() error {
 		if copyData {
 			// Copy data from old map to the new map. Old map and new map are of the
 			// same version but of different size.
-			err := b.copyFromOldMap()
+			err := b.copyFromOldMap(false)
 			if err != nil {
 				log.WithError(err).Error("error copying data from old map")
 				closeErr := b.fd.Close()
@@ -794,6 +986,10 @@ func (b *PinnedMap) CopyDeltaFromOldMap() error {
 	if err != nil {
 		return fmt.Errorf("error upgrading data from old map %s, err=%w", b.GetName(), err)
 	}
+	if b.LiveResize {
+		log.WithField("name", b.Name).Debug("CopyDeltaFromOldMap - live resize, entries migrated by the map owner.")
+		return nil
+	}
 	if b.oldfd == 0 {
 		log.WithField("name", b.Name).Debug("CopyDeltaFromOldMap - no old map, done.")
 		return nil
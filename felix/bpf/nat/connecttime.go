@@ -117,9 +117,13 @@ func RemoveConnectTimeLoadBalancer(cgroupv2 string) error {
 	return nil
 }
 
-func loadProgram(logLevel, ipver string, udpNotSeen time.Duration, excludeUDP bool) (*libbpf.Obj, error) {
+func loadProgram(logLevel, ipver string, udpNotSeen time.Duration, excludeUDP, natStats bool) (*libbpf.Obj, error) {
 	filename := path.Join(bpfdefs.ObjectDir, ProgFileName(logLevel, ipver))
-	obj, err := bpf.LoadObject(filename, &libbpf.CTLBGlobalData{UDPNotSeen: udpNotSeen, ExcludeUDP: excludeUDP})
+	obj, err := bpf.LoadObject(filename, &libbpf.CTLBGlobalData{
+		UDPNotSeen: udpNotSeen,
+		ExcludeUDP: excludeUDP,
+		NATStats:   natStats,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("error loading %s:%w", filename, err)
 	}
@@ -160,7 +164,8 @@ func updateCTLBJumpMap(jumpMap maps.Map, obj *libbpf.Obj) error {
 	return nil
 }
 
-func InstallConnectTimeLoadBalancer(ipv4Enabled, ipv6Enabled bool, cgroupv2 string, logLevel string, udpNotSeen time.Duration, excludeUDP bool) error {
+func InstallConnectTimeLoadBalancer(ipv4Enabled, ipv6Enabled bool, cgroupv2 string, logLevel string,
+	udpNotSeen time.Duration, excludeUDP, natStats bool) error {
 
 	bpfMount, err := utils.MaybeMountBPFfs()
 	if err != nil {
@@ -178,13 +183,13 @@ func InstallConnectTimeLoadBalancer(ipv4Enabled, ipv6Enabled bool, cgroupv2 stri
 
 	// Load and attach v4, v46 CTLB program.
 	if ipv4Enabled {
-		v4Obj, err = loadProgram(logLevel, "4", udpNotSeen, excludeUDP)
+		v4Obj, err = loadProgram(logLevel, "4", udpNotSeen, excludeUDP, natStats)
 		if err != nil {
 			return err
 		}
 		defer v4Obj.Close()
 
-		v46Obj, err = loadProgram(logLevel, "46", udpNotSeen, excludeUDP)
+		v46Obj, err = loadProgram(logLevel, "46", udpNotSeen, excludeUDP, natStats)
 		if err != nil {
 			return err
 		}
@@ -226,7 +231,7 @@ func InstallConnectTimeLoadBalancer(ipv4Enabled, ipv6Enabled bool, cgroupv2 stri
 	}
 	// Load the v6 CTLB program.
 	if ipv6Enabled {
-		v6Obj, err = loadProgram(logLevel, "6", udpNotSeen, excludeUDP)
+		v6Obj, err = loadProgram(logLevel, "6", udpNotSeen, excludeUDP, natStats)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,285 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nat
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+func init() {
+	maps.SetSize(StatsMapParameters.VersionedName(), StatsMapParameters.MaxEntries)
+	maps.SetSize(StatsMapV6Parameters.VersionedName(), StatsMapV6Parameters.MaxEntries)
+}
+
+//	struct calico_nat_stats_key {
+//	   uint32_t fe_addr; // NBO
+//	   uint32_t be_addr; // NBO
+//	   uint16_t fe_port; // HBO
+//	   uint16_t be_port; // HBO
+//	   uint8_t protocol;
+//	   uint8_t pad[3];
+//	};
+const statsKeySize = 16
+
+//	struct calico_nat_stats_key {
+//	   ipv6_addr_t fe_addr; // NBO
+//	   ipv6_addr_t be_addr; // NBO
+//	   uint16_t fe_port; // HBO
+//	   uint16_t be_port; // HBO
+//	   uint8_t protocol;
+//	   uint8_t pad[3];
+//	};
+const statsKeyV6Size = 40
+
+//	struct calico_nat_stats_value {
+//	   uint64_t packets_to_be;
+//	   uint64_t bytes_to_be;
+//	   uint64_t packets_from_be;
+//	   uint64_t bytes_from_be;
+//	   uint64_t conns;
+//	   uint64_t ctlb_conns;
+//	};
+const statsValueSize = 48
+
+// StatsKeyInterface is a key of the NAT stats map, which keeps the traffic
+// counters of a frontend and backend pair.
+type StatsKeyInterface interface {
+	FrontendAddr() net.IP
+	FrontendPort() uint16
+	BackendAddr() net.IP
+	BackendPort() uint16
+	Proto() uint8
+	AsBytes() []byte
+	String() string
+}
+
+type StatsKey [statsKeySize]byte
+
+func NewStatsKey(feAddr net.IP, fePort uint16, beAddr net.IP, bePort uint16, proto uint8) StatsKey {
+	var k StatsKey
+	copy(k[0:4], feAddr.To4())
+	copy(k[4:8], beAddr.To4())
+	binary.LittleEndian.PutUint16(k[8:10], fePort)
+	binary.LittleEndian.PutUint16(k[10:12], bePort)
+	k[12] = proto
+	return k
+}
+
+func (k StatsKey) FrontendAddr() net.IP {
+	return k[0:4]
+}
+
+func (k StatsKey) BackendAddr() net.IP {
+	return k[4:8]
+}
+
+func (k StatsKey) FrontendPort() uint16 {
+	return binary.LittleEndian.Uint16(k[8:10])
+}
+
+func (k StatsKey) BackendPort() uint16 {
+	return binary.LittleEndian.Uint16(k[10:12])
+}
+
+func (k StatsKey) Proto() uint8 {
+	return k[12]
+}
+
+func (k StatsKey) AsBytes() []byte {
+	return k[:]
+}
+
+func (k StatsKey) String() string {
+	return fmt.Sprintf("NATStatsKey{Frontend: %v:%v, Backend: %v:%v, Proto: %v}",
+		k.FrontendAddr(), k.FrontendPort(), k.BackendAddr(), k.BackendPort(), k.Proto())
+}
+
+func StatsKeyFromBytes(b []byte) StatsKeyInterface {
+	var k StatsKey
+	copy(k[:], b)
+	return k
+}
+
+type StatsKeyV6 [statsKeyV6Size]byte
+
+func NewStatsKeyV6(feAddr net.IP, fePort uint16, beAddr net.IP, bePort uint16, proto uint8) StatsKeyV6 {
+	var k StatsKeyV6
+	copy(k[0:16], feAddr.To16())
+	copy(k[16:32], beAddr.To16())
+	binary.LittleEndian.PutUint16(k[32:34], fePort)
+	binary.LittleEndian.PutUint16(k[34:36], bePort)
+	k[36] = proto
+	return k
+}
+
+func (k StatsKeyV6) FrontendAddr() net.IP {
+	return k[0:16]
+}
+
+func (k StatsKeyV6) BackendAddr() net.IP {
+	return k[16:32]
+}
+
+func (k StatsKeyV6) FrontendPort() uint16 {
+	return binary.LittleEndian.Uint16(k[32:34])
+}
+
+func (k StatsKeyV6) BackendPort() uint16 {
+	return binary.LittleEndian.Uint16(k[34:36])
+}
+
+func (k StatsKeyV6) Proto() uint8 {
+	return k[36]
+}
+
+func (k StatsKeyV6) AsBytes() []byte {
+	return k[:]
+}
+
+func (k StatsKeyV6) String() string {
+	return fmt.Sprintf("NATStatsKeyV6{Frontend: [%v]:%v, Backend: [%v]:%v, Proto: %v}",
+		k.FrontendAddr(), k.FrontendPort(), k.BackendAddr(), k.BackendPort(), k.Proto())
+}
+
+func StatsKeyV6FromBytes(b []byte) StatsKeyInterface {
+	var k StatsKeyV6
+	copy(k[:], b)
+	return k
+}
+
+// StatsValue holds the counters of a NAT stats map entry summed over all CPUs.
+type StatsValue struct {
+	// PacketsToBackend and BytesToBackend count the packets that were DNATed
+	// from the frontend to the backend.
+	PacketsToBackend uint64
+	BytesToBackend   uint64
+	// PacketsFromBackend and BytesFromBackend count the replies that were
+	// SNATed from the backend back to the frontend.
+	PacketsFromBackend uint64
+	BytesFromBackend   uint64
+	// Conns counts the connections that were load balanced by the TC
+	// programs.
+	Conns uint64
+	// CTLBConns counts the connections that were load balanced at connect
+	// time.  Their packets never go through the NAT in the TC programs so
+	// they are not counted.
+	CTLBConns uint64
+}
+
+func (v *StatsValue) Add(o StatsValue) {
+	v.PacketsToBackend += o.PacketsToBackend
+	v.BytesToBackend += o.BytesToBackend
+	v.PacketsFromBackend += o.PacketsFromBackend
+	v.BytesFromBackend += o.BytesFromBackend
+	v.Conns += o.Conns
+	v.CTLBConns += o.CTLBConns
+}
+
+// Sub returns the counters that were added since o.  The map is an LRU map
+// and entries of removed backends get reused, so if any of the counters went
+// backwards, the entry was recreated and all of v is new.
+func (v StatsValue) Sub(o StatsValue) StatsValue {
+	if v.PacketsToBackend < o.PacketsToBackend || v.BytesToBackend < o.BytesToBackend ||
+		v.PacketsFromBackend < o.PacketsFromBackend || v.BytesFromBackend < o.BytesFromBackend ||
+		v.Conns < o.Conns || v.CTLBConns < o.CTLBConns {
+		return v
+	}
+	return StatsValue{
+		PacketsToBackend:   v.PacketsToBackend - o.PacketsToBackend,
+		BytesToBackend:     v.BytesToBackend - o.BytesToBackend,
+		PacketsFromBackend: v.PacketsFromBackend - o.PacketsFromBackend,
+		BytesFromBackend:   v.BytesFromBackend - o.BytesFromBackend,
+		Conns:              v.Conns - o.Conns,
+		CTLBConns:          v.CTLBConns - o.CTLBConns,
+	}
+}
+
+func (v StatsValue) String() string {
+	return fmt.Sprintf("to-backend packets %d bytes %d from-backend packets %d bytes %d conns %d ctlb-conns %d",
+		v.PacketsToBackend, v.BytesToBackend, v.PacketsFromBackend, v.BytesFromBackend, v.Conns, v.CTLBConns)
+}
+
+// StatsValueFromPerCPUBytes sums the values of all CPUs of a per-CPU map entry.
+func StatsValueFromPerCPUBytes(b []byte) StatsValue {
+	var v StatsValue
+	for start := 0; start+statsValueSize <= len(b); start += statsValueSize {
+		v.PacketsToBackend += binary.LittleEndian.Uint64(b[start : start+8])
+		v.BytesToBackend += binary.LittleEndian.Uint64(b[start+8 : start+16])
+		v.PacketsFromBackend += binary.LittleEndian.Uint64(b[start+16 : start+24])
+		v.BytesFromBackend += binary.LittleEndian.Uint64(b[start+24 : start+32])
+		v.Conns += binary.LittleEndian.Uint64(b[start+32 : start+40])
+		v.CTLBConns += binary.LittleEndian.Uint64(b[start+40 : start+48])
+	}
+	return v
+}
+
+var StatsMapParameters = maps.MapParameters{
+	Type:       "lru_percpu_hash",
+	KeySize:    statsKeySize,
+	ValueSize:  statsValueSize,
+	MaxEntries: 64 * 1024,
+	Name:       "cali_v4_nat_stats",
+}
+
+func StatsMap() maps.Map {
+	return maps.NewPinnedMap(StatsMapParameters)
+}
+
+var StatsMapV6Parameters = maps.MapParameters{
+	Type:       "lru_percpu_hash",
+	KeySize:    statsKeyV6Size,
+	ValueSize:  statsValueSize,
+	MaxEntries: 64 * 1024,
+	Name:       "cali_v6_nat_stats",
+}
+
+func StatsMapV6() maps.Map {
+	return maps.NewPinnedMap(StatsMapV6Parameters)
+}
+
+// StatsMapMem represents the NAT stats map loaded into memory
+type StatsMapMem map[StatsKeyInterface]StatsValue
+
+// LoadStatsMap loads the IPv4 NAT stats map into a go map or returns an error
+func LoadStatsMap(m maps.Map) (StatsMapMem, error) {
+	return loadStatsMap(m, StatsKeyFromBytes)
+}
+
+// LoadStatsMapV6 loads the IPv6 NAT stats map into a go map or returns an error
+func LoadStatsMapV6(m maps.Map) (StatsMapMem, error) {
+	return loadStatsMap(m, StatsKeyV6FromBytes)
+}
+
+func loadStatsMap(m maps.Map, keyFromBytes func([]byte) StatsKeyInterface) (StatsMapMem, error) {
+	ret := make(StatsMapMem)
+
+	if err := m.Open(); err != nil {
+		return nil, err
+	}
+
+	err := m.Iter(func(k, v []byte) maps.IteratorAction {
+		ret[keyFromBytes(k)] = StatsValueFromPerCPUBytes(v)
+		return maps.IterNone
+	})
+	if err != nil {
+		ret = nil
+	}
+
+	return ret, err
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package perf reads the samples that BPF programs write to a perf event
+// array with bpf_perf_event_output().
+package perf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"unsafe"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+const (
+	// Size of struct perf_event_header.
+	recordHeaderSize = 8
+	pollTimeoutMS    = 1000
+)
+
+// Reader reads the samples written to a perf event array.  It opens a perf
+// event with a ring buffer for each CPU and stores it in the map, so that the
+// BPF programs running on that CPU write to it.
+type Reader struct {
+	rings   []*ring
+	pollFDs []unix.PollFd
+	lost    uint64
+}
+
+type ring struct {
+	fd   int
+	mem  []byte
+	meta *unix.PerfEventMmapPage
+	data []byte
+}
+
+// NewReader creates a reader for the given perf event array, with a ring
+// buffer of pagesPerCPU pages, which must be a power of 2, for each CPU.
+func NewReader(m maps.Map, pagesPerCPU int) (*Reader, error) {
+	if pagesPerCPU <= 0 || pagesPerCPU&(pagesPerCPU-1) != 0 {
+		return nil, fmt.Errorf("pages per CPU must be a power of 2, not %d", pagesPerCPU)
+	}
+	r := &Reader{}
+	for cpu := 0; cpu < maps.NumPossibleCPUs(); cpu++ {
+		rg, err := openRing(cpu, pagesPerCPU)
+		if errors.Is(err, unix.ENODEV) {
+			// Possible but offline CPU, there is nothing to read from.
+			log.WithField("cpu", cpu).Debug("CPU is offline, skipping it.")
+			continue
+		} else if err != nil {
+			r.Close()
+			return nil, err
+		}
+		r.rings = append(r.rings, rg)
+		r.pollFDs = append(r.pollFDs, unix.PollFd{Fd: int32(rg.fd), Events: unix.POLLIN})
+
+		var k, v [4]byte
+		binary.LittleEndian.PutUint32(k[:], uint32(cpu))
+		binary.LittleEndian.PutUint32(v[:], uint32(rg.fd))
+		if err := m.Update(k[:], v[:]); err != nil {
+			r.Close()
+			return nil, fmt.Errorf("failed to store perf event of CPU %d in map %s: %w", cpu, m.GetName(), err)
+		}
+	}
+	return r, nil
+}
+
+func openRing(cpu, pages int) (*ring, error) {
+	attr := unix.PerfEventAttr{
+		Type:        unix.PERF_TYPE_SOFTWARE,
+		Config:      unix.PERF_COUNT_SW_BPF_OUTPUT,
+		Sample_type: unix.PERF_SAMPLE_RAW,
+		// Wake up the reader on every sample.
+		Wakeup: 1,
+	}
+	attr.Size = uint32(unsafe.Sizeof(attr))
+	fd, err := unix.PerfEventOpen(&attr, -1, cpu, -1, unix.PERF_FLAG_FD_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open perf event on CPU %d: %w", cpu, err)
+	}
+	pageSize := os.Getpagesize()
+	// The first page holds the metadata, the rest is the ring buffer.
+	mem, err := unix.Mmap(fd, 0, (pages+1)*pageSize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED)
+	if err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to map perf ring buffer of CPU %d: %w", cpu, err)
+	}
+	if err := unix.IoctlSetInt(fd, unix.PERF_EVENT_IOC_ENABLE, 0); err != nil {
+		_ = unix.Munmap(mem)
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to enable perf event on CPU %d: %w", cpu, err)
+	}
+	return &ring{
+		fd:   fd,
+		mem:  mem,
+		meta: (*unix.PerfEventMmapPage)(unsafe.Pointer(&mem[0])),
+		data: mem[pageSize:],
+	}, nil
+}
+
+// Read waits for samples and passes each one to handle, until done is closed.
+// The sample is only valid until handle returns.
+func (r *Reader) Read(done <-chan struct{}, handle func(sample []byte)) error {
+	for {
+		select {
+		case <-done:
+			return nil
+		default:
+		}
+		_, err := unix.Poll(r.pollFDs, pollTimeoutMS)
+		if err != nil && !errors.Is(err, unix.EINTR) {
+			return fmt.Errorf("failed to poll perf events: %w", err)
+		}
+		for _, rg := range r.rings {
+			r.lost += rg.drain(handle)
+		}
+	}
+}
+
+// Lost returns the number of samples that the kernel dropped because the ring
+// buffers were full.
+func (r *Reader) Lost() uint64 {
+	return r.lost
+}
+
+func (r *Reader) Close() {
+	for _, rg := range r.rings {
+		_ = unix.Munmap(rg.mem)
+		unix.Close(rg.fd)
+	}
+	r.rings = nil
+	r.pollFDs = nil
+}
+
+// drain passes the samples in the ring buffer to handle and returns the
+// number of samples that were lost.
+func (rg *ring) drain(handle func(sample []byte)) (lost uint64) {
+	head := atomic.LoadUint64(&rg.meta.Data_head)
+	tail := atomic.LoadUint64(&rg.meta.Data_tail)
+	var buf []byte
+	for tail < head {
+		hdr := rg.read(tail, recordHeaderSize, nil)
+		typ := binary.LittleEndian.Uint32(hdr[0:])
+		size := uint64(binary.LittleEndian.Uint16(hdr[6:]))
+		switch typ {
+		case unix.PERF_RECORD_SAMPLE:
+			// The header is followed by the size of the raw sample and the
+			// sample itself.
+			sizeBytes := rg.read(tail+recordHeaderSize, 4, nil)
+			sampleSize := uint64(binary.LittleEndian.Uint32(sizeBytes))
+			buf = rg.read(tail+recordHeaderSize+4, sampleSize, buf[:0])
+			handle(buf)
+		case unix.PERF_RECORD_LOST:
+			// The header is followed by the ID of the event and the number of
+			// lost samples.
+			lostBytes := rg.read(tail+recordHeaderSize+8, 8, nil)
+			lost += binary.LittleEndian.Uint64(lostBytes)
+		}
+		tail += size
+	}
+	atomic.StoreUint64(&rg.meta.Data_tail, tail)
+	return
+}
+
+// read copies size bytes from the given position in the ring buffer, which
+// may wrap around its end, and returns them.
+func (rg *ring) read(pos, size uint64, buf []byte) []byte {
+	n := uint64(len(rg.data))
+	for i := uint64(0); i < size; i++ {
+		buf = append(buf, rg.data[(pos+i)%n])
+	}
+	return buf
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perf
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
+)
+
+func newTestRing(dataSize int) *ring {
+	meta := &unix.PerfEventMmapPage{}
+	return &ring{meta: meta, data: make([]byte, dataSize)}
+}
+
+// write appends a record to the ring at its head, wrapping around the end.
+func (rg *ring) write(typ uint32, body []byte) {
+	rec := make([]byte, recordHeaderSize+len(body))
+	binary.LittleEndian.PutUint32(rec[0:], typ)
+	binary.LittleEndian.PutUint16(rec[6:], uint16(len(rec)))
+	copy(rec[recordHeaderSize:], body)
+	head := rg.meta.Data_head
+	for i, b := range rec {
+		rg.data[(head+uint64(i))%uint64(len(rg.data))] = b
+	}
+	rg.meta.Data_head = head + uint64(len(rec))
+}
+
+func sampleBody(sample []byte) []byte {
+	body := make([]byte, 4+len(sample))
+	binary.LittleEndian.PutUint32(body, uint32(len(sample)))
+	copy(body[4:], sample)
+	return body
+}
+
+func TestRingDrain(t *testing.T) {
+	RegisterTestingT(t)
+	rg := newTestRing(64)
+	// Start near the end of the buffer so that the second sample wraps.
+	rg.meta.Data_head = 40
+	rg.meta.Data_tail = 40
+
+	rg.write(unix.PERF_RECORD_SAMPLE, sampleBody([]byte("abcd")))
+	rg.write(unix.PERF_RECORD_SAMPLE, sampleBody([]byte("efghijkl")))
+	lostBody := make([]byte, 16)
+	binary.LittleEndian.PutUint64(lostBody[8:], 3)
+	rg.write(unix.PERF_RECORD_LOST, lostBody)
+
+	var samples []string
+	lost := rg.drain(func(sample []byte) {
+		samples = append(samples, string(sample))
+	})
+	Expect(samples).To(Equal([]string{"abcd", "efghijkl"}))
+	Expect(lost).To(BeEquivalentTo(3))
+	Expect(rg.meta.Data_tail).To(Equal(rg.meta.Data_head))
+
+	// Nothing more to read.
+	lost = rg.drain(func(sample []byte) {
+		samples = append(samples, string(sample))
+	})
+	Expect(samples).To(HaveLen(2))
+	Expect(lost).To(BeZero())
+}
@@ -23,6 +23,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	. "github.com/projectcalico/calico/felix/bpf/asm"
+	"github.com/projectcalico/calico/felix/bpf/audit"
 	"github.com/projectcalico/calico/felix/bpf/ipsets"
 	"github.com/projectcalico/calico/felix/bpf/maps"
 	"github.com/projectcalico/calico/felix/bpf/state"
@@ -51,6 +52,7 @@ type Builder struct {
 	stateMapFD         maps.FD
 	staticJumpMapFD    maps.FD
 	policyJumpMapFD    maps.FD
+	auditEventsMapFD   maps.FD
 	policyMapIndex     int
 	policyMapStride    int
 	policyDebugEnabled bool
@@ -61,6 +63,7 @@ type Builder struct {
 	maxJumpsPerProgram int
 	numRulesInProgram  int
 	xdp                bool
+	xdpDenyOnly        bool
 }
 
 type ipSetIDProvider interface {
@@ -117,6 +120,7 @@ var (
 	// port+proto+pad, the dst key is also aligned in the same way. <sweat :-)>
 	offSrcIPSetKey = nextOffset(ipsets.IPSetEntryV6Size, 4)
 	offDstIPSetKey = nextOffset(ipsets.IPSetEntryV6Size, 4)
+	offAuditEvent  = nextOffset(audit.EventSize, 8)
 
 	// Offsets within the cal_tc_state struct.
 	// WARNING: must be kept in sync with the definitions in bpf-gpl/types.h.
@@ -173,6 +177,9 @@ type Rule struct {
 type Policy struct {
 	Name  string
 	Rules []Rule
+	// Staged policies are evaluated, so their rules are counted, but a match only
+	// skips the rest of the policy and never decides the verdict.
+	Staged bool
 }
 
 type Tier struct {
@@ -210,6 +217,12 @@ type Rules struct {
 	// we are implementing untracked policy (provided in the HostNormalTiers field) and that
 	// traffic is allowed to continue if not explicitly allowed or denied.
 	ForXDP bool
+
+	// True when building an XDP program that only enforces the Deny rules of the pre-DNAT
+	// policy (provided in the HostPreDnatTiers field) instead of untracked policy.  Traffic
+	// that is not denied continues to TC without being marked as accepted, so that TC can
+	// enforce the full policy.  Only valid together with ForXDP.
+	XDPDenyOnly bool
 }
 
 type RuleMatchID = uint64
@@ -226,10 +239,19 @@ const (
 
 func (p *Builder) Instructions(rules Rules) ([]Insns, error) {
 	p.xdp = rules.ForXDP
+	p.xdpDenyOnly = rules.ForXDP && rules.XDPDenyOnly
 	p.b = NewBlock(p.policyDebugEnabled)
 	p.blocks = append(p.blocks, p.b)
 	p.writeProgramHeader()
 
+	if p.xdpDenyOnly {
+		// Any verdict other than Deny is left to the TC program, which enforces the
+		// pre-DNAT policy for real.
+		p.writeTiers(rules.HostPreDnatTiers, legDestPreNAT, "xdp_pass")
+		p.b.Jump("xdp_pass")
+		goto footer
+	}
+
 	if p.xdp {
 		// For an XDP program HostNormalTiers continues the untracked policy to enforce;
 		// other fields are unused.
@@ -293,6 +315,7 @@ normalPolicy:
 		p.writeProfiles(rules.Profiles, "allow")
 	}
 
+footer:
 	p.writeProgramFooter()
 
 	var progs []Insns
@@ -351,17 +374,7 @@ func (p *Builder) writeProgramFooter() {
 	// Store the policy result in the state for the next program to see.
 	p.b.MovImm32(R1, int32(state.PolicyDeny))
 	p.b.Store32(R9, R1, stateOffPolResult)
-
-	// Execute the tail call to drop program
-	p.b.Mov64(R1, R6)                            // First arg is the context.
-	p.b.LoadMapFD(R2, uint32(p.staticJumpMapFD)) // Second arg is the map.
-	if p.useJmps {
-		p.b.AddCommentF("Deny jump to %d", p.denyJmp)
-		p.b.MovImm32(R3, int32(p.denyJmp)) // Third arg is the index (rather than a pointer to the index).
-	} else {
-		p.b.Load32(R3, R6, skbCb1) // Third arg is the index from skb->cb[1]).
-	}
-	p.b.Call(HelperTailCall)
+	p.writeDropTailCall()
 
 	// Fall through if tail call fails.
 	p.writeExitTarget()
@@ -398,6 +411,31 @@ func (p *Builder) writeProgramFooter() {
 		}
 		p.b.Exit()
 	}
+
+	if p.b.TargetIsUsed("reject") {
+		p.b.LabelNextInsn("reject")
+		// Same as deny but the drop program replies to the sender.
+		p.b.MovImm32(R1, int32(state.PolicyReject))
+		p.b.Store32(R9, R1, stateOffPolResult)
+		p.writeDropTailCall()
+
+		// Fall through if tail call fails.
+		p.b.MovImm64(R0, 2 /* TC_ACT_SHOT */)
+		p.b.Exit()
+	}
+}
+
+// writeDropTailCall emits the tail call to the drop program.
+func (p *Builder) writeDropTailCall() {
+	p.b.Mov64(R1, R6)                            // First arg is the context.
+	p.b.LoadMapFD(R2, uint32(p.staticJumpMapFD)) // Second arg is the map.
+	if p.useJmps {
+		p.b.AddCommentF("Deny jump to %d", p.denyJmp)
+		p.b.MovImm32(R3, int32(p.denyJmp)) // Third arg is the index (rather than a pointer to the index).
+	} else {
+		p.b.Load32(R3, R6, skbCb1) // Third arg is the index from skb->cb[1]).
+	}
+	p.b.Call(HelperTailCall)
 }
 
 func (p *Builder) writeExitTarget() {
@@ -482,14 +520,23 @@ func (p *Builder) setUpIPSetKey(ipsetID uint64, keyOffset int16, ipOffset, portO
 
 func (p *Builder) writeTiers(tiers []Tier, destLeg matchLeg, allowLabel string) {
 	actionLabels := map[string]string{
-		"allow": allowLabel,
-		"deny":  "deny",
-		"log":   "log",
+		"allow":  allowLabel,
+		"deny":   "deny",
+		"reject": "reject",
+		"log":    "log",
+	}
+	if p.xdp {
+		// XDP cannot send replies, it leaves that to TC.
+		actionLabels["reject"] = "deny"
 	}
 	for _, tier := range tiers {
 		endOfTierLabel := fmt.Sprint("end_of_tier_", p.tierID)
 		actionLabels["pass"] = endOfTierLabel
 		actionLabels["next-tier"] = endOfTierLabel
+		if p.xdpDenyOnly {
+			// Leave logging to TC as well.
+			actionLabels["log"] = "xdp_pass"
+		}
 
 		log.Debugf("Start of tier %d %q", p.tierID, tier.Name)
 		p.b.AddCommentF("Start of tier %s", tier.Name)
@@ -543,7 +590,21 @@ func (p *Builder) writePolicyRules(policy Policy, actionLabels map[string]string
 func (p *Builder) writePolicy(policy Policy, actionLabels map[string]string, destLeg matchLeg) {
 	p.b.AddCommentF("Start of policy %s", policy.Name)
 	log.Debugf("Start of policy %q %d", policy.Name, p.policyID)
-	p.writePolicyRules(policy, actionLabels, destLeg)
+	if policy.Staged {
+		endOfPolicyLabel := fmt.Sprint("end_of_policy_", p.policyID)
+		stagedLabels := map[string]string{}
+		for action, label := range actionLabels {
+			if action == "log" {
+				stagedLabels[action] = label
+				continue
+			}
+			stagedLabels[action] = endOfPolicyLabel
+		}
+		p.writePolicyRules(policy, stagedLabels, destLeg)
+		p.b.LabelNextInsn(endOfPolicyLabel)
+	} else {
+		p.writePolicyRules(policy, actionLabels, destLeg)
+	}
 	log.Debugf("End of policy %q %d", policy.Name, p.policyID)
 	p.b.AddCommentF("End of policy %s", policy.Name)
 	p.policyID++
@@ -553,9 +614,13 @@ func (p *Builder) writeProfile(profile Profile, idx int, allowLabel string) {
 	actionLabels := map[string]string{
 		"allow":     allowLabel,
 		"deny":      "deny",
+		"reject":    "reject",
 		"pass":      "deny",
 		"next-tier": "deny",
 	}
+	if p.xdp {
+		actionLabels["reject"] = "deny"
+	}
 	log.Debugf("Start of profile %q %d", profile.Name, idx)
 	p.writePolicyRules(profile, actionLabels, legDest)
 	log.Debugf("End of profile %q %d", profile.Name, idx)
@@ -716,6 +781,8 @@ func (p *Builder) writeRule(r Rule, actionLabel string, destLeg matchLeg) {
 			p.writeICMPTypeCodeMatch(false, uint8(icmp.IcmpTypeCode.Type), uint8(icmp.IcmpTypeCode.Code))
 		case *proto.Rule_IcmpType:
 			p.writeICMPTypeMatch(false, uint8(icmp.IcmpType))
+		case *proto.Rule_IcmpTypesAndCodes:
+			p.writeICMPTypesMatch(false, icmp.IcmpTypesAndCodes)
 		}
 	}
 	if rule.NotIcmp != nil {
@@ -725,6 +792,8 @@ func (p *Builder) writeRule(r Rule, actionLabel string, destLeg matchLeg) {
 			p.writeICMPTypeCodeMatch(true, uint8(icmp.NotIcmpTypeCode.Type), uint8(icmp.NotIcmpTypeCode.Code))
 		case *proto.Rule_NotIcmpType:
 			p.writeICMPTypeMatch(true, uint8(icmp.NotIcmpType))
+		case *proto.Rule_NotIcmpTypesAndCodes:
+			p.writeICMPTypesMatch(true, icmp.NotIcmpTypesAndCodes)
 		}
 	}
 
@@ -738,6 +807,9 @@ func (p *Builder) writeStartOfRule() {
 }
 
 func (p *Builder) writeEndOfRule(rule Rule, actionLabel string) {
+	if rule.Audit && p.auditEventsMapFD != 0 && !p.xdp {
+		p.writeAuditEvent(rule.MatchID)
+	}
 	if actionLabel == "log" {
 		p.b.Load64(R1, R9, stateOffFlags)
 		p.b.OrImm64(R1, int32(FlagLogPacket))
@@ -756,6 +828,45 @@ func (p *Builder) writeEndOfRule(rule Rule, actionLabel string) {
 	p.b.LabelNextInsn(p.endOfRuleLabel())
 }
 
+// writeAuditEvent builds an audit event for the connection on the stack and
+// writes it to the audit events map, where felix picks it up.
+func (p *Builder) writeAuditEvent(id RuleMatchID) {
+	p.b.AddCommentF("Rule has audit enabled, write event for rule 0x%x", id)
+	p.b.LoadImm64(R1, int64(id))
+	p.b.StoreStack64(R1, offAuditEvent+audit.EventOffMatchID)
+	// Copy all 16 bytes of the addresses, the state has the v6 layout even
+	// for v4 programs.
+	for i := int16(0); i < 16; i += 8 {
+		p.b.Load64(R1, R9, FieldOffset{Offset: stateOffIPSrc.Offset + i, Field: stateOffIPSrc.Field})
+		p.b.StoreStack64(R1, offAuditEvent+audit.EventOffIPSrc+i)
+		p.b.Load64(R1, R9, FieldOffset{Offset: stateOffPreNATIPDst.Offset + i, Field: stateOffPreNATIPDst.Field})
+		p.b.StoreStack64(R1, offAuditEvent+audit.EventOffIPDst+i)
+	}
+	p.b.Load16(R1, R9, stateOffSrcPort)
+	p.b.StoreStack16(R1, offAuditEvent+audit.EventOffSrcPort)
+	p.b.Load16(R1, R9, stateOffPreNATDstPort)
+	p.b.StoreStack16(R1, offAuditEvent+audit.EventOffDstPort)
+	p.b.Load8(R1, R9, stateOffIPProto)
+	p.b.StoreStack8(R1, offAuditEvent+audit.EventOffIPProto)
+	flags := int32(0)
+	if p.forIPv6 {
+		flags = audit.EventFlagIPv6
+	}
+	// Flags and padding.
+	p.b.MovImm64(R1, flags)
+	p.b.StoreStack8(R1, offAuditEvent+audit.EventOffFlags)
+	p.b.MovImm64(R1, 0)
+	p.b.StoreStack16(R1, offAuditEvent+audit.EventOffFlags+1)
+
+	p.b.Mov64(R1, R6) // First arg is the context.
+	p.b.LoadMapFD(R2, uint32(p.auditEventsMapFD))
+	p.b.LoadImm64(R3, 0xffffffff) // BPF_F_CURRENT_CPU
+	p.b.Mov64(R4, R10)
+	p.b.AddImm64(R4, int32(offAuditEvent))
+	p.b.MovImm64(R5, audit.EventSize)
+	p.b.Call(HelperPerfEventOutput)
+}
+
 func (p *Builder) writeProtoMatch(negate bool, protocol *proto.Protocol) {
 	if negate {
 		p.b.AddCommentF("If protocol == %s, skip to next rule", protocolToName(protocol))
@@ -799,6 +910,52 @@ func (p *Builder) writeICMPTypeCodeMatch(negate bool, icmpType, icmpCode uint8)
 		p.b.JumpNEImm64(R1, (int32(icmpCode)<<8)|int32(icmpType), p.endOfRuleLabel())
 	}
 }
+
+func (p *Builder) writeICMPTypesMatch(negate bool, tcs *proto.IcmpTypesAndCodes) {
+	var entries []string
+	for _, t := range tcs.Types {
+		entries = append(entries, fmt.Sprint(t))
+	}
+	for _, tc := range tcs.TypeCodes {
+		entries = append(entries, fmt.Sprintf("%d/%d", tc.Type, tc.Code))
+	}
+	if negate {
+		p.b.AddCommentF("If ICMP type/code in {%s}, skip to next rule", strings.Join(entries, ","))
+	} else {
+		p.b.AddCommentF("If ICMP type/code not in {%s}, skip to next rule", strings.Join(entries, ","))
+	}
+
+	var onMatchLabel string
+	if negate {
+		// Match negated, if we match any type then we jump to the next rule.
+		onMatchLabel = p.endOfRuleLabel()
+	} else {
+		// Match is non-negated, if we match, go to the next match criteria.
+		onMatchLabel = p.freshPerRuleLabel()
+	}
+
+	if len(tcs.Types) > 0 {
+		p.b.Load8(R1, R9, stateOffICMPType)
+		for _, t := range tcs.Types {
+			p.b.JumpEqImm64(R1, t, onMatchLabel)
+		}
+	}
+	if len(tcs.TypeCodes) > 0 {
+		// The code is stored in the byte after the type.
+		p.b.Load16(R1, R9, stateOffICMPType)
+		for _, tc := range tcs.TypeCodes {
+			p.b.JumpEqImm64(R1, (tc.Code<<8)|tc.Type, onMatchLabel)
+		}
+	}
+
+	if !negate {
+		// If we fall through then none of the types matched so the rule doesn't match.
+		p.b.Jump(p.endOfRuleLabel())
+		// Label the next match so we can skip to it on success.
+		p.b.LabelNextInsn(onMatchLabel)
+	}
+}
+
 func (p *Builder) writeCIDRSMatch(negate bool, leg matchLeg, cidrs []string) {
 	if p.policyDebugEnabled {
 		cidrStrings := "{"
@@ -1262,6 +1419,15 @@ func WithPolicyMapIndexAndStride(entryPointIdx, stride int) Option {
 	}
 }
 
+// WithAuditEventsMapFD makes the policy programs write an event to the given
+// perf event array for each new connection that matches a rule with audit
+// enabled.  It has no effect on XDP programs.
+func WithAuditEventsMapFD(fd maps.FD) Option {
+	return func(b *Builder) {
+		b.auditEventsMapFD = fd
+	}
+}
+
 func WithIPv6() Option {
 	return func(p *Builder) {
 		p.forIPv6 = true
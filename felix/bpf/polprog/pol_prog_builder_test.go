@@ -140,6 +140,34 @@ func TestPolicyDump(t *testing.T) {
 	checkLabelsAndComments(&proto.Rule{NotIcmp: &proto.Rule_NotIcmpTypeCode{NotIcmpTypeCode: &proto.IcmpTypeAndCode{Type: 10, Code: 12}}}, "If ICMP type == 10 and code == 12, skip to next rule", "comment")
 	checkLabelsAndComments(&proto.Rule{Icmp: &proto.Rule_IcmpType{IcmpType: 10}}, "If ICMP type != 10, skip to next rule", "comment")
 	checkLabelsAndComments(&proto.Rule{NotIcmp: &proto.Rule_NotIcmpType{NotIcmpType: 10}}, "If ICMP type == 10, skip to next rule", "comment")
+	icmpTypes := &proto.IcmpTypesAndCodes{Types: []int32{0, 8}, TypeCodes: []*proto.IcmpTypeAndCode{{Type: 3, Code: 4}}}
+	checkLabelsAndComments(&proto.Rule{Icmp: &proto.Rule_IcmpTypesAndCodes{IcmpTypesAndCodes: icmpTypes}}, "If ICMP type/code not in {0,8,3/4}, skip to next rule", "comment")
+	checkLabelsAndComments(&proto.Rule{NotIcmp: &proto.Rule_NotIcmpTypesAndCodes{NotIcmpTypesAndCodes: icmpTypes}}, "If ICMP type/code in {0,8,3/4}, skip to next rule", "comment")
+}
+
+func TestAuditEvent(t *testing.T) {
+	RegisterTestingT(t)
+	alloc := idalloc.New()
+
+	comments := func(opts ...Option) []string {
+		pg := NewBuilder(alloc, 1, 2, 3, 4, opts...)
+		insns, err := pg.Instructions(Rules{
+			Tiers: []Tier{{
+				Policies: []Policy{{
+					Rules: []Rule{{
+						Rule:    &proto.Rule{Action: "Deny", Audit: true},
+						MatchID: 0x1234,
+					}},
+				}},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, comments := aggregateCommentsAndLabels(&insns[0])
+		return comments
+	}
+
+	Expect(comments(WithPolicyDebugEnabled(), WithAuditEventsMapFD(5))).To(ContainElement("Rule has audit enabled, write event for rule 0x1234"))
+	Expect(comments(WithPolicyDebugEnabled())).NotTo(ContainElement("Rule has audit enabled, write event for rule 0x1234"))
 }
 
 func aggregateCommentsAndLabels(insns *asm.Insns) ([]string, []string) {
@@ -208,3 +236,113 @@ func TestProgramSplitting(t *testing.T) {
 	Expect(len(progs)).To(BeNumerically(">=", 6))
 	Expect(len(progs)).To(BeNumerically("<=", 8))
 }
+
+func TestStagedPolicy(t *testing.T) {
+	RegisterTestingT(t)
+	alloc := idalloc.New()
+
+	build := func(staged bool) []string {
+		pg := NewBuilder(alloc, 1, 2, 3, 4, WithAllowDenyJumps(666, 777), WithPolicyDebugEnabled())
+		insns, err := pg.Instructions(Rules{
+			Tiers: []Tier{{
+				Name: "default",
+				Policies: []Policy{{
+					Name:   "pol",
+					Staged: staged,
+					Rules: []Rule{
+						{Rule: &proto.Rule{Action: "Deny", IpVersion: 4}},
+						{Rule: &proto.Rule{Action: "Allow", IpVersion: 4}},
+					},
+				}},
+				EndAction: TierEndPass,
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		labels, _ := aggregateCommentsAndLabels(&insns[0])
+		return labels
+	}
+
+	// The rules of a staged policy jump past the end of the policy rather
+	// than to the deny/allow labels.
+	Expect(build(true)).To(ContainElement("end_of_policy_0"))
+	Expect(build(false)).NotTo(ContainElement("end_of_policy_0"))
+}
+
+func TestXDPDenyOnly(t *testing.T) {
+	RegisterTestingT(t)
+	alloc := idalloc.New()
+	setID := func(id string) string {
+		alloc.GetOrAlloc(id)
+		return id
+	}
+	pg := NewBuilder(alloc, 1, 2, 3, 4, WithAllowDenyJumps(666, 777), WithPolicyDebugEnabled())
+
+	rule := func(action string) Rule {
+		return Rule{Rule: &proto.Rule{
+			Action:      action,
+			IpVersion:   4,
+			SrcIpSetIds: []string{setID("s:denylist1234567")},
+		}}
+	}
+	progs, err := pg.Instructions(Rules{
+		ForHostInterface: true,
+		ForXDP:           true,
+		XDPDenyOnly:      true,
+		HostPreDnatTiers: []Tier{{
+			Name:      "default",
+			EndAction: TierEndPass,
+			Policies: []Policy{{
+				Name:  "default.deny-list",
+				Rules: []Rule{rule("Log"), rule("Allow"), rule("Pass"), rule("Deny")},
+			}},
+		}},
+		// Untracked policy must be ignored.
+		HostNormalTiers: []Tier{{
+			Name: "default",
+			Policies: []Policy{{
+				Name:  "default.untracked",
+				Rules: []Rule{rule("Allow")},
+			}},
+		}},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(progs).To(HaveLen(1))
+
+	labels, comments := aggregateCommentsAndLabels(&progs[0])
+	Expect(labels).To(ContainElements("xdp_pass", "deny"))
+	Expect(labels).NotTo(ContainElement("allow"))
+	Expect(comments).To(ContainElement("Deny jump to 777"))
+	Expect(comments).NotTo(ContainElement("Allow jump to 666"))
+	Expect(comments).NotTo(ContainElement("Start of policy default.untracked"))
+}
+
+func TestRejectRule(t *testing.T) {
+	RegisterTestingT(t)
+	alloc := idalloc.New()
+
+	build := func(forXDP bool) []string {
+		pg := NewBuilder(alloc, 1, 2, 3, 4, WithAllowDenyJumps(666, 777), WithPolicyDebugEnabled())
+		rules := Rules{ForHostInterface: true, ForXDP: forXDP}
+		tiers := []Tier{{
+			Name: "default",
+			Policies: []Policy{{
+				Name:  "pol",
+				Rules: []Rule{{Rule: &proto.Rule{Action: "Reject", IpVersion: 4}}},
+			}},
+		}}
+		if forXDP {
+			rules.HostPreDnatTiers = tiers
+		} else {
+			rules.HostNormalTiers = tiers
+		}
+		insns, err := pg.Instructions(rules)
+		Expect(err).NotTo(HaveOccurred())
+		labels, _ := aggregateCommentsAndLabels(&insns[0])
+		return labels
+	}
+
+	// TC hands rejected packets to the drop program with their own policy
+	// result, XDP can't reply so it simply denies them.
+	Expect(build(false)).To(ContainElement("reject"))
+	Expect(build(true)).NotTo(ContainElement("reject"))
+}
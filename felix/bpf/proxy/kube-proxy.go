@@ -19,11 +19,14 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes"
+	k8sp "k8s.io/kubernetes/pkg/proxy"
 
 	"github.com/projectcalico/calico/felix/bpf/bpfmap"
 	"github.com/projectcalico/calico/felix/bpf/maps"
+	"github.com/projectcalico/calico/felix/bpf/nat"
 	"github.com/projectcalico/calico/felix/bpf/routes"
 	"github.com/projectcalico/calico/felix/ip"
 )
@@ -47,12 +50,16 @@ type KubeProxy struct {
 	backendMap  maps.MapWithExistsCheck
 	affinityMap maps.Map
 	ctMap       maps.Map
+	statsMap    maps.Map
 	rt          *RTCache
 	opts        []Option
 
 	excludedCIDRs *ip.CIDRTrie
 
 	dsrEnabled bool
+
+	serviceStatsEnabled bool
+	statsCollector      *serviceStatsCollector
 }
 
 // StartKubeProxy start a new kube-proxy if there was no error
@@ -67,6 +74,7 @@ func StartKubeProxy(k8s kubernetes.Interface, hostname string,
 		backendMap:  bpfMaps.BackendMap.(maps.MapWithExistsCheck),
 		affinityMap: bpfMaps.AffinityMap,
 		ctMap:       bpfMaps.CtMap,
+		statsMap:    bpfMaps.NATStatsMap,
 		opts:        opts,
 		rt:          NewRTCache(),
 
@@ -80,6 +88,14 @@ func StartKubeProxy(k8s kubernetes.Interface, hostname string,
 		}
 	}
 
+	if kp.serviceStatsEnabled && kp.statsMap != nil {
+		kp.statsCollector = newServiceStatsCollector(kp)
+		if err := prometheus.Register(kp.statsCollector); err != nil {
+			log.WithError(err).Warn("Failed to register service stats metrics.")
+			kp.statsCollector = nil
+		}
+	}
+
 	go func() {
 		err := kp.start()
 		if err != nil {
@@ -97,6 +113,10 @@ func (kp *KubeProxy) setIpFamily(ipFamily int) {
 // Stop stops KubeProxy and waits for it to exit
 func (kp *KubeProxy) Stop() {
 	kp.stopOnce.Do(func() {
+		if kp.statsCollector != nil {
+			prometheus.Unregister(kp.statsCollector)
+		}
+
 		kp.lock.Lock()
 		defer kp.lock.Unlock()
 
@@ -261,3 +281,14 @@ func (kp *KubeProxy) ConntrackFrontendHasBackend(ip net.IP, port uint16, backend
 	// We cannot say yet, so do not break anything
 	return true
 }
+
+func (kp *KubeProxy) serviceStats(stats nat.StatsMapMem) map[k8sp.ServicePortName]*ServiceStats {
+	kp.lock.RLock()
+	defer kp.lock.RUnlock()
+
+	if s, ok := kp.syncer.(*Syncer); ok {
+		return s.ServiceStats(stats)
+	}
+
+	return nil
+}
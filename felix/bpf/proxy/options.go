@@ -71,6 +71,15 @@ func WithDSREnabled() Option {
 	})
 }
 
+// WithServiceStats exports the NAT traffic counters of services as prometheus
+// metrics.
+func WithServiceStats() Option {
+	return makeKubeProxyOption(func(kp *KubeProxy) error {
+		kp.serviceStatsEnabled = true
+		return nil
+	})
+}
+
 // WithTopologyNodeZone sets the topology node zone
 func WithTopologyNodeZone(nodeZone string) Option {
 	return makeOption(func(p *proxy) error {
@@ -0,0 +1,278 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	k8sp "k8s.io/kubernetes/pkg/proxy"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+	"github.com/projectcalico/calico/felix/bpf/nat"
+)
+
+// ServiceStats holds the traffic counters of a service port and of each of its
+// current backends, keyed by "ip:port".
+type ServiceStats struct {
+	nat.StatsValue
+	Backends map[string]nat.StatsValue
+}
+
+// serviceStatsIndex maps frontends to service ports and lists the backends of
+// each service port.
+type serviceStatsIndex struct {
+	frontends map[ipPortProto]k8sp.ServicePortName
+	backends  map[k8sp.ServicePortName]map[string]struct{}
+}
+
+func newServiceStatsIndex(svcs map[svcKey]svcInfo, eps k8sp.EndpointsMap) *serviceStatsIndex {
+	idx := &serviceStatsIndex{
+		frontends: make(map[ipPortProto]k8sp.ServicePortName, len(svcs)),
+		backends:  make(map[k8sp.ServicePortName]map[string]struct{}),
+	}
+
+	for skey, sinfo := range svcs {
+		idx.frontends[servicePortToIPPortProto(sinfo.svc)] = skey.sname
+		if _, ok := idx.backends[skey.sname]; ok {
+			continue
+		}
+		bes := make(map[string]struct{}, len(eps[skey.sname]))
+		for _, ep := range eps[skey.sname] {
+			bes[net.JoinHostPort(ep.IP(), strconv.Itoa(ep.Port()))] = struct{}{}
+		}
+		idx.backends[skey.sname] = bes
+	}
+
+	return idx
+}
+
+// ServiceStats aggregates the entries of the NAT stats map per service port and
+// per backend, for all the service ports and backends programmed by the last
+// Apply().  Entries of frontends that do not belong to any service are ignored.
+// The counters of a service include backends that were removed but whose
+// entries are still in the map.
+func (s *Syncer) ServiceStats(stats nat.StatsMapMem) map[k8sp.ServicePortName]*ServiceStats {
+	idx := s.statsIndex.Load()
+	if idx == nil {
+		return nil
+	}
+
+	npIP := podNPIPStr
+	if s.ipFamily == 6 {
+		npIP = podNPIPV6Str
+	}
+
+	ret := make(map[k8sp.ServicePortName]*ServiceStats, len(idx.backends))
+	for sname, bes := range idx.backends {
+		st := &ServiceStats{Backends: make(map[string]nat.StatsValue, len(bes))}
+		for be := range bes {
+			st.Backends[be] = nat.StatsValue{}
+		}
+		ret[sname] = st
+	}
+
+	for k, v := range stats {
+		fe := ipPortProto{ipPort{k.FrontendAddr().String(), int(k.FrontendPort())}, k.Proto()}
+		sname, ok := idx.frontends[fe]
+		if !ok {
+			// The frontend of a nodeport is the IP of the node the packet
+			// arrived at, which we may not know about.
+			fe.ip = npIP
+			if sname, ok = idx.frontends[fe]; !ok {
+				continue
+			}
+		}
+
+		st := ret[sname]
+		st.Add(v)
+
+		be := net.JoinHostPort(k.BackendAddr().String(), strconv.Itoa(int(k.BackendPort())))
+		if bv, ok := st.Backends[be]; ok {
+			bv.Add(v)
+			st.Backends[be] = bv
+		}
+	}
+
+	return ret
+}
+
+var (
+	serviceStatsLabels = []string{"namespace", "service", "port"}
+	backendStatsLabels = []string{"namespace", "service", "port", "endpoint"}
+)
+
+const (
+	labelDirection = "direction"
+	labelLB        = "lb"
+
+	directionToBackend   = "to_backend"
+	directionFromBackend = "from_backend"
+
+	lbTC          = "tc"
+	lbConnectTime = "connect_time"
+)
+
+// serviceStatsCollector exports the NAT stats map of a KubeProxy as prometheus
+// metrics.  The map is an LRU map whose entries are evicted and reused, so the
+// collector accumulates what was added to each entry since the previous scrape
+// to keep the counters monotonic.
+type serviceStatsCollector struct {
+	kp       *KubeProxy
+	statsMap maps.Map
+	load     func(maps.Map) (nat.StatsMapMem, error)
+
+	svcPackets, svcBytes, svcConns *prometheus.Desc
+	bePackets, beBytes, beConns    *prometheus.Desc
+
+	lock sync.Mutex
+	// last is the content of the stats map at the previous scrape.
+	last nat.StatsMapMem
+	// totals are the counters of the current service ports and backends.
+	totals map[k8sp.ServicePortName]*ServiceStats
+}
+
+func newServiceStatsCollector(kp *KubeProxy) *serviceStatsCollector {
+	constLabels := prometheus.Labels{"ip_version": fmt.Sprint(kp.ipFamily)}
+
+	svcDesc := func(name, help, label string) *prometheus.Desc {
+		return prometheus.NewDesc(name, help, append(serviceStatsLabels[:len(serviceStatsLabels):len(serviceStatsLabels)], label), constLabels)
+	}
+	beDesc := func(name, help, label string) *prometheus.Desc {
+		return prometheus.NewDesc(name, help, append(backendStatsLabels[:len(backendStatsLabels):len(backendStatsLabels)], label), constLabels)
+	}
+
+	c := &serviceStatsCollector{
+		kp:       kp,
+		statsMap: kp.statsMap,
+		load:     nat.LoadStatsMap,
+
+		svcPackets: svcDesc("felix_bpf_service_packets_total",
+			"Number of packets to (DNAT) and from (SNAT) the backends of a service that were NATed by the BPF programs on this node.",
+			labelDirection),
+		svcBytes: svcDesc("felix_bpf_service_bytes_total",
+			"Number of bytes to (DNAT) and from (SNAT) the backends of a service that were NATed by the BPF programs on this node.",
+			labelDirection),
+		svcConns: svcDesc("felix_bpf_service_connections_total",
+			"Number of connections to a service that were load balanced by the BPF programs on this node, by the TC programs "+
+				"or at connect time.  The packets of connections load balanced at connect time are not counted.",
+			labelLB),
+		bePackets: beDesc("felix_bpf_service_backend_packets_total",
+			"Number of packets to (DNAT) and from (SNAT) a service backend that were NATed by the BPF programs on this node.",
+			labelDirection),
+		beBytes: beDesc("felix_bpf_service_backend_bytes_total",
+			"Number of bytes to (DNAT) and from (SNAT) a service backend that were NATed by the BPF programs on this node.",
+			labelDirection),
+		beConns: beDesc("felix_bpf_service_backend_connections_total",
+			"Number of connections to a service backend that were load balanced by the BPF programs on this node, by the TC "+
+				"programs or at connect time.  The packets of connections load balanced at connect time are not counted.",
+			labelLB),
+	}
+
+	if kp.ipFamily == 6 {
+		c.load = nat.LoadStatsMapV6
+	}
+
+	return c
+}
+
+func (c *serviceStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.svcPackets
+	ch <- c.svcBytes
+	ch <- c.svcConns
+	ch <- c.bePackets
+	ch <- c.beBytes
+	ch <- c.beConns
+}
+
+func (c *serviceStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats, err := c.load(c.statsMap)
+	if err != nil {
+		log.WithError(err).Warn("Failed to load NAT stats map.")
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.update(stats)
+
+	for sname, st := range c.totals {
+		labels := []string{sname.Namespace, sname.Name, sname.Port}
+		c.collectValue(ch, st.StatsValue, labels, c.svcPackets, c.svcBytes, c.svcConns)
+		for be, v := range st.Backends {
+			beLabels := append(labels[:len(labels):len(labels)], be)
+			c.collectValue(ch, v, beLabels, c.bePackets, c.beBytes, c.beConns)
+		}
+	}
+}
+
+// update adds what was counted since the previous scrape to the totals and
+// drops the totals of service ports and backends that no longer exist.
+func (c *serviceStatsCollector) update(stats nat.StatsMapMem) {
+	delta := make(nat.StatsMapMem, len(stats))
+	for k, v := range stats {
+		delta[k] = v.Sub(c.last[k])
+	}
+
+	current := c.kp.serviceStats(delta)
+	if current == nil {
+		// Services are not known yet, keep the deltas for later.
+		return
+	}
+	c.last = stats
+
+	totals := make(map[k8sp.ServicePortName]*ServiceStats, len(current))
+	for sname, st := range current {
+		t := c.totals[sname]
+		if t == nil {
+			t = &ServiceStats{}
+		}
+		t.Add(st.StatsValue)
+
+		backends := make(map[string]nat.StatsValue, len(st.Backends))
+		for be, v := range st.Backends {
+			bv := t.Backends[be]
+			bv.Add(v)
+			backends[be] = bv
+		}
+		t.Backends = backends
+
+		totals[sname] = t
+	}
+	c.totals = totals
+}
+
+func (c *serviceStatsCollector) collectValue(ch chan<- prometheus.Metric, v nat.StatsValue, labels []string,
+	packets, bytes, conns *prometheus.Desc) {
+
+	counter := func(desc *prometheus.Desc, v uint64, label string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(v),
+			append(labels[:len(labels):len(labels)], label)...)
+	}
+
+	counter(packets, v.PacketsToBackend, directionToBackend)
+	counter(packets, v.PacketsFromBackend, directionFromBackend)
+	counter(bytes, v.BytesToBackend, directionToBackend)
+	counter(bytes, v.BytesFromBackend, directionFromBackend)
+	counter(conns, v.Conns, lbTC)
+	counter(conns, v.CTLBConns, lbConnectTime)
+}
+
+var _ prometheus.Collector = (*serviceStatsCollector)(nil)
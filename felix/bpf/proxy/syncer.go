@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -137,6 +138,11 @@ type Syncer struct {
 	// Protects accessing the [prev|new][Svc|Eps]Map,
 	mapsLck sync.Mutex
 
+	// statsIndex maps the frontends and backends programmed by the last
+	// Apply() to service ports.  It is replaced as a whole so that
+	// ServiceStats() does not need to take mapsLck.
+	statsIndex atomic.Pointer[serviceStatsIndex]
+
 	// synced is true after reconciling the first Apply
 	synced bool
 
@@ -740,6 +746,8 @@ func (s *Syncer) Apply(state DPSyncerState) error {
 		s.synced = true
 	}
 
+	s.statsIndex.Store(newServiceStatsIndex(s.newSvcMap, s.newEpsMap))
+
 	// We wrote all updates, no one will create new records in affinity table
 	// that we would clean up now, so do it!
 	return s.cleanupSticky()
@@ -1274,6 +1274,50 @@ var _ = Describe("BPF Syncer", func() {
 		})
 
 	})
+
+	It("should aggregate NAT stats per service and backend", func() {
+		Expect(s.ServiceStats(nil)).To(BeNil(), "stats before the first Apply")
+
+		err := s.Apply(state)
+		Expect(err).NotTo(HaveOccurred())
+
+		stats := s.ServiceStats(nat.StatsMapMem{
+			nat.NewStatsKey(net.IPv4(10, 0, 0, 1), 1234, net.IPv4(10, 1, 0, 1), 5555, 6): {
+				PacketsToBackend: 10, BytesToBackend: 1000, PacketsFromBackend: 8, BytesFromBackend: 4000, Conns: 1,
+			},
+			// A backend that was removed from the service.
+			nat.NewStatsKey(net.IPv4(10, 0, 0, 1), 1234, net.IPv4(10, 1, 0, 2), 5555, 6): {
+				PacketsToBackend: 2, BytesToBackend: 200, CTLBConns: 1,
+			},
+			// A frontend that does not belong to any service.
+			nat.NewStatsKey(net.IPv4(10, 0, 0, 2), 1234, net.IPv4(10, 1, 0, 1), 5555, 6): {
+				PacketsToBackend: 5, BytesToBackend: 500, Conns: 1,
+			},
+		})
+
+		Expect(stats).To(HaveLen(1))
+		Expect(stats).To(HaveKey(svcKey))
+		Expect(stats[svcKey].StatsValue).To(Equal(nat.StatsValue{
+			PacketsToBackend: 12, BytesToBackend: 1200, PacketsFromBackend: 8, BytesFromBackend: 4000, Conns: 1, CTLBConns: 1,
+		}))
+		Expect(stats[svcKey].Backends).To(Equal(map[string]nat.StatsValue{
+			"10.1.0.1:5555": {PacketsToBackend: 10, BytesToBackend: 1000, PacketsFromBackend: 8, BytesFromBackend: 4000, Conns: 1},
+		}))
+
+		By("reporting services and backends without traffic")
+		stats = s.ServiceStats(nil)
+		Expect(stats).To(HaveKey(svcKey))
+		Expect(stats[svcKey].StatsValue).To(Equal(nat.StatsValue{}))
+		Expect(stats[svcKey].Backends).To(Equal(map[string]nat.StatsValue{"10.1.0.1:5555": {}}))
+	})
+
+	It("should compute NAT stats deltas of reused entries", func() {
+		v := nat.StatsValue{PacketsToBackend: 10, BytesToBackend: 1000, Conns: 2}
+		Expect(v.Sub(nat.StatsValue{PacketsToBackend: 4, BytesToBackend: 400, Conns: 1})).To(Equal(
+			nat.StatsValue{PacketsToBackend: 6, BytesToBackend: 600, Conns: 1}))
+		// The entry was evicted and created again since, all of it is new.
+		Expect(v.Sub(nat.StatsValue{PacketsToBackend: 20, BytesToBackend: 400})).To(Equal(v))
+	})
 })
 
 type mockNATMap struct {
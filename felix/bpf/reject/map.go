@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reject
+
+import (
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+const (
+	// LastSeen (8) + Credit (8)
+	RateLimitValueSize = 16
+)
+
+// RateLimitMapParams describes the map that the TC programs use to keep a
+// token bucket per source IP for the ICMP errors sent for packets rejected by
+// policy.  The map is owned by the BPF programs, felix only makes sure that it
+// exists.
+var RateLimitMapParams = maps.MapParameters{
+	Type:       "lru_hash",
+	KeySize:    4,
+	ValueSize:  RateLimitValueSize,
+	MaxEntries: 65536,
+	Name:       "cali_v4_rej_rl",
+}
+
+func RateLimitMap() maps.Map {
+	return maps.NewPinnedMap(RateLimitMapParams)
+}
+
+var RateLimitMapV6Params = maps.MapParameters{
+	Type:       "lru_hash",
+	KeySize:    16,
+	ValueSize:  RateLimitValueSize,
+	MaxEntries: 65536,
+	Name:       "cali_v6_rej_rl",
+}
+
+func RateLimitMapV6() maps.Map {
+	return maps.NewPinnedMap(RateLimitMapV6Params)
+}
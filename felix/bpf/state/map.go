@@ -30,6 +30,7 @@ const (
 	PolicyNoMatch PolicyResult = iota
 	PolicyAllow
 	PolicyDeny
+	PolicyReject
 	PolicyTailCallFailed = 10
 	MaxRuleIDs           = 32
 )
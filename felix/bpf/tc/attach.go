@@ -64,6 +64,12 @@ type AttachPoint struct {
 	NATout               uint32
 	UDPOnly              bool
 	RedirectPeer         bool
+	NATStats             bool
+	EgressBandwidth      bool
+	EgressBandwidthFQ    bool
+	EDTID                uint32
+	RejectRateLimit      uint32
+	DNSSnoop             bool
 }
 
 var ErrDeviceNotFound = errors.New("device not found")
@@ -352,6 +358,93 @@ func RemoveQdisc(ifaceName string) error {
 	return libbpf.RemoveQDisc(ifaceName)
 }
 
+// fqQdiscHandle is the handle of the root qdisc that EnsureFQQdisc installs.
+// It tells that qdisc apart from the ones configured by the user, which we
+// must not replace or remove.
+const fqQdiscHandle = "ca1f:"
+
+// rootQdisc returns the kind and the handle of the root qdisc of the given
+// interface.
+func rootQdisc(ifaceName string) (kind, handle string, err error) {
+	out, err := ExecTC("qdisc", "show", "dev", ifaceName, "root")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to check root qdisc of interface '%s': %w", ifaceName, err)
+	}
+	fields := strings.Fields(out)
+	if len(fields) < 3 || fields[0] != "qdisc" {
+		return "", "", fmt.Errorf("unexpected root qdisc of interface '%s': %q", ifaceName, out)
+	}
+	return fields[1], fields[2], nil
+}
+
+// EnsureFQQdisc makes sure that the packets sent by the given interface go
+// through fq, which honours the departure times that the BPF programs set on
+// packets.  It only replaces the root qdisc that the kernel created by
+// default, which has the handle 0:.  If the root qdisc is mq, there is a fq
+// qdisc under each of its tx queues.  It returns false if the user configured
+// another qdisc, which it leaves alone.
+func EnsureFQQdisc(ifaceName string) (bool, error) {
+	logCxt := log.WithField("iface", ifaceName)
+
+	kind, handle, err := rootQdisc(ifaceName)
+	if err != nil {
+		return false, err
+	}
+	if handle != fqQdiscHandle && handle != "0:" {
+		logCxt.WithField("qdisc", kind).Debug("Root qdisc was configured by the user, not replacing it with fq")
+		return false, nil
+	}
+
+	if kind != "mq" {
+		if handle == fqQdiscHandle && kind == "fq" {
+			logCxt.Debug("Already have a fq root qdisc on this interface")
+			return true, nil
+		}
+		if _, err := ExecTC("qdisc", "replace", "dev", ifaceName, "root", "handle", fqQdiscHandle, "fq"); err != nil {
+			return false, fmt.Errorf("failed to set fq root qdisc on interface '%s': %w", ifaceName, err)
+		}
+		return true, nil
+	}
+
+	if handle != fqQdiscHandle {
+		if _, err := ExecTC("qdisc", "replace", "dev", ifaceName, "root", "handle", fqQdiscHandle, "mq"); err != nil {
+			return false, fmt.Errorf("failed to set mq root qdisc on interface '%s': %w", ifaceName, err)
+		}
+	}
+	out, err := ExecTC("class", "show", "dev", ifaceName, "parent", fqQdiscHandle)
+	if err != nil {
+		return false, fmt.Errorf("failed to list tx queues of interface '%s': %w", ifaceName, err)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] != "class" || fields[1] != "mq" {
+			continue
+		}
+		if _, err := ExecTC("qdisc", "replace", "dev", ifaceName, "parent", fields[2], "fq"); err != nil {
+			return false, fmt.Errorf("failed to set fq qdisc on tx queue %s of interface '%s': %w",
+				fields[2], ifaceName, err)
+		}
+	}
+	return true, nil
+}
+
+// RemoveFQQdisc restores the default root qdisc of the given interface if
+// EnsureFQQdisc replaced it.
+func RemoveFQQdisc(ifaceName string) error {
+	_, handle, err := rootQdisc(ifaceName)
+	if err != nil {
+		return err
+	}
+	if handle != fqQdiscHandle {
+		return nil
+	}
+	// Deleting the root qdisc makes the kernel create the default one again.
+	if _, err := ExecTC("qdisc", "del", "dev", ifaceName, "root"); err != nil {
+		return fmt.Errorf("failed to remove fq root qdisc from interface '%s': %w", ifaceName, err)
+	}
+	return nil
+}
+
 func findFilterPriority(progsToClean []attachedProg) int {
 	prio := 0
 	for _, p := range progsToClean {
@@ -419,6 +512,32 @@ func (ap *AttachPoint) Configure() *libbpf.TcGlobalData {
 		globalData.Flags |= libbpf.GlobalsRedirectPeer
 	}
 
+	if ap.NATStats {
+		globalData.Flags |= libbpf.GlobalsNATStats
+	}
+
+	if ap.DNSSnoop {
+		globalData.Flags |= libbpf.GlobalsDNSSnoop
+	}
+
+	// The id is recorded on packets from the workload, which the program on
+	// the host side of the workload interface sees on ingress.
+	if ap.Hook == hook.Ingress {
+		globalData.EDTID = ap.EDTID
+	}
+
+	// Packets are only stamped with a departure time if the interface has
+	// the fq qdisc that holds them until then.
+	if ap.EgressBandwidth {
+		if ap.Hook != hook.Egress {
+			globalData.Flags |= libbpf.GlobalsEDTReset
+		} else if ap.EgressBandwidthFQ {
+			globalData.Flags |= libbpf.GlobalsEDTSchedule
+		}
+	}
+
+	globalData.RejectRateLimit = ap.RejectRateLimit
+
 	globalData.HostTunnelIPv4 = globalData.HostIPv4
 	globalData.HostTunnelIPv6 = globalData.HostIPv6
 
@@ -117,6 +117,10 @@ func CleanUpProgramsAndPins() {
 			log.WithError(err).WithField("iface", iface).Info(
 				"Failed to remove BPF program from interface, maybe interface has gone?")
 		}
+		if err := RemoveFQQdisc(iface); err != nil {
+			log.WithError(err).WithField("iface", iface).Info(
+				"Failed to restore the root qdisc of the interface, maybe interface has gone?")
+		}
 		return nil
 	})
 
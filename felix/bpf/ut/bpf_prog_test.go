@@ -52,6 +52,7 @@ import (
 	"github.com/projectcalico/calico/felix/bpf/nat"
 	"github.com/projectcalico/calico/felix/bpf/polprog"
 	"github.com/projectcalico/calico/felix/bpf/profiling"
+	"github.com/projectcalico/calico/felix/bpf/reject"
 	"github.com/projectcalico/calico/felix/bpf/routes"
 	"github.com/projectcalico/calico/felix/bpf/state"
 	tcdefs "github.com/projectcalico/calico/felix/bpf/tc/defs"
@@ -575,7 +576,7 @@ var (
 	natMap, natBEMap, ctMap, rtMap, ipsMap, testStateMap, affinityMap, arpMap, fsafeMap     maps.Map
 	natMapV6, natBEMapV6, ctMapV6, rtMapV6, ipsMapV6, affinityMapV6, arpMapV6, fsafeMapV6   maps.Map
 	stateMap, countersMap, ifstateMap, progMap, progMapXDP, policyJumpMap, policyJumpMapXDP maps.Map
-	profilingMap                                                                            maps.Map
+	profilingMap, fsafeRLMap, rejectRLMap                                                   maps.Map
 	allMaps                                                                                 []maps.Map
 )
 
@@ -599,6 +600,8 @@ func initMapsOnce() {
 		arpMapV6 = arp.MapV6()
 		fsafeMap = failsafes.Map()
 		fsafeMapV6 = failsafes.MapV6()
+		fsafeRLMap = failsafes.RateLimitMap()
+		rejectRLMap = reject.RateLimitMap()
 		countersMap = counters.Map()
 		ifstateMap = ifstate.Map()
 		policyJumpMap = jump.Map()
@@ -606,7 +609,7 @@ func initMapsOnce() {
 		profilingMap = profiling.Map()
 
 		allMaps = []maps.Map{natMap, natBEMap, natMapV6, natBEMapV6, ctMap, ctMapV6, rtMap, rtMapV6, ipsMap, ipsMapV6,
-			stateMap, testStateMap, affinityMap, affinityMapV6, arpMap, arpMapV6, fsafeMap, fsafeMapV6,
+			stateMap, testStateMap, affinityMap, affinityMapV6, arpMap, arpMapV6, fsafeMap, fsafeMapV6, fsafeRLMap, rejectRLMap,
 			countersMap, ifstateMap, profilingMap,
 			policyJumpMap, policyJumpMapXDP}
 		for _, m := range allMaps {
@@ -750,6 +753,8 @@ func objLoad(fname, bpfFsDir, ipFamily string, topts testOpts, polProg, hasHostC
 				}
 
 				globals.IfaceName = setLogPrefix(bpfIfaceName)
+				globals.Flags = topts.xdpFlags
+				globals.FailsafeRateLimit = topts.xdpFailsafeRateLimit
 				if err := globals.Set(m); err != nil {
 					return nil, fmt.Errorf("failed to configure xdp program: %w", err)
 				}
@@ -1098,6 +1103,9 @@ type testOpts struct {
 	progLog       string
 	ipv6          bool
 	objname       string
+
+	xdpFlags             uint32
+	xdpFailsafeRateLimit uint32
 }
 
 type testOption func(opts *testOpts)
@@ -1124,6 +1132,13 @@ func withXDP() testOption {
 	}
 }
 
+func withXDPGlobals(flags, failsafeRateLimit uint32) testOption {
+	return func(o *testOpts) {
+		o.xdpFlags = flags
+		o.xdpFailsafeRateLimit = failsafeRateLimit
+	}
+}
+
 func withPSNATPorts(start, end uint16) testOption {
 	return func(o *testOpts) {
 		o.psnaStart = uint32(start)
@@ -1821,6 +1836,8 @@ func resetBPFMaps() {
 	resetCTMap(ctMap)
 	resetRTMap(rtMap)
 	resetMap(fsafeMap)
+	resetMap(fsafeRLMap)
+	resetMap(rejectRLMap)
 	resetMap(natMap)
 	resetMap(natBEMap)
 }
@@ -91,8 +91,13 @@ func TestMapResizeWithCopy(t *testing.T) {
 		v[i] = uint8(i)
 	}
 	Expect(v.AsBytes()).To(Equal(val))
-	err = maps.V4.CtMap.CopyDeltaFromOldMap()
+
+	migrator, err := conntrack.NewLiveResizeMigrator(maps.V4.CtMap, 4)
+	Expect(err).NotTo(HaveOccurred())
+	err = migrator.Migrate()
 	Expect(err).NotTo(HaveOccurred(), "migration failed")
+	_, err = os.Stat(maps.V4.CtMap.Path() + "_old")
+	Expect(err).To(HaveOccurred(), "old conntrack map present")
 }
 
 func TestMapDownSize(t *testing.T) {
@@ -139,7 +144,8 @@ func TestCTDeltaMigration(t *testing.T) {
 
 	t.Log("STEP: update existing key in the old map")
 
-	// update the value for the old key in the old map
+	// update the value for the old key in the old map, it is seen later than
+	// the copy in the new map.
 	newVal := conntrack.Value{}
 	for i := range newVal {
 		newVal[i] = uint8(i + 10)
@@ -157,10 +163,23 @@ func TestCTDeltaMigration(t *testing.T) {
 		Expect(err).NotTo(HaveOccurred())
 	}
 
+	t.Log("STEP: update an entry in the new map")
+
+	// This is what the programs do once they switched to the new map, the
+	// entry must not be overwritten by the older one in the old map.
+	k0 := conntrack.NewKey(1, net.ParseIP("10.0.0.1"), 0, net.ParseIP("10.0.0.2"), 0)
+	v0 := conntrack.Value{}
+	for i := range v0 {
+		v0[i] = uint8(i + 100)
+	}
+	err = maps.V4.CtMap.Update(k0.AsBytes(), v0[:])
+	Expect(err).NotTo(HaveOccurred())
+
 	matchKeyVals := func(ctSaved conntrack.MapMem) {
 		Expect(ctSaved).Should(HaveKeyWithValue(k, newVal))
 		Expect(ctSaved).ShouldNot(HaveKeyWithValue(k, v))
-		for i := 0; i < numEntries; i++ {
+		Expect(ctSaved).Should(HaveKeyWithValue(k0, v0))
+		for i := 1; i < numEntries; i++ {
 			key := conntrack.NewKey(1, net.ParseIP("10.0.0.1"), uint16(i), net.ParseIP("10.0.0.2"), uint16(i>>16))
 			val := conntrack.Value{}
 			for j := range val {
@@ -173,17 +192,62 @@ func TestCTDeltaMigration(t *testing.T) {
 	t.Log("STEP: copy delta")
 
 	_, err = os.Stat(maps.V4.CtMap.Path() + "_old")
-	Expect(err).NotTo(HaveOccurred(), "old conntrack map present")
-	// Migrate the delta (10 k,v pairs) to the new map
-	err = maps.V4.CtMap.CopyDeltaFromOldMap()
+	Expect(err).NotTo(HaveOccurred(), "old conntrack map not present")
+	Expect(maps.V4.CtMap.(*bpfmaps.PinnedMap).LiveResizeInProgress()).To(BeTrue())
+
+	// Migrate the delta to the new map
+	migrator, err := conntrack.NewLiveResizeMigrator(maps.V4.CtMap, 4)
+	Expect(err).NotTo(HaveOccurred())
+	err = migrator.Migrate()
 	Expect(err).NotTo(HaveOccurred(), "migration failed")
 	ctSaved = saveCTMap(maps.V4.CtMap)
 	Expect(ctSaved).To(HaveLen(numEntries + 1))
 	matchKeyVals(ctSaved)
 
+	_, err = os.Stat(maps.V4.CtMap.Path() + "_old")
+	Expect(err).To(HaveOccurred(), "old conntrack map present")
+	Expect(maps.V4.CtMap.(*bpfmaps.PinnedMap).LiveResizeInProgress()).To(BeFalse())
+}
+
+func TestCTLiveResizeResume(t *testing.T) {
+	// Add 1 k,v pair in old ctmap
+	k, err := setUpMapTestWithSingleKV(t)
+	Expect(err).NotTo(HaveOccurred(), "Failed to create ct entry")
+
+	conntrack.SetMapAutoScaling(true)
+	defer conntrack.SetMapAutoScaling(false)
+
+	// Grow the map like the AutoScaler does, the programs keep using the old
+	// map and felix restarts.
+	size := ctMap.(*bpfmaps.PinnedMap).MaxEntries
+	err = ctMap.(*bpfmaps.PinnedMap).Grow(size * 2)
+	Expect(err).NotTo(HaveOccurred())
+
+	// An entry that the programs create in the old map after the map grew.
+	err = insertNumberedKey(1)
+	Expect(err).NotTo(HaveOccurred())
+
+	maps, err := bpfmap.CreateBPFMaps(false)
+	Expect(err).NotTo(HaveOccurred())
+
+	defer restoreMaps(maps)
+
+	// The entries are copied before the programs switch to the grown map.
+	pm := maps.V4.CtMap.(*bpfmaps.PinnedMap)
+	Expect(pm.MaxEntries).To(Equal(size * 2))
+	Expect(pm.LiveResizeInProgress()).To(BeTrue())
+	ctSaved := saveCTMap(maps.V4.CtMap)
+	Expect(ctSaved).To(HaveLen(2))
+	Expect(ctSaved).Should(HaveKey(k))
+
+	migrator, err := conntrack.NewLiveResizeMigrator(maps.V4.CtMap, 4)
 	Expect(err).NotTo(HaveOccurred())
+	err = migrator.Migrate()
+	Expect(err).NotTo(HaveOccurred(), "migration failed")
+
 	_, err = os.Stat(maps.V4.CtMap.Path() + "_old")
 	Expect(err).To(HaveOccurred(), "old conntrack map present")
+	Expect(pm.LiveResizeInProgress()).To(BeFalse())
 }
 
 func TestMapEntryDeletion(t *testing.T) {
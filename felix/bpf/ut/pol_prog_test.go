@@ -1854,6 +1854,40 @@ var polProgramTests = []polProgramTest{
 		DroppedPackets: []packet{
 			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 8, 3)},
 	},
+	{
+		PolicyName: "allow icmp packet with type in list",
+		Policy: makeRulesSingleTier([]*proto.Rule{{
+			Action: "Allow",
+			Icmp: &proto.Rule_IcmpTypesAndCodes{IcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+				Types:     []int32{0, 8},
+				TypeCodes: []*proto.IcmpTypeAndCode{{Type: 3, Code: 4}},
+			}},
+		}}),
+		AllowedPackets: []packet{
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 0, 0),
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 8, 0),
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 3, 4)},
+		DroppedPackets: []packet{
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 10, 0),
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 3, 1)},
+	},
+	{
+		PolicyName: "allow icmp packet with type not in list",
+		Policy: makeRulesSingleTier([]*proto.Rule{{
+			Action: "Allow",
+			NotIcmp: &proto.Rule_NotIcmpTypesAndCodes{NotIcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+				Types:     []int32{0, 8},
+				TypeCodes: []*proto.IcmpTypeAndCode{{Type: 3, Code: 4}},
+			}},
+		}}),
+		AllowedPackets: []packet{
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 10, 0),
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 3, 1)},
+		DroppedPackets: []packet{
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 0, 0),
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 8, 0),
+			icmpPktWithTypeCode("10.0.0.1", "10.0.0.2", 3, 4)},
+	},
 	// Generic protocol tests.
 	{
 		PolicyName: "Protocol match",
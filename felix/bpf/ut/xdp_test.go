@@ -23,7 +23,9 @@ import (
 	"github.com/google/gopacket/layers"
 	. "github.com/onsi/gomega"
 
+	"github.com/projectcalico/calico/felix/bpf/conntrack"
 	"github.com/projectcalico/calico/felix/bpf/failsafes"
+	"github.com/projectcalico/calico/felix/bpf/libbpf"
 	"github.com/projectcalico/calico/felix/bpf/polprog"
 	"github.com/projectcalico/calico/felix/proto"
 )
@@ -275,6 +277,130 @@ func TestXDPPrograms(t *testing.T) {
 	}
 }
 
+var denyOnlyXDPRules = polprog.Rules{
+	ForXDP:           true,
+	ForHostInterface: true,
+	XDPDenyOnly:      true,
+	HostPreDnatTiers: []polprog.Tier{{
+		EndAction: "pass",
+		Policies: []polprog.Policy{{
+			Name: "deny some",
+			Rules: []polprog.Rule{{
+				Rule: &proto.Rule{
+					SrcNet: []string{"9.8.7.0/24"},
+					Action: "Deny",
+				}}, {
+				Rule: &proto.Rule{
+					Action: "Allow",
+				}},
+			}}},
+	}},
+}
+
+func TestXDPDenyOnlyCTFastPath(t *testing.T) {
+	RegisterTestingT(t)
+
+	defer resetBPFMaps()
+	defer func() { bpfIfaceName = "" }()
+	bpfIfaceName = "XDPCT"
+
+	ipHdr := &layers.IPv4{
+		Version:  4,
+		IHL:      5,
+		TTL:      64,
+		Flags:    layers.IPv4DontFragment,
+		SrcIP:    net.IPv4(9, 8, 7, 6),
+		DstIP:    net.IPv4(10, 0, 0, 10),
+		Protocol: layers.IPProtocolTCP,
+	}
+	tcp := &layers.TCP{
+		SrcPort: 55555,
+		DstPort: 80,
+		ACK:     true,
+	}
+	_, _, _, _, pktBytes, err := testPacketV4(nil, ipHdr, tcp, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	runBpfTest(t, "xdp_calico_entrypoint", &denyOnlyXDPRules, func(bpfrun bpfProgRunFn) {
+		res, err := bpfrun(pktBytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RetvalStrXDP()).To(Equal("XDP_DROP"))
+	}, withXDP(), withXDPGlobals(libbpf.XDPGlobalsCTFastPath, 0))
+
+	ctKey := conntrack.NewKey(uint8(layers.IPProtocolTCP), ipHdr.SrcIP, 55555, ipHdr.DstIP.To4(), 80)
+	ctVal := conntrack.NewValueNormal(0, 0, 0, conntrack.Leg{}, conntrack.Leg{})
+	err = ctMap.Update(ctKey.AsBytes(), ctVal.AsBytes())
+	Expect(err).NotTo(HaveOccurred())
+
+	runBpfTest(t, "xdp_calico_entrypoint", &denyOnlyXDPRules, func(bpfrun bpfProgRunFn) {
+		res, err := bpfrun(pktBytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RetvalStrXDP()).To(Equal("XDP_PASS"))
+		Expect(res.dataOut[TOS_BYTE]).To(Equal(uint8(TOS_NOTSET)))
+	}, withXDP(), withXDPGlobals(libbpf.XDPGlobalsCTFastPath, 0))
+
+	// Without the fast path, the deny rule still applies.
+	runBpfTest(t, "xdp_calico_entrypoint", &denyOnlyXDPRules, func(bpfrun bpfProgRunFn) {
+		res, err := bpfrun(pktBytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RetvalStrXDP()).To(Equal("XDP_DROP"))
+	}, withXDP())
+}
+
+func TestXDPFailsafeRateLimit(t *testing.T) {
+	RegisterTestingT(t)
+
+	defer resetBPFMaps()
+	err := fsafeMap.Update(
+		failsafes.MakeKey(17, 53, false, "4.4.4.4", 16).ToSlice(),
+		failsafes.Value(),
+	)
+	Expect(err).NotTo(HaveOccurred())
+
+	defer func() { bpfIfaceName = "" }()
+	bpfIfaceName = "XDPRL"
+
+	ipHdr := &layers.IPv4{
+		Version:  4,
+		IHL:      5,
+		TTL:      64,
+		Flags:    layers.IPv4DontFragment,
+		SrcIP:    net.IPv4(4, 4, 4, 4),
+		DstIP:    net.IPv4(1, 1, 1, 1),
+		Protocol: layers.IPProtocolUDP,
+	}
+	udp := &layers.UDP{
+		SrcPort: 54321,
+		DstPort: 53,
+	}
+	_, _, _, _, pktBytes, err := testPacketV4(nil, ipHdr, udp, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	// With a limit of 1 packet per second, the first packet gets through and
+	// the second one is over the limit.
+	runBpfTest(t, "xdp_calico_entrypoint", nil, func(bpfrun bpfProgRunFn) {
+		res, err := bpfrun(pktBytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RetvalStrXDP()).To(Equal("XDP_PASS"))
+
+		res, err = bpfrun(pktBytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RetvalStrXDP()).To(Equal("XDP_DROP"))
+	}, withXDP(), withXDPGlobals(0, 1))
+
+	// Packets of established flows are not rate limited.
+	ctKey := conntrack.NewKey(uint8(layers.IPProtocolUDP), ipHdr.DstIP.To4(), 53, ipHdr.SrcIP.To4(), 54321)
+	ctVal := conntrack.NewValueNormal(0, 0, 0, conntrack.Leg{}, conntrack.Leg{})
+	err = ctMap.Update(ctKey.AsBytes(), ctVal.AsBytes())
+	Expect(err).NotTo(HaveOccurred())
+
+	runBpfTest(t, "xdp_calico_entrypoint", nil, func(bpfrun bpfProgRunFn) {
+		res, err := bpfrun(pktBytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RetvalStrXDP()).To(Equal("XDP_PASS"))
+	}, withXDP(), withXDPGlobals(0, 1))
+}
+
 var xdpV6TestCases = []xdpTestV6{
 	{
 		Description: "2 - Packets not matched, must pass without metadata",
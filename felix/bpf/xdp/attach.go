@@ -37,6 +37,13 @@ type AttachPoint struct {
 	HookLayoutV6 hook.Layout
 
 	Modes []bpf.XDPMode
+
+	// CTFastPath lets packets of flows that have a conntrack entry skip the
+	// XDP policy.  Only safe when XDP policy is also enforced by TC.
+	CTFastPath bool
+	// FailsafeRateLimit is the number of new flows per second that a source
+	// may open to the inbound failsafe ports, 0 means unlimited.
+	FailsafeRateLimit uint32
 }
 
 func (ap *AttachPoint) PolicyAllowJumpIdx(family int) int {
@@ -78,9 +85,10 @@ func (ap *AttachPoint) ProgramName() string {
 
 func (ap *AttachPoint) Log() *log.Entry {
 	return log.WithFields(log.Fields{
-		"iface":    ap.Iface,
-		"modes":    ap.Modes,
-		"logLevel": ap.LogLevel,
+		"iface":      ap.Iface,
+		"modes":      ap.Modes,
+		"logLevel":   ap.LogLevel,
+		"ctFastPath": ap.CTFastPath,
 	})
 }
 
@@ -133,6 +141,11 @@ func (ap *AttachPoint) Configuration() *libbpf.XDPGlobalData {
 	copy(in, ap.Iface)
 	globalData.IfaceName = string(in)
 
+	if ap.CTFastPath {
+		globalData.Flags |= libbpf.XDPGlobalsCTFastPath
+	}
+	globalData.FailsafeRateLimit = ap.FailsafeRateLimit
+
 	return globalData
 }
 
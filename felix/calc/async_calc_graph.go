@@ -31,6 +31,10 @@ import (
 const (
 	tickInterval    = 10 * time.Millisecond
 	leakyBucketSize = 10
+
+	// scheduleCheckInterval is how often we check whether scheduled policies need to be
+	// activated or deactivated.  Schedules have minute granularity.
+	scheduleCheckInterval = time.Second
 )
 
 var (
@@ -81,6 +85,7 @@ type AsyncCalcGraph struct {
 
 	flushTicks       <-chan time.Time
 	healthTicks      <-chan time.Time
+	scheduleTicks    <-chan time.Time
 	flushLeakyBucket int
 	dirty            bool
 
@@ -99,6 +104,7 @@ func NewAsyncCalcGraph(
 	healthAggregator *health.HealthAggregator,
 ) *AsyncCalcGraph {
 	eventSequencer := NewEventSequencer(conf)
+	eventSequencer.ReportPolicyGenerations = conf.PolicyStatusReportInterval > 0
 	g := &AsyncCalcGraph{
 		inputEvents:      make(chan interface{}, 10),
 		outputChannels:   outputChannels,
@@ -183,6 +189,10 @@ func (acg *AsyncCalcGraph) loop() {
 			}
 		case <-acg.healthTicks:
 			acg.reportHealth()
+		case <-acg.scheduleTicks:
+			if acg.CalcGraph.CheckPolicySchedules() {
+				acg.dirty = true
+			}
 		case <-acg.debugHangC:
 			log.Warning("Debug hang simulation timer popped, hanging the calculation graph!!")
 			time.Sleep(1 * time.Hour)
@@ -255,5 +265,6 @@ func (acg *AsyncCalcGraph) Start() {
 	log.Info("Starting AsyncCalcGraph")
 	acg.flushTicks = time.NewTicker(tickInterval).C
 	acg.healthTicks = time.NewTicker(healthInterval).C
+	acg.scheduleTicks = time.NewTicker(scheduleCheckInterval).C
 	go acg.loop()
 }
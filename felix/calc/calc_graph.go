@@ -113,6 +113,10 @@ type CalcGraph struct {
 	// AllUpdDispatcher is the input node to the calculation graph.
 	AllUpdDispatcher *dispatcher.Dispatcher
 
+	// policyScheduler filters updates before they reach AllUpdDispatcher, hiding scheduled
+	// policies outside their windows.
+	policyScheduler *PolicyScheduler
+
 	// Pointers to the other calc graph nodes; we don't use most of
 	// these (because the nodes reference each other directly) but
 	// they're very useful when inspecting the state of the calc graph
@@ -133,7 +137,18 @@ type CalcGraph struct {
 }
 
 func (g *CalcGraph) OnUpdates(updates []api.Update) {
+	g.AllUpdDispatcher.OnUpdates(g.policyScheduler.FilterUpdates(updates))
+}
+
+// CheckPolicySchedules activates and deactivates scheduled policies whose windows have opened or
+// closed.  It returns true if any policies changed.
+func (g *CalcGraph) CheckPolicySchedules() bool {
+	updates := g.policyScheduler.CheckSchedules()
+	if len(updates) == 0 {
+		return false
+	}
 	g.AllUpdDispatcher.OnUpdates(updates)
+	return true
 }
 
 func (g *CalcGraph) OnStatusUpdated(update api.SyncStatus) {
@@ -147,7 +162,9 @@ func (g *CalcGraph) Flush() {
 func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config, liveCallback func()) *CalcGraph {
 	hostname := conf.FelixHostname
 	log.Infof("Creating calculation graph, filtered to hostname %v", hostname)
-	cg := &CalcGraph{}
+	cg := &CalcGraph{
+		policyScheduler: NewPolicyScheduler(),
+	}
 
 	// The source of the processing graph, this dispatcher will be fed all the updates from the
 	// datastore, fanning them out to the registered receivers.
@@ -302,7 +319,12 @@ func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config, liveC
 	ruleScanner.OnIPSetActive = func(ipSet *IPSetData) {
 		log.WithField("ipSet", ipSet).Info("IPSet now active")
 		callbacks.OnIPSetAdded(ipSet.UniqueID(), ipSet.DataplaneProtocolType())
-		if ipSet.Service != "" {
+		if len(ipSet.Domains) > 0 {
+			// Domain IP sets have fixed members; the dataplane resolves them.
+			for _, domain := range ipSet.Domains {
+				callbacks.OnIPSetMemberAdded(ipSet.UniqueID(), labelindex.IPSetMember{Domain: domain})
+			}
+		} else if ipSet.Service != "" {
 			serviceIndex.UpdateIPSet(ipSet.UniqueID(), ipSet.Service)
 		} else {
 			ipsetMemberIndex.UpdateIPSet(ipSet.UniqueID(), ipSet.Selector, ipSet.NamedPortProtocol, ipSet.NamedPort)
@@ -313,7 +335,7 @@ func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config, liveC
 		log.WithField("ipSet", ipSet).Info("IPSet now inactive")
 		if ipSet.Service != "" {
 			serviceIndex.DeleteIPSet(ipSet.UniqueID())
-		} else {
+		} else if len(ipSet.Domains) == 0 {
 			ipsetMemberIndex.DeleteIPSet(ipSet.UniqueID())
 		}
 		callbacks.OnIPSetRemoved(ipSet.UniqueID())
@@ -101,6 +101,12 @@ type EventSequencer struct {
 	sentWireguardV6     set.Set[string]
 	sentServices        set.Set[serviceID]
 
+	// ReportPolicyGenerations enables the PolicyGenerations message, which records the generation
+	// of each policy that we've sent downstream.
+	ReportPolicyGenerations   bool
+	sentPolicyGenerations     map[model.PolicyKey]int64
+	policyGenerationsModified bool
+
 	Callback EventHandler
 }
 
@@ -178,6 +184,10 @@ func NewEventSequencer(conf configInterface) *EventSequencer {
 		sentWireguard:       set.New[string](),
 		sentWireguardV6:     set.New[string](),
 		sentServices:        set.New[serviceID](),
+
+		sentPolicyGenerations: map[model.PolicyKey]int64{},
+		// Send an initial, possibly empty, report to replace any report from a previous run.
+		policyGenerationsModified: true,
 	}
 	return buf
 }
@@ -297,6 +307,10 @@ func (buf *EventSequencer) flushPolicyUpdates() {
 	for key, rules := range buf.pendingPolicyUpdates {
 		buf.Callback(ParsedRulesToActivePolicyUpdate(key, rules))
 		buf.sentPolicies.Add(key)
+		if buf.ReportPolicyGenerations && !rules.Staged {
+			buf.sentPolicyGenerations[key] = rules.Generation
+			buf.policyGenerationsModified = true
+		}
 		delete(buf.pendingPolicyUpdates, key)
 	}
 }
@@ -320,6 +334,7 @@ func ParsedRulesToActivePolicyUpdate(key model.PolicyKey, rules *ParsedRules) *p
 			Untracked:        rules.Untracked,
 			PreDnat:          rules.PreDNAT,
 			OriginalSelector: rules.OriginalSelector,
+			Staged:           rules.Staged,
 		},
 	}
 }
@@ -340,10 +355,33 @@ func (buf *EventSequencer) flushPolicyDeletes() {
 			},
 		})
 		buf.sentPolicies.Discard(item)
+		if _, ok := buf.sentPolicyGenerations[item]; ok {
+			delete(buf.sentPolicyGenerations, item)
+			buf.policyGenerationsModified = true
+		}
 		return set.RemoveItem
 	})
 }
 
+// PolicyGenerations is sent after a flush that changes the set of active policies, or their
+// generations, if the EventSequencer's ReportPolicyGenerations is set.  It is consumed by the
+// policy status reporter rather than by the dataplane.
+type PolicyGenerations struct {
+	Generations map[model.PolicyKey]int64
+}
+
+func (buf *EventSequencer) flushPolicyGenerations() {
+	if !buf.ReportPolicyGenerations || !buf.policyGenerationsModified {
+		return
+	}
+	gens := make(map[model.PolicyKey]int64, len(buf.sentPolicyGenerations))
+	for k, v := range buf.sentPolicyGenerations {
+		gens[k] = v
+	}
+	buf.Callback(&PolicyGenerations{Generations: gens})
+	buf.policyGenerationsModified = false
+}
+
 func (buf *EventSequencer) OnProfileActive(key model.ProfileRulesKey, rules *ParsedRules) {
 	buf.pendingProfileDeletes.Discard(key)
 	buf.pendingProfileUpdates[key] = rules
@@ -395,6 +433,12 @@ func ModelWorkloadEndpointToProto(ep *model.WorkloadEndpoint, tiers []*proto.Tie
 	if ep.Mac != nil {
 		mac = ep.Mac.String()
 	}
+	var qosControls *proto.QoSControls
+	if ep.QoSControls != nil {
+		qosControls = &proto.QoSControls{
+			EgressBandwidth: ep.QoSControls.EgressBandwidth,
+		}
+	}
 	return &proto.WorkloadEndpoint{
 		State:                      ep.State,
 		Name:                       ep.Name,
@@ -407,6 +451,7 @@ func ModelWorkloadEndpointToProto(ep *model.WorkloadEndpoint, tiers []*proto.Tie
 		Ipv6Nat:                    natsToProtoNatInfo(ep.IPv6NAT),
 		AllowSpoofedSourcePrefixes: netsToStrings(ep.AllowSpoofedSourcePrefixes),
 		Annotations:                ep.Annotations,
+		QosControls:                qosControls,
 	}
 }
 
@@ -787,6 +832,9 @@ func (buf *EventSequencer) flushAddedIPSets() {
 }
 
 func memberToProto(member labelindex.IPSetMember) string {
+	if member.Domain != "" {
+		return member.Domain
+	}
 	switch member.Protocol {
 	case labelindex.ProtocolNone:
 		return member.CIDR.String()
@@ -819,6 +867,7 @@ func (buf *EventSequencer) Flush() {
 	buf.flushProfileDeletes()
 	buf.flushPolicyDeletes()
 	buf.flushRemovedIPSets()
+	buf.flushPolicyGenerations()
 
 	// Flush ServiceAccount and Namespace updates. These have no particular ordering compared with other updates.
 	buf.flushServiceAccounts()
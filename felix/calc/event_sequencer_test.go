@@ -386,3 +386,50 @@ func (i *dummyConfigInterface) UpdateFrom(map[string]string, config.Source) (cha
 func (i *dummyConfigInterface) RawValues() map[string]string {
 	return nil
 }
+
+var _ = Describe("Policy generation reporting", func() {
+	var uut *calc.EventSequencer
+	var recorder *dataplaneRecorder
+	key := model.PolicyKey{Tier: "default", Name: "gnp"}
+
+	lastGenerations := func() map[model.PolicyKey]int64 {
+		for i := len(recorder.Messages) - 1; i >= 0; i-- {
+			if g, ok := recorder.Messages[i].(*calc.PolicyGenerations); ok {
+				return g.Generations
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		uut = calc.NewEventSequencer(&dummyConfigInterface{})
+		uut.ReportPolicyGenerations = true
+		recorder = &dataplaneRecorder{}
+		uut.Callback = recorder.record
+	})
+
+	It("should report generations after the policy updates", func() {
+		uut.OnPolicyActive(key, &calc.ParsedRules{Generation: 2})
+		uut.OnPolicyActive(model.PolicyKey{Tier: "default", Name: "staged"}, &calc.ParsedRules{Staged: true})
+		uut.Flush()
+		Expect(recorder.Messages[len(recorder.Messages)-1]).To(BeAssignableToTypeOf(&calc.PolicyGenerations{}))
+		Expect(lastGenerations()).To(Equal(map[model.PolicyKey]int64{key: 2}))
+
+		By("not re-sending unchanged generations")
+		recorder.Messages = nil
+		uut.Flush()
+		Expect(recorder.Messages).To(BeNil())
+
+		By("removing deleted policies")
+		uut.OnPolicyInactive(key)
+		uut.Flush()
+		Expect(lastGenerations()).To(BeEmpty())
+	})
+
+	It("should not report generations unless enabled", func() {
+		uut.ReportPolicyGenerations = false
+		uut.OnPolicyActive(key, &calc.ParsedRules{Generation: 2})
+		uut.Flush()
+		Expect(lastGenerations()).To(BeNil())
+	})
+})
@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/schedule"
+)
+
+var gaugeVecPolicyScheduleActive = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "felix_policy_schedule_active",
+	Help: "Whether each policy with a schedule is currently active (1) or not (0).",
+}, []string{"tier", "name"})
+
+func init() {
+	prometheus.MustRegister(gaugeVecPolicyScheduleActive)
+}
+
+// PolicyScheduler sits in front of the calculation graph and hides policies that have a
+// schedule while none of their windows is open.  A scheduled policy that is inactive looks,
+// to the rest of the graph, as if it had been deleted; when a window opens, the policy is
+// passed on again.
+type PolicyScheduler struct {
+	scheduledPolicies map[model.PolicyKey]*scheduledPolicy
+	// nextTransition is the earliest time at which a scheduled policy changes state, or the
+	// zero time if there are none.
+	nextTransition time.Time
+	nowFunc        func() time.Time
+}
+
+type scheduledPolicy struct {
+	update   api.Update
+	schedule *schedule.Schedule
+	// active is true if the policy has been passed on to the rest of the graph.
+	active         bool
+	nextTransition time.Time
+}
+
+func NewPolicyScheduler() *PolicyScheduler {
+	return &PolicyScheduler{
+		scheduledPolicies: map[model.PolicyKey]*scheduledPolicy{},
+		nowFunc:           time.Now,
+	}
+}
+
+// FilterUpdates returns the updates with those for inactive scheduled policies removed or
+// converted to deletions.  The input slice is returned unchanged if there is nothing to filter.
+func (s *PolicyScheduler) FilterUpdates(updates []api.Update) []api.Update {
+	var filtered []api.Update
+	for i, upd := range updates {
+		key, ok := upd.Key.(model.PolicyKey)
+		if !ok {
+			if filtered != nil {
+				filtered = append(filtered, upd)
+			}
+			continue
+		}
+		out, pass := s.onPolicyUpdate(key, upd)
+		if pass && filtered == nil && out.Value == upd.Value && out.UpdateType == upd.UpdateType {
+			// Common case: unscheduled policy, nothing to change.
+			continue
+		}
+		if filtered == nil {
+			filtered = append([]api.Update{}, updates[:i]...)
+		}
+		if pass {
+			filtered = append(filtered, out)
+		}
+	}
+	if filtered == nil {
+		return updates
+	}
+	return filtered
+}
+
+// onPolicyUpdate records the given update and returns the update to pass on, if any.
+func (s *PolicyScheduler) onPolicyUpdate(key model.PolicyKey, upd api.Update) (api.Update, bool) {
+	old := s.scheduledPolicies[key]
+	var policy *model.Policy
+	if upd.Value != nil {
+		policy = upd.Value.(*model.Policy)
+	}
+
+	if policy == nil || policy.Schedule == nil {
+		if old != nil {
+			s.removePolicy(key)
+			if !old.active && policy == nil {
+				// The rest of the graph never saw the policy.
+				return upd, false
+			}
+		}
+		return upd, true
+	}
+
+	sched, err := schedule.Parse(policy.Schedule)
+	if err != nil {
+		// Should have been caught by validation.  Err on the side of not applying the policy.
+		log.WithError(err).WithField("policy", key).Warn("Ignoring policy with invalid schedule")
+		sched = nil
+	}
+	sp := &scheduledPolicy{update: upd, schedule: sched}
+	if sched != nil {
+		sp.active, sp.nextTransition = sched.Evaluate(s.nowFunc())
+	}
+	s.scheduledPolicies[key] = sp
+	s.recalculateNextTransition()
+	s.reportState(key, sp, old)
+
+	if sp.active {
+		return upd, true
+	}
+	if old == nil || !old.active {
+		return upd, false
+	}
+	return deletionFor(key), true
+}
+
+func (s *PolicyScheduler) removePolicy(key model.PolicyKey) {
+	delete(s.scheduledPolicies, key)
+	gaugeVecPolicyScheduleActive.DeleteLabelValues(key.Tier, key.Name)
+	s.recalculateNextTransition()
+}
+
+func deletionFor(key model.PolicyKey) api.Update {
+	return api.Update{
+		KVPair:     model.KVPair{Key: key},
+		UpdateType: api.UpdateTypeKVDeleted,
+	}
+}
+
+// NextTransition returns the time at which CheckSchedules next needs to be called, or the zero
+// time if no scheduled policy will change state.
+func (s *PolicyScheduler) NextTransition() time.Time {
+	return s.nextTransition
+}
+
+// CheckSchedules re-evaluates the schedules that are due and returns the updates needed to
+// activate or deactivate policies.
+func (s *PolicyScheduler) CheckSchedules() []api.Update {
+	now := s.nowFunc()
+	if s.nextTransition.IsZero() || now.Before(s.nextTransition) {
+		return nil
+	}
+	var updates []api.Update
+	for key, sp := range s.scheduledPolicies {
+		if sp.schedule == nil || sp.nextTransition.IsZero() || now.Before(sp.nextTransition) {
+			continue
+		}
+		old := *sp
+		sp.active, sp.nextTransition = sp.schedule.Evaluate(now)
+		s.reportState(key, sp, &old)
+		if sp.active == old.active {
+			continue
+		}
+		if sp.active {
+			updates = append(updates, sp.update)
+		} else {
+			updates = append(updates, deletionFor(key))
+		}
+	}
+	s.recalculateNextTransition()
+	return updates
+}
+
+func (s *PolicyScheduler) recalculateNextTransition() {
+	s.nextTransition = time.Time{}
+	for _, sp := range s.scheduledPolicies {
+		if sp.nextTransition.IsZero() {
+			continue
+		}
+		if s.nextTransition.IsZero() || sp.nextTransition.Before(s.nextTransition) {
+			s.nextTransition = sp.nextTransition
+		}
+	}
+}
+
+func (s *PolicyScheduler) reportState(key model.PolicyKey, sp, old *scheduledPolicy) {
+	value := 0.0
+	if sp.active {
+		value = 1
+	}
+	gaugeVecPolicyScheduleActive.WithLabelValues(key.Tier, key.Name).Set(value)
+	if old != nil && old.active == sp.active {
+		return
+	}
+	log.WithFields(log.Fields{
+		"policy":         key,
+		"active":         sp.active,
+		"nextTransition": sp.nextTransition,
+	}).Info("Scheduled policy state changed")
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+func TestPolicyScheduler(t *testing.T) {
+	RegisterTestingT(t)
+
+	now := time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC)
+	s := NewPolicyScheduler()
+	s.nowFunc = func() time.Time { return now }
+
+	scheduledKey := model.PolicyKey{Tier: "default", Name: "maintenance"}
+	scheduled := &model.Policy{
+		Selector: "all()",
+		Schedule: &apiv3.PolicySchedule{
+			Windows: []apiv3.ScheduleWindow{
+				{Start: "0 2 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+			},
+		},
+	}
+	plainKey := model.PolicyKey{Tier: "default", Name: "plain"}
+	plain := &model.Policy{Selector: "all()"}
+	update := func(key model.PolicyKey, p *model.Policy) api.Update {
+		upd := api.Update{KVPair: model.KVPair{Key: key}, UpdateType: api.UpdateTypeKVNew}
+		if p != nil {
+			upd.Value = p
+		} else {
+			upd.UpdateType = api.UpdateTypeKVDeleted
+		}
+		return upd
+	}
+
+	// Outside the window, the scheduled policy is hidden.
+	updates := []api.Update{update(plainKey, plain), update(scheduledKey, scheduled)}
+	out := s.FilterUpdates(updates)
+	Expect(out).To(Equal(updates[:1]))
+	Expect(s.NextTransition()).To(Equal(time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC)))
+	Expect(s.CheckSchedules()).To(BeEmpty())
+
+	// Unscheduled updates pass through untouched.
+	Expect(s.FilterUpdates(updates[:1])).To(Equal(updates[:1]))
+
+	// When the window opens, the policy is sent.
+	now = now.Add(time.Hour)
+	Expect(s.CheckSchedules()).To(Equal([]api.Update{update(scheduledKey, scheduled)}))
+	Expect(s.NextTransition()).To(Equal(time.Date(2026, 3, 1, 4, 0, 0, 0, time.UTC)))
+
+	// Updates to an active policy pass through.
+	Expect(s.FilterUpdates(updates[1:])).To(Equal(updates[1:]))
+
+	// When the window closes, the policy is deleted.
+	now = now.Add(2 * time.Hour)
+	Expect(s.CheckSchedules()).To(Equal([]api.Update{update(scheduledKey, nil)}))
+
+	// Deleting an inactive policy sends nothing.
+	Expect(s.FilterUpdates([]api.Update{update(scheduledKey, nil)})).To(BeEmpty())
+	Expect(s.NextTransition().IsZero()).To(BeTrue())
+
+	// Removing the schedule from an active policy leaves it active.
+	now = time.Date(2026, 3, 2, 2, 30, 0, 0, time.UTC)
+	Expect(s.FilterUpdates(updates[1:])).To(Equal(updates[1:]))
+	unscheduled := update(scheduledKey, plain)
+	Expect(s.FilterUpdates([]api.Update{unscheduled})).To(Equal([]api.Update{unscheduled}))
+	Expect(s.NextTransition().IsZero()).To(BeTrue())
+}
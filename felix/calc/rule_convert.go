@@ -18,6 +18,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 	log "github.com/sirupsen/logrus"
 	googleproto "google.golang.org/protobuf/proto"
@@ -127,6 +128,8 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 		OriginalSrcServiceNamespace:  in.OriginalSrcServiceNamespace,
 		OriginalDstService:           in.OriginalDstService,
 		OriginalDstServiceNamespace:  in.OriginalDstServiceNamespace,
+
+		Audit: in.Audit,
 	}
 
 	if len(in.OriginalSrcServiceAccountNames) > 0 || in.OriginalSrcServiceAccountSelector != "" {
@@ -164,6 +167,35 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 		if len(in.HTTPMatch.Methods) > 0 {
 			out.HttpMatch.Methods = in.HTTPMatch.Methods
 		}
+		var headers []*proto.HTTPMatch_HeaderMatch
+		for _, headerMatch := range in.HTTPMatch.Headers {
+			protoHeader := &proto.HTTPMatch_HeaderMatch{Name: headerMatch.Name}
+			if headerMatch.Exact != "" {
+				protoHeader.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Exact{Exact: headerMatch.Exact}
+			} else if headerMatch.Prefix != "" {
+				protoHeader.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Prefix{Prefix: headerMatch.Prefix}
+			} else if headerMatch.Regex != "" {
+				protoHeader.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Regex{Regex: headerMatch.Regex}
+			} else if headerMatch.Present {
+				protoHeader.HeaderMatch = &proto.HTTPMatch_HeaderMatch_Present{Present: true}
+			} else {
+				log.Error("Ignoring unknown header match type", headerMatch)
+				continue
+			}
+			headers = append(headers, protoHeader)
+		}
+		if len(headers) > 0 {
+			out.HttpMatch.Headers = headers
+		}
+		if len(in.HTTPMatch.Hosts) > 0 {
+			out.HttpMatch.Hosts = in.HTTPMatch.Hosts
+		}
+		if in.HTTPMatch.GRPC != nil {
+			out.HttpMatch.Grpc = &proto.HTTPMatch_GRPCMatch{
+				Service: in.HTTPMatch.GRPC.Service,
+				Methods: in.HTTPMatch.GRPC.Methods,
+			}
+		}
 	}
 
 	if in.Metadata != nil {
@@ -206,6 +238,16 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 			}
 		}
 	}
+	if len(in.ICMPTypes) > 0 {
+		out.Icmp = &proto.Rule_IcmpTypesAndCodes{
+			IcmpTypesAndCodes: convertICMPTypes(in.ICMPTypes),
+		}
+	}
+	if len(in.NotICMPTypes) > 0 {
+		out.NotIcmp = &proto.Rule_NotIcmpTypesAndCodes{
+			NotIcmpTypesAndCodes: convertICMPTypes(in.NotICMPTypes),
+		}
+	}
 
 	log.WithFields(log.Fields{
 		"in":  in,
@@ -282,3 +324,18 @@ func portToProtoPort(in numorstring.Port) (out *proto.PortRange) {
 	}
 	return
 }
+
+func convertICMPTypes(in []v3.ICMPTypeCode) *proto.IcmpTypesAndCodes {
+	out := &proto.IcmpTypesAndCodes{}
+	for _, tc := range in {
+		if tc.Code == nil {
+			out.Types = append(out.Types, int32(tc.Type))
+		} else {
+			out.TypeCodes = append(out.TypeCodes, &proto.IcmpTypeAndCode{
+				Type: int32(tc.Type),
+				Code: int32(*tc.Code),
+			})
+		}
+	}
+	return out
+}
@@ -90,7 +90,16 @@ var fullyLoadedParsedRule = ParsedRule{
 	HTTPMatch: &model.HTTPMatch{Methods: []string{"GET", "POST"}, Paths: []v3.HTTPPath{
 		{Exact: "/foo"},
 		{Prefix: "/bar"},
-	}},
+	},
+		Headers: []v3.HTTPHeaderMatch{
+			{Name: "x-tenant", Exact: "a"},
+			{Name: "x-version", Prefix: "v1."},
+			{Name: "user-agent", Regex: "curl/.*"},
+			{Name: "authorization", Present: true},
+		},
+		Hosts: []string{"*.example.com"},
+		GRPC:  &v3.GRPCMatch{Service: "helloworld.Greeter", Methods: []string{"SayHello"}},
+	},
 
 	Metadata: &model.RuleMetadata{Annotations: map[string]string{"key": "value"}},
 }
@@ -157,7 +166,16 @@ var fullyLoadedProtoRule = &proto.Rule{
 	HttpMatch: &proto.HTTPMatch{Methods: []string{"GET", "POST"},
 		Paths: []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Exact{Exact: "/foo"}},
 			{PathMatch: &proto.HTTPMatch_PathMatch_Prefix{Prefix: "/bar"}},
-		}},
+		},
+		Headers: []*proto.HTTPMatch_HeaderMatch{
+			{Name: "x-tenant", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Exact{Exact: "a"}},
+			{Name: "x-version", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Prefix{Prefix: "v1."}},
+			{Name: "user-agent", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Regex{Regex: "curl/.*"}},
+			{Name: "authorization", HeaderMatch: &proto.HTTPMatch_HeaderMatch_Present{Present: true}},
+		},
+		Hosts: []string{"*.example.com"},
+		Grpc:  &proto.HTTPMatch_GRPCMatch{Service: "helloworld.Greeter", Methods: []string{"SayHello"}},
+	},
 
 	Metadata: &proto.RuleMetadata{Annotations: map[string]string{"key": "value"}},
 }
@@ -212,6 +230,33 @@ var _ = DescribeTable("ParsedRulesToProtoRules",
 				NotIcmpType: 11,
 			},
 		}),
+	Entry("ICMP type list rule",
+		ParsedRule{
+			ICMPTypes:    []v3.ICMPTypeCode{{Type: 0}, {Type: 3, Code: &icmpCode12}, {Type: 8}},
+			NotICMPTypes: []v3.ICMPTypeCode{{Type: 10}},
+		},
+		&proto.Rule{
+			Icmp: &proto.Rule_IcmpTypesAndCodes{
+				IcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+					Types:     []int32{0, 8},
+					TypeCodes: []*proto.IcmpTypeAndCode{{Type: 3, Code: 12}},
+				},
+			},
+			NotIcmp: &proto.Rule_NotIcmpTypesAndCodes{
+				NotIcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+					Types: []int32{10},
+				},
+			},
+		}),
+	Entry("audit rule",
+		ParsedRule{
+			Action: "deny",
+			Audit:  true,
+		},
+		&proto.Rule{
+			Action: "deny",
+			Audit:  true,
+		}),
 	Entry("Service match rule",
 		ParsedRule{
 			DstIPPortSetIDs: []string{"ipPortSetID"},
@@ -16,8 +16,10 @@ package calc
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 	log "github.com/sirupsen/logrus"
 
@@ -100,6 +102,9 @@ type IPSetData struct {
 	// Type of the ip set to represent for this service. This allows us to create service
 	// IP sets with and without port information.
 	ServiceIncludePorts bool
+	// The sorted, lower-case domain names that this IP set represents.  The members of a domain
+	// IP set are the domain names themselves; the dataplane resolves them to IPs.
+	Domains []string
 	// cachedUID holds the calculated unique ID of this IP set, or "" if it hasn't been calculated
 	// yet.
 	cachedUID string
@@ -119,13 +124,19 @@ func (d *IPSetData) String() string {
 	if d.ServiceIncludePorts {
 		parts = append(parts, "serviceIncludePorts=true")
 	}
+	if len(d.Domains) > 0 {
+		parts = append(parts, fmt.Sprintf("domains:%v", d.Domains))
+	}
 	parts = append(parts, fmt.Sprintf("uniqueID:%q", d.UniqueID()))
 	return "IPSetData{" + strings.Join(parts, ", ") + "}"
 }
 
 func (d *IPSetData) UniqueID() string {
 	if d.cachedUID == "" {
-		if d.Service != "" {
+		if len(d.Domains) > 0 {
+			// Domain based IP set.
+			d.cachedUID = hash.MakeUniqueID("d", strings.Join(d.Domains, ","))
+		} else if d.Service != "" {
 			// Service based IP set.
 			if d.ServiceIncludePorts {
 				// Service IP set including its ports
@@ -191,6 +202,8 @@ func (rs *RuleScanner) OnPolicyActive(key model.PolicyKey, policy *model.Policy)
 		policy.Namespace,
 		selector.Normalise(policy.Selector),
 	)
+	parsedRules.Staged = policy.Staged
+	parsedRules.Generation = policy.Generation
 	rs.RulesUpdateCallbacks.OnPolicyActive(key, parsedRules)
 }
 
@@ -311,6 +324,12 @@ type ParsedRules struct {
 	PreDNAT bool
 
 	OriginalSelector string
+
+	// Staged is true if these rules come from a staged policy, which must not affect traffic.
+	Staged bool
+
+	// Generation is the generation of the policy resource, used for policy status reporting.
+	Generation int64
 }
 
 // ParsedRule is like a backend.model.Rule, except the selector matches and named ports are
@@ -330,6 +349,7 @@ type ParsedRule struct {
 	DstNamedPortIPSetIDs []string
 	ICMPType             *int
 	ICMPCode             *int
+	ICMPTypes            []v3.ICMPTypeCode
 	SrcIPSetIDs          []string
 	DstIPSetIDs          []string
 	DstIPPortSetIDs      []string
@@ -343,6 +363,7 @@ type ParsedRule struct {
 	NotDstNamedPortIPSetIDs []string
 	NotICMPType             *int
 	NotICMPCode             *int
+	NotICMPTypes            []v3.ICMPTypeCode
 	NotSrcIPSetIDs          []string
 	NotDstIPSetIDs          []string
 
@@ -368,6 +389,10 @@ type ParsedRule struct {
 	// does not implement the match, but other dataplanes such as Dikastes do.
 	HTTPMatch *model.HTTPMatch
 
+	// Audit is passed through so that the dataplane can emit an audit record for connections that
+	// match the rule.
+	Audit bool
+
 	Metadata *model.RuleMetadata
 }
 
@@ -443,6 +468,11 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		srcSelIPSets = append(srcSelIPSets, &IPSetData{Service: svc, ServiceIncludePorts: false})
 	}
 
+	// And the domain IP set, which the dataplane populates from the DNS responses it sees.
+	if len(rule.DstDomains) > 0 {
+		dstSelIPSets = append(dstSelIPSets, &IPSetData{Domains: normaliseDomains(rule.DstDomains)})
+	}
+
 	parsedRule = &ParsedRule{
 		Action: rule.Action,
 
@@ -461,8 +491,9 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		DstIPSetIDs:          ipSetsToUIDs(dstSelIPSets),
 		DstIPPortSetIDs:      ipSetsToUIDs(dstIPPortSets),
 
-		ICMPType: rule.ICMPType,
-		ICMPCode: rule.ICMPCode,
+		ICMPType:  rule.ICMPType,
+		ICMPCode:  rule.ICMPCode,
+		ICMPTypes: rule.ICMPTypes,
 
 		NotProtocol: rule.NotProtocol,
 
@@ -476,8 +507,9 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		NotDstNamedPortIPSetIDs: ipSetsToUIDs(notDstNamedPortIPSets),
 		NotDstIPSetIDs:          ipSetsToUIDs(notDstSelIPSets),
 
-		NotICMPType: rule.NotICMPType,
-		NotICMPCode: rule.NotICMPCode,
+		NotICMPType:  rule.NotICMPType,
+		NotICMPCode:  rule.NotICMPCode,
+		NotICMPTypes: rule.NotICMPTypes,
 
 		// Pass through original values of some fields for the policy API.
 		OriginalSrcSelector:               rule.OriginalSrcSelector,
@@ -495,6 +527,7 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		OriginalDstService:                rule.DstService,
 		OriginalDstServiceNamespace:       rule.DstServiceNamespace,
 		HTTPMatch:                         rule.HTTPMatch,
+		Audit:                             rule.Audit,
 
 		// Pass through metadata (used by iptables backend)
 		Metadata: rule.Metadata,
@@ -513,6 +546,18 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 	return
 }
 
+// normaliseDomains returns a sorted, de-duplicated, lower-case copy of the given domain names so
+// that equivalent rules share an IP set.
+func normaliseDomains(domains []string) []string {
+	s := set.New[string]()
+	for _, d := range domains {
+		s.Add(strings.ToLower(d))
+	}
+	out := s.Slice()
+	sort.Strings(out)
+	return out
+}
+
 // Converts a list of named ports to a list of IPSets.
 func namedPortsToIPSets(namedPorts []string, positiveSelectors []selector.Selector, proto labelindex.IPSetPortProtocol) []*IPSetData {
 	var ipSets []*IPSetData
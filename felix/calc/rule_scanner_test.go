@@ -162,6 +162,13 @@ var _ = DescribeTable("RuleScanner rule conversion should generate correct Parse
 			OriginalSrcServiceNamespace: "default",
 		}),
 
+	// Domains.
+	Entry("dest domains",
+		model.Rule{DstDomains: []string{"example.com", "*.Example.org", "EXAMPLE.com"}},
+		ParsedRule{
+			DstIPSetIDs: []string{"d:Om0q3mmRr1Op-taIi4KPI490cp0zFvwpn7xhVQ"},
+		}),
+
 	// Selectors.
 	Entry("source selector", model.Rule{SrcSelector: sel1}, ParsedRule{SrcIPSetIDs: []string{sel1ID}}),
 	Entry("dest selector", model.Rule{DstSelector: sel1}, ParsedRule{DstIPSetIDs: []string{sel1ID}}),
@@ -237,6 +244,10 @@ var _ = Describe("ParsedRule", func() {
 				// as either IPPortIPSetIDs or IPSetIDs.
 				continue
 			}
+			if name == "DstDomains" {
+				// Domains are rendered on the ParsedRule as IPSetIDs.
+				continue
+			}
 			if strings.HasSuffix(name, "Net") {
 				// Deprecated XXXNet fields.
 				continue
@@ -317,7 +328,7 @@ func (ur *scanUpdateRecorder) OnProfileInactive(key model.ProfileRulesKey) {
 }
 
 func (ur *scanUpdateRecorder) ipSetActive(ipSet *IPSetData) {
-	if ipSet.Service != "" {
+	if ipSet.Service != "" || len(ipSet.Domains) > 0 {
 		// Not a selector-based set.
 		return
 	}
@@ -325,7 +336,7 @@ func (ur *scanUpdateRecorder) ipSetActive(ipSet *IPSetData) {
 }
 
 func (ur *scanUpdateRecorder) ipSetInactive(ipSet *IPSetData) {
-	if ipSet.Service != "" {
+	if ipSet.Service != "" || len(ipSet.Domains) > 0 {
 		// Not a selector-based set.
 		return
 	}
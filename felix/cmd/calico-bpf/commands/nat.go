@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"strconv"
@@ -28,6 +29,7 @@ import (
 )
 
 func init() {
+	natDumpCmd.Flags().Bool("stats", false, "show the traffic counters of frontends and backends")
 	natCmd.AddCommand(natDumpCmd)
 	natCmd.AddCommand(natAffDumpCmd)
 
@@ -96,6 +98,13 @@ func dumpAff(cmd *cobra.Command) (err error) {
 }
 
 func dump(cmd *cobra.Command) error {
+	showStats, err := cmd.Flags().GetBool("stats")
+	if err != nil {
+		return err
+	}
+
+	var stats nat.StatsMapMem
+
 	if ipv6 != nil && *ipv6 {
 		natMap, err := nat.LoadFrontendMapV6(nat.FrontendMapV6())
 		if err != nil {
@@ -107,7 +116,13 @@ func dump(cmd *cobra.Command) error {
 			return err
 		}
 
-		dumpNice[nat.FrontendKeyV6, nat.BackendValueV6](cmd.Printf, natMap, back)
+		if showStats {
+			if stats, err = nat.LoadStatsMapV6(nat.StatsMapV6()); err != nil {
+				return err
+			}
+		}
+
+		dumpNice[nat.FrontendKeyV6, nat.BackendValueV6](cmd.Printf, natMap, back, stats)
 	} else {
 		natMap, err := nat.LoadFrontendMap(nat.FrontendMap())
 		if err != nil {
@@ -119,15 +134,78 @@ func dump(cmd *cobra.Command) error {
 			return err
 		}
 
-		dumpNice[nat.FrontendKey, nat.BackendValue](cmd.Printf, natMap, back)
+		if showStats {
+			if stats, err = nat.LoadStatsMap(nat.StatsMap()); err != nil {
+				return err
+			}
+		}
+
+		dumpNice[nat.FrontendKey, nat.BackendValue](cmd.Printf, natMap, back, stats)
 	}
 	return nil
 }
 
 type printfFn func(format string, i ...interface{})
 
+type natStatsFrontend struct {
+	addr  string
+	port  uint16
+	proto uint8
+}
+
+func isNodePortWildcard(addr net.IP) bool {
+	for _, b := range addr {
+		if b != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// natStatsByFrontend groups the entries of the stats map by frontend and then by
+// backend "ip:port". Entries whose frontend is not in the NAT map are for
+// nodeports and we group them under the nodeport wildcard frontend.
+func natStatsByFrontend[FK nat.FrontendKeyComparable](natMap map[FK]nat.FrontendValue,
+	stats nat.StatsMapMem) map[natStatsFrontend]map[string]nat.StatsValue {
+
+	frontends := make(map[natStatsFrontend]struct{}, len(natMap))
+	for nk := range natMap {
+		frontends[natStatsFrontend{nk.Addr().String(), nk.Port(), nk.Proto()}] = struct{}{}
+	}
+
+	ret := make(map[natStatsFrontend]map[string]nat.StatsValue)
+	for k, v := range stats {
+		fe := natStatsFrontend{k.FrontendAddr().String(), k.FrontendPort(), k.Proto()}
+		if _, ok := frontends[fe]; !ok {
+			if k.FrontendAddr().To4() != nil {
+				fe.addr = net.IPv4bcast.String()
+			} else {
+				fe.addr = net.IP(bytes.Repeat([]byte{0xff}, 16)).String()
+			}
+		}
+
+		backends := ret[fe]
+		if backends == nil {
+			backends = make(map[string]nat.StatsValue)
+			ret[fe] = backends
+		}
+		be := net.JoinHostPort(k.BackendAddr().String(), strconv.Itoa(int(k.BackendPort())))
+		bv := backends[be]
+		bv.Add(v)
+		backends[be] = bv
+	}
+
+	return ret
+}
+
 func dumpNice[FK nat.FrontendKeyComparable, BV nat.BackendValueInterface](printf printfFn,
-	natMap map[FK]nat.FrontendValue, back map[nat.BackendKey]BV) {
+	natMap map[FK]nat.FrontendValue, back map[nat.BackendKey]BV, stats nat.StatsMapMem) {
+
+	var feStats map[natStatsFrontend]map[string]nat.StatsValue
+	if stats != nil {
+		feStats = natStatsByFrontend(natMap, stats)
+	}
+
 	for nk, nv := range natMap {
 		valCount := nv.Count()
 		count := int(valCount)
@@ -140,6 +218,18 @@ func dumpNice[FK nat.FrontendKeyComparable, BV nat.BackendValueInterface](printf
 		if flags != "" {
 			flags = " flags " + flags
 		}
+		var backendStats map[string]nat.StatsValue
+		if feStats != nil {
+			backendStats = feStats[natStatsFrontend{nk.Addr().String(), nk.Port(), nk.Proto()}]
+			var total nat.StatsValue
+			for _, v := range backendStats {
+				total.Add(v)
+			}
+			if isNodePortWildcard(nk.Addr()) {
+				flags += " (nodeport)"
+			}
+			flags += " " + total.String()
+		}
 		printf("%s port %d proto %d id %d count %d local %d%s\n",
 			nk.Addr(), nk.Port(), nk.Proto(), id, count, local, flags)
 		for i := 0; i < count; i++ {
@@ -149,12 +239,17 @@ func dumpNice[FK nat.FrontendKeyComparable, BV nat.BackendValueInterface](printf
 			if !ok {
 				printf("is missing\n")
 			} else {
-				fmtStr := "%s:%d\n"
+				fmtStr := "%s:%d"
 				// Use "[]" with IPv6 addresses
 				if bv.Addr().To4() == nil {
-					fmtStr = "[%s]:%d\n"
+					fmtStr = "[%s]:%d"
 				}
 				printf(fmtStr, bv.Addr(), bv.Port())
+				if feStats != nil {
+					be := net.JoinHostPort(bv.Addr().String(), strconv.Itoa(int(bv.Port())))
+					printf(" %s", backendStats[be])
+				}
+				printf("\n")
 			}
 		}
 	}
@@ -17,6 +17,7 @@ package commands
 import (
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 
 	nat2 "github.com/projectcalico/calico/felix/bpf/nat"
@@ -35,5 +36,39 @@ func TestNATDump(t *testing.T) {
 		nat2.NewNATBackendKey(108, 0): nat2.NewNATBackendValue(net.IPv4(3, 3, 3, 3), 553),
 	}
 
-	dumpNice(func(format string, i ...interface{}) { fmt.Printf(format, i...) }, nat, back)
+	dumpNice(func(format string, i ...interface{}) { fmt.Printf(format, i...) }, nat, back, nil)
+}
+
+func TestNATDumpStats(t *testing.T) {
+	nat := nat2.MapMem{
+		nat2.NewNATKey(net.IPv4(1, 1, 1, 1), 80, 6): nat2.NewNATValue(35, 2, 0, 0),
+	}
+
+	back := nat2.BackendMapMem{
+		nat2.NewNATBackendKey(35, 0): nat2.NewNATBackendValue(net.IPv4(5, 5, 5, 5), 8080),
+		nat2.NewNATBackendKey(35, 1): nat2.NewNATBackendValue(net.IPv4(6, 6, 6, 6), 8080),
+	}
+
+	stats := nat2.StatsMapMem{
+		nat2.NewStatsKey(net.IPv4(1, 1, 1, 1), 80, net.IPv4(5, 5, 5, 5), 8080, 6): {
+			PacketsToBackend: 10, BytesToBackend: 1000, PacketsFromBackend: 8, BytesFromBackend: 800, Conns: 1,
+		},
+		nat2.NewStatsKey(net.IPv4(1, 1, 1, 1), 80, net.IPv4(6, 6, 6, 6), 8080, 6): {
+			PacketsToBackend: 5, BytesToBackend: 500, Conns: 1, CTLBConns: 1,
+		},
+		nat2.NewStatsKey(net.IPv4(1, 1, 1, 1), 80, net.IPv4(7, 7, 7, 7), 8080, 6): {
+			PacketsToBackend: 1, BytesToBackend: 100, Conns: 1,
+		},
+	}
+
+	var out strings.Builder
+	dumpNice(func(format string, i ...interface{}) { fmt.Fprintf(&out, format, i...) }, nat, back, stats)
+
+	expected := "1.1.1.1 port 80 proto 6 id 35 count 2 local 0 " +
+		"to-backend packets 16 bytes 1600 from-backend packets 8 bytes 800 conns 3 ctlb-conns 1\n" +
+		"\t35:0\t 5.5.5.5:8080 to-backend packets 10 bytes 1000 from-backend packets 8 bytes 800 conns 1 ctlb-conns 0\n" +
+		"\t35:1\t 6.6.6.6:8080 to-backend packets 5 bytes 500 from-backend packets 0 bytes 0 conns 1 ctlb-conns 1\n"
+	if out.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", out.String(), expected)
+	}
 }
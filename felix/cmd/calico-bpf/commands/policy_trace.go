@@ -0,0 +1,691 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+
+	"github.com/projectcalico/calico/felix/bpf"
+	"github.com/projectcalico/calico/felix/bpf/conntrack"
+	"github.com/projectcalico/calico/felix/bpf/hook"
+	"github.com/projectcalico/calico/felix/bpf/maps"
+	"github.com/projectcalico/calico/felix/bpf/nat"
+	"github.com/projectcalico/calico/felix/bpf/state"
+	"github.com/projectcalico/calico/felix/bpf/tc"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func init() {
+	policyCmd.AddCommand(policyTraceCmd)
+	policyTraceCmd.Flags().String("proto", "tcp", "Protocol of the packet: tcp, udp or icmp")
+	policyTraceCmd.Flags().String("src-ip", "", "Source IP of the packet")
+	policyTraceCmd.Flags().String("dst-ip", "", "Destination IP of the packet")
+	policyTraceCmd.Flags().Uint16("src-port", 12345, "Source port of the packet")
+	policyTraceCmd.Flags().Uint16("dst-port", 80, "Destination port of the packet")
+	policyTraceCmd.Flags().Bool("keep-conntrack", false,
+		"Keep the conntrack and NAT affinity entries created by the packet instead of removing them")
+}
+
+var policyTraceCmd = &cobra.Command{
+	Use: "trace <interface> <hook> --src-ip=<ip> --dst-ip=<ip>\n" +
+		"\n\thook - can be 'ingress' or 'egress'.",
+	Short: "simulates a packet through the programs attached to an interface",
+	Long: "Runs a synthetic packet through the tc programs attached to an interface and prints\n" +
+		"the verdict, the NAT that was applied, the conntrack result, the policy result and the\n" +
+		"policy rules that the packet matched, as the programs recorded them.\n" +
+		"\n" +
+		"The packet is processed by the live programs and maps.  The conntrack entries and the\n" +
+		"NAT affinity entry that it created are removed afterwards unless --keep-conntrack is\n" +
+		"set.  Its other side effects stay: it is accounted in the counters of the interface,\n" +
+		"of the rules and, if enabled, of the service, and it may add an entry to the ARP map.\n" +
+		"If the programs were loaded with debug logging, their log of the packet is in the\n" +
+		"trace pipe.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := policyTrace(cmd, args); err != nil {
+			log.WithError(err).Error("Failed to trace packet.")
+		}
+	},
+}
+
+type tracePacket struct {
+	proto   uint8
+	srcIP   net.IP
+	dstIP   net.IP
+	srcPort uint16
+	dstPort uint16
+}
+
+func (p tracePacket) hasPorts() bool {
+	return p.proto == 6 || p.proto == 17
+}
+
+func (p tracePacket) String() string {
+	if p.hasPorts() {
+		return fmt.Sprintf("%s %s -> %s", protoStr(p.proto),
+			net.JoinHostPort(p.srcIP.String(), strconv.Itoa(int(p.srcPort))),
+			net.JoinHostPort(p.dstIP.String(), strconv.Itoa(int(p.dstPort))))
+	}
+	return fmt.Sprintf("%s %s -> %s", protoStr(p.proto), p.srcIP, p.dstIP)
+}
+
+func parseTracePacket(cmd *cobra.Command, v6 bool) (tracePacket, error) {
+	var p tracePacket
+
+	protoName, _ := cmd.Flags().GetString("proto")
+	switch strings.ToLower(protoName) {
+	case "tcp":
+		p.proto = 6
+	case "udp":
+		p.proto = 17
+	case "icmp":
+		p.proto = 1
+		if v6 {
+			p.proto = 58
+		}
+	default:
+		return p, fmt.Errorf("unsupported protocol %q", protoName)
+	}
+
+	for flag, ip := range map[string]*net.IP{"src-ip": &p.srcIP, "dst-ip": &p.dstIP} {
+		s, _ := cmd.Flags().GetString(flag)
+		*ip = net.ParseIP(s)
+		if *ip == nil {
+			return p, fmt.Errorf("invalid --%s %q", flag, s)
+		}
+		if (ip.To4() == nil) != v6 {
+			return p, fmt.Errorf("--%s %s does not match the IP version", flag, s)
+		}
+	}
+
+	if p.hasPorts() {
+		p.srcPort, _ = cmd.Flags().GetUint16("src-port")
+		p.dstPort, _ = cmd.Flags().GetUint16("dst-port")
+	}
+
+	return p, nil
+}
+
+// buildTracePacket serializes p as an ethernet frame.  TCP packets are SYNs and
+// ICMP packets are echo requests so that they start a new connection.
+func buildTracePacket(p tracePacket, srcMAC, dstMAC net.HardwareAddr) ([]byte, error) {
+	eth := &layers.Ethernet{
+		SrcMAC:       srcMAC,
+		DstMAC:       dstMAC,
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+
+	var (
+		ipLayer  gopacket.NetworkLayer
+		ipSerial gopacket.SerializableLayer
+	)
+	if p.srcIP.To4() != nil {
+		ip := &layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Flags:    layers.IPv4DontFragment,
+			SrcIP:    p.srcIP.To4(),
+			DstIP:    p.dstIP.To4(),
+			Protocol: layers.IPProtocol(p.proto),
+		}
+		ipLayer, ipSerial = ip, ip
+	} else {
+		eth.EthernetType = layers.EthernetTypeIPv6
+		ip := &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			SrcIP:      p.srcIP,
+			DstIP:      p.dstIP,
+			NextHeader: layers.IPProtocol(p.proto),
+		}
+		ipLayer, ipSerial = ip, ip
+	}
+
+	payload := gopacket.Payload([]byte("calico-bpf policy trace"))
+	pktLayers := []gopacket.SerializableLayer{eth, ipSerial}
+
+	switch p.proto {
+	case 6:
+		l4 := &layers.TCP{
+			SrcPort: layers.TCPPort(p.srcPort),
+			DstPort: layers.TCPPort(p.dstPort),
+			SYN:     true,
+			Window:  64240,
+		}
+		_ = l4.SetNetworkLayerForChecksum(ipLayer)
+		pktLayers = append(pktLayers, l4)
+	case 17:
+		l4 := &layers.UDP{
+			SrcPort: layers.UDPPort(p.srcPort),
+			DstPort: layers.UDPPort(p.dstPort),
+		}
+		_ = l4.SetNetworkLayerForChecksum(ipLayer)
+		pktLayers = append(pktLayers, l4)
+	case 1:
+		pktLayers = append(pktLayers, &layers.ICMPv4{
+			TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0),
+			Id:       1,
+			Seq:      1,
+		})
+	case 58:
+		l4 := &layers.ICMPv6{
+			TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeEchoRequest, 0),
+		}
+		_ = l4.SetNetworkLayerForChecksum(ipLayer)
+		pktLayers = append(pktLayers, l4, &layers.ICMPv6Echo{Identifier: 1, SeqNumber: 1})
+	}
+	pktLayers = append(pktLayers, payload)
+
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true},
+		pktLayers...)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parseTraceOutput returns the packet that the program produced and, if the
+// program encapsulated it, the outer packet.
+func parseTraceOutput(data []byte) (pkt tracePacket, outer *tracePacket, err error) {
+	var ips []tracePacket
+
+	for _, l := range gopacket.NewPacket(data, layers.LayerTypeEthernet, gopacket.Default).Layers() {
+		switch l := l.(type) {
+		case *layers.IPv4:
+			ips = append(ips, tracePacket{proto: uint8(l.Protocol), srcIP: l.SrcIP, dstIP: l.DstIP})
+		case *layers.IPv6:
+			ips = append(ips, tracePacket{proto: uint8(l.NextHeader), srcIP: l.SrcIP, dstIP: l.DstIP})
+		case *layers.TCP:
+			if len(ips) > 0 {
+				ips[len(ips)-1].srcPort, ips[len(ips)-1].dstPort = uint16(l.SrcPort), uint16(l.DstPort)
+			}
+		case *layers.UDP:
+			if len(ips) > 0 {
+				ips[len(ips)-1].srcPort, ips[len(ips)-1].dstPort = uint16(l.SrcPort), uint16(l.DstPort)
+			}
+		}
+	}
+
+	switch len(ips) {
+	case 0:
+		return pkt, nil, errors.New("no IP header in the output packet")
+	case 1:
+		return ips[0], nil, nil
+	default:
+		return ips[len(ips)-1], &ips[0], nil
+	}
+}
+
+func tcVerdictStr(rc int32) string {
+	switch rc {
+	case -1:
+		return "TC_ACT_UNSPEC (continue with the next program)"
+	case 0:
+		return "TC_ACT_OK (allow)"
+	case 2:
+		return "TC_ACT_SHOT (drop)"
+	case 7:
+		return "TC_ACT_REDIRECT (allow, redirected to another interface)"
+	}
+	return fmt.Sprintf("unknown (%d)", rc)
+}
+
+// skbCtx returns a struct __sk_buff with only the fields that BPF_PROG_TEST_RUN
+// accepts from userspace set.
+func skbCtx(ifindex int, ingress bool) []byte {
+	const (
+		skbIngressIfindexOffset = 36
+		skbIfindexOffset        = 40
+		skbCtxSize              = 44
+	)
+	ctx := make([]byte, skbCtxSize)
+	if ingress {
+		binary.LittleEndian.PutUint32(ctx[skbIngressIfindexOffset:], uint32(ifindex))
+	}
+	binary.LittleEndian.PutUint32(ctx[skbIfindexOffset:], uint32(ifindex))
+	return ctx
+}
+
+type traceRule struct {
+	matchID uint64
+	tier    string
+	policy  string
+	rule    int
+}
+
+func (r traceRule) String() string {
+	s := fmt.Sprintf("policy %s rule %d", r.policy, r.rule)
+	if r.tier != "" {
+		s = fmt.Sprintf("tier %s %s", r.tier, s)
+	}
+	return fmt.Sprintf("%s (match ID %d)", s, r.matchID)
+}
+
+// policyRules returns the rules of the policy program in the order in which
+// the program evaluates them, based on the comments of the program.
+func policyRules(policyDbg bpf.PolicyDebugInfo) []traceRule {
+	var rules []traceRule
+
+	var cur traceRule
+	for _, insn := range policyDbg.PolicyInfo {
+		for _, comment := range insn.Comments {
+			switch {
+			case strings.HasPrefix(comment, "Start of tier "):
+				cur.tier = strings.TrimPrefix(comment, "Start of tier ")
+			case strings.HasPrefix(comment, "Start of policy "):
+				cur.policy = strings.TrimPrefix(comment, "Start of policy ")
+				cur.rule = -1
+			case strings.HasPrefix(comment, "Start of rule "):
+				cur.rule++
+			case strings.Contains(comment, "Rule MatchID"):
+				cur.matchID = getRuleMatchID(comment)
+				rules = append(rules, cur)
+			}
+		}
+	}
+
+	return rules
+}
+
+func loadPolicyDebugInfo(iface string, h hook.Hook, v6 bool) (bpf.PolicyDebugInfo, error) {
+	var policyDbg bpf.PolicyDebugInfo
+
+	family := proto.IPVersion_IPV4
+	if v6 {
+		family = proto.IPVersion_IPV6
+	}
+	data, err := os.ReadFile(bpf.PolicyDebugJSONFileName(iface, h.String(), family))
+	if err != nil {
+		return policyDbg, err
+	}
+	err = json.Unmarshal(data, &policyDbg)
+	return policyDbg, err
+}
+
+// traceCTKeys returns the keys under which the conntrack entry of p can be
+// created.  The programs order the legs of a key by address and port, so p can
+// be on either side.
+func traceCTKeys(p tracePacket, v6 bool) [][]byte {
+	if v6 {
+		return [][]byte{
+			conntrack.NewKeyV6(p.proto, p.srcIP, p.srcPort, p.dstIP, p.dstPort).AsBytes(),
+			conntrack.NewKeyV6(p.proto, p.dstIP, p.dstPort, p.srcIP, p.srcPort).AsBytes(),
+		}
+	}
+	return [][]byte{
+		conntrack.NewKey(p.proto, p.srcIP, p.srcPort, p.dstIP, p.dstPort).AsBytes(),
+		conntrack.NewKey(p.proto, p.dstIP, p.dstPort, p.srcIP, p.srcPort).AsBytes(),
+	}
+}
+
+// traceAffinityKey returns the key of the NAT affinity entry that the
+// programs create if p is sent to a service with session affinity.
+func traceAffinityKey(p tracePacket, v6 bool) []byte {
+	if v6 {
+		return nat.NewAffinityKeyV6(p.srcIP, nat.NewNATKeyV6(p.dstIP, p.dstPort, p.proto)).AsBytes()
+	}
+	return nat.NewAffinityKey(p.srcIP, nat.NewNATKey(p.dstIP, p.dstPort, p.proto)).AsBytes()
+}
+
+func mapHasKey(m maps.Map, k []byte) (bool, error) {
+	_, err := m.Get(k)
+	if err == nil {
+		return true, nil
+	}
+	if maps.IsNotExists(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// runOnOneCPU runs f with the calling thread pinned to a single CPU, which it
+// passes to f.  BPF_PROG_TEST_RUN runs the program on the CPU of the caller, so
+// that is the slot of the per-CPU maps that the program used.
+func runOnOneCPU(f func(cpu int) error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var orig unix.CPUSet
+	if err := unix.SchedGetaffinity(0, &orig); err != nil {
+		return errors.WithMessage(err, "failed to get CPU affinity")
+	}
+	cpu := -1
+	for i := 0; i < maps.NumPossibleCPUs(); i++ {
+		if orig.IsSet(i) {
+			cpu = i
+			break
+		}
+	}
+	if cpu < 0 {
+		return errors.New("no CPU to run on")
+	}
+
+	var set unix.CPUSet
+	set.Set(cpu)
+	if err := unix.SchedSetaffinity(0, &set); err != nil {
+		return errors.WithMessage(err, "failed to set CPU affinity")
+	}
+	defer func() {
+		if err := unix.SchedSetaffinity(0, &orig); err != nil {
+			log.WithError(err).Warn("Failed to restore CPU affinity.")
+		}
+	}()
+
+	return f(cpu)
+}
+
+// readTraceState returns the state that the programs left on the given CPU.
+func readTraceState(cpu int) (state.State, error) {
+	m := state.Map()
+	if err := m.Open(); err != nil {
+		return state.State{}, errors.WithMessage(err, "failed to open state map")
+	}
+	defer m.Close()
+
+	v, err := m.Get(make([]byte, 4))
+	if err != nil {
+		return state.State{}, errors.WithMessage(err, "failed to read state map")
+	}
+	size := state.MapParameters.ValueSize
+	if len(v) < (cpu+1)*size {
+		return state.State{}, fmt.Errorf("no state for CPU %d", cpu)
+	}
+	return state.StateFromBytes(v[cpu*size : (cpu+1)*size]), nil
+}
+
+func stateSrcIP(st state.State, v6 bool) net.IP {
+	words := []uint32{st.SrcAddr}
+	if v6 {
+		words = append(words, st.SrcAddr1, st.SrcAddr2, st.SrcAddr3)
+	}
+	ip := make(net.IP, 4*len(words))
+	for i, w := range words {
+		binary.LittleEndian.PutUint32(ip[4*i:], w)
+	}
+	return ip
+}
+
+// stateIsOf returns whether st is the state of p rather than of a packet that
+// the kernel processed on the same CPU after the run.
+func stateIsOf(st state.State, p tracePacket, v6 bool) bool {
+	if !stateSrcIP(st, v6).Equal(p.srcIP) {
+		return false
+	}
+	return !p.hasPorts() || st.SrcPort == p.srcPort
+}
+
+func ctResultStr(flags uint32) string {
+	var s string
+	switch flags & 0xff {
+	case 0:
+		s = "new connection"
+	case 1:
+		s = "mid-flow miss"
+	case 2:
+		s = "established"
+	case 3:
+		s = "established, approved on both sides"
+	case 4:
+		s = "established, reply of a NATed connection"
+	case 5:
+		s = "established, request of a NATed connection"
+	case 6:
+		s = "invalid"
+	default:
+		s = fmt.Sprintf("unknown (%d)", flags&0xff)
+	}
+	if flags&0x100 != 0 {
+		s += ", related"
+	}
+	if flags&0x200 != 0 {
+		s += ", RPF check failed"
+	}
+	return s
+}
+
+func policyResultStr(st state.State) string {
+	const stSkipPolicy = 0x20
+
+	if st.Flags&stSkipPolicy != 0 {
+		return "skipped, the packet is allowed without policy"
+	}
+	switch st.ConntrackRCFlags & 0xff {
+	case 2, 3, 4, 5:
+		return "not evaluated, the packet belongs to a known connection"
+	}
+	switch st.PolicyRC {
+	case state.PolicyNoMatch:
+		return "no match, or policy was not reached"
+	case state.PolicyAllow:
+		return "allow"
+	case state.PolicyDeny:
+		return "deny"
+	case state.PolicyReject:
+		return "reject"
+	case state.PolicyTailCallFailed:
+		return "failed to jump to the policy program"
+	}
+	return fmt.Sprintf("unknown (%d)", st.PolicyRC)
+}
+
+func policyTrace(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("invalid number of arguments: %d", len(args))
+	}
+	iface, h := args[0], hook.StringToHook(args[1])
+	if h != hook.Ingress && h != hook.Egress {
+		return fmt.Errorf("invalid hook: '%s'", args[1])
+	}
+	v6 := ipv6 != nil && *ipv6
+
+	pkt, err := parseTracePacket(cmd, v6)
+	if err != nil {
+		return err
+	}
+	keepCT, _ := cmd.Flags().GetBool("keep-conntrack")
+
+	intf, err := net.InterfaceByName(iface)
+	if err != nil {
+		return err
+	}
+
+	// Frames at the ingress hook were sent to the interface, frames at the
+	// egress hook are sent from it.
+	srcMAC, dstMAC := net.HardwareAddr{0xee, 0xee, 0xee, 0xee, 0xee, 0xee}, intf.HardwareAddr
+	if h == hook.Egress {
+		srcMAC, dstMAC = dstMAC, srcMAC
+	}
+	if len(srcMAC) != 6 {
+		srcMAC = make(net.HardwareAddr, 6)
+	}
+	if len(dstMAC) != 6 {
+		dstMAC = make(net.HardwareAddr, 6)
+	}
+	data, err := buildTracePacket(pkt, srcMAC, dstMAC)
+	if err != nil {
+		return errors.WithMessage(err, "failed to build packet")
+	}
+
+	ap := &tc.AttachPoint{AttachPoint: bpf.AttachPoint{Iface: iface, Hook: h}}
+	progID, err := ap.ProgramID()
+	if err != nil {
+		return err
+	}
+	progFD, err := bpf.GetProgFDByID(progID)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to get program %d", progID)
+	}
+	defer progFD.Close()
+
+	ctMap, affMap := conntrack.Map(), nat.AffinityMap()
+	if v6 {
+		ctMap, affMap = conntrack.MapV6(), nat.AffinityMapV6()
+	}
+	if err := ctMap.Open(); err != nil {
+		return errors.WithMessage(err, "failed to open conntrack map")
+	}
+	defer ctMap.Close()
+	if err := affMap.Open(); err != nil {
+		return errors.WithMessage(err, "failed to open NAT affinity map")
+	}
+	defer affMap.Close()
+
+	// Only the entries that did not exist before the run are the packet's own,
+	// the others belong to a connection that it collided with.
+	ctKeys := traceCTKeys(pkt, v6)
+	ctExisted := make([]bool, len(ctKeys))
+	for i, k := range ctKeys {
+		if ctExisted[i], err = mapHasKey(ctMap, k); err != nil {
+			return errors.WithMessage(err, "failed to read conntrack map")
+		}
+	}
+	affKey := traceAffinityKey(pkt, v6)
+	affExisted, err := mapHasKey(affMap, affKey)
+	if err != nil {
+		return errors.WithMessage(err, "failed to read NAT affinity map")
+	}
+
+	var (
+		res bpf.ProgResult
+		st  state.State
+	)
+	err = runOnOneCPU(func(cpu int) error {
+		var err error
+		res, err = bpf.RunBPFProgramWithCtx(progFD, data, skbCtx(intf.Index, h == hook.Ingress), 1)
+		if err != nil {
+			return errors.WithMessage(err, "failed to run program")
+		}
+		st, err = readTraceState(cpu)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("Packet: %s on %s %s\n", pkt, iface, h)
+	cmd.Printf("Verdict: %s\n", tcVerdictStr(res.RC))
+	cmd.Printf("Duration: %s\n", res.Duration)
+
+	if out, outer, err := parseTraceOutput(res.DataOut); err != nil {
+		cmd.Printf("Output: %s\n", err)
+	} else {
+		if !out.srcIP.Equal(pkt.srcIP) || out.srcPort != pkt.srcPort {
+			cmd.Printf("SNAT: %s -> %s\n",
+				net.JoinHostPort(pkt.srcIP.String(), strconv.Itoa(int(pkt.srcPort))),
+				net.JoinHostPort(out.srcIP.String(), strconv.Itoa(int(out.srcPort))))
+		}
+		if !out.dstIP.Equal(pkt.dstIP) || out.dstPort != pkt.dstPort {
+			cmd.Printf("DNAT: %s -> %s\n",
+				net.JoinHostPort(pkt.dstIP.String(), strconv.Itoa(int(pkt.dstPort))),
+				net.JoinHostPort(out.dstIP.String(), strconv.Itoa(int(out.dstPort))))
+		}
+		if outer != nil {
+			cmd.Printf("Encapsulated: %s\n", outer)
+		}
+	}
+
+	if !stateIsOf(st, pkt, v6) {
+		cmd.Println("State: overwritten by another packet before it was read, run the trace again")
+	} else {
+		cmd.Printf("Conntrack result: %s\n", ctResultStr(st.ConntrackRCFlags))
+		cmd.Printf("Policy: %s\n", policyResultStr(st))
+		printTraceRules(cmd, iface, h, v6, st)
+	}
+
+	keyFromBytes, valFromBytes := conntrack.KeyFromBytes, conntrack.ValueFromBytes
+	if v6 {
+		keyFromBytes, valFromBytes = conntrack.KeyV6FromBytes, conntrack.ValueV6FromBytes
+	}
+	var created [][]byte
+	for i, k := range ctKeys {
+		if ctExisted[i] {
+			continue
+		}
+		v, err := ctMap.Get(k)
+		if err != nil {
+			continue
+		}
+		created = append(created, k)
+		if val := valFromBytes(v); val.Type() == conntrack.TypeNATForward {
+			created = append(created, val.ReverseNATKey().AsBytes())
+		}
+	}
+	cmd.Println("Conntrack:")
+	for _, k := range created {
+		v, err := ctMap.Get(k)
+		if err != nil {
+			continue
+		}
+		cmd.Printf("\t%v -> %v\n", keyFromBytes(k), valFromBytes(v))
+		if !keepCT {
+			if err := ctMap.Delete(k); err != nil && !maps.IsNotExists(err) {
+				log.WithError(err).Warn("Failed to remove conntrack entry of the packet.")
+			}
+		}
+	}
+
+	if !affExisted && !keepCT {
+		if err := affMap.Delete(affKey); err != nil && !maps.IsNotExists(err) {
+			log.WithError(err).Warn("Failed to remove NAT affinity entry of the packet.")
+		}
+	}
+
+	return nil
+}
+
+// printTraceRules prints the rules that the policy program recorded as hit,
+// in the order in which it hit them.
+func printTraceRules(cmd *cobra.Command, iface string, h hook.Hook, v6 bool, st state.State) {
+	hit := int(st.RulesHit)
+	if hit > state.MaxRuleIDs {
+		hit = state.MaxRuleIDs
+	}
+	if hit == 0 {
+		return
+	}
+
+	byID := make(map[uint64]traceRule)
+	if policyDbg, err := loadPolicyDebugInfo(iface, h, v6); err != nil {
+		log.WithError(err).Warn("No policy debug info, showing only the match IDs of the rules.")
+	} else {
+		for _, r := range policyRules(policyDbg) {
+			byID[r.matchID] = r
+		}
+	}
+
+	cmd.Println("Matched rules:")
+	for _, id := range st.RuleIDs[:hit] {
+		if r, ok := byID[id]; ok {
+			cmd.Printf("\t%s\n", r)
+		} else {
+			cmd.Printf("\tmatch ID %d\n", id)
+		}
+	}
+}
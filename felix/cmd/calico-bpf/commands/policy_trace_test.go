@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/projectcalico/calico/felix/bpf"
+	"github.com/projectcalico/calico/felix/bpf/asm"
+	"github.com/projectcalico/calico/felix/bpf/state"
+)
+
+func TestTracePacketRoundTrip(t *testing.T) {
+	macs := []net.HardwareAddr{{1, 2, 3, 4, 5, 6}, {6, 5, 4, 3, 2, 1}}
+
+	for _, p := range []tracePacket{
+		{proto: 6, srcIP: net.IPv4(10, 0, 0, 1), dstIP: net.IPv4(10, 0, 0, 2), srcPort: 12345, dstPort: 80},
+		{proto: 17, srcIP: net.ParseIP("dead::1"), dstIP: net.ParseIP("dead::2"), srcPort: 53, dstPort: 5353},
+		{proto: 1, srcIP: net.IPv4(10, 0, 0, 1), dstIP: net.IPv4(10, 0, 0, 2)},
+	} {
+		data, err := buildTracePacket(p, macs[0], macs[1])
+		if err != nil {
+			t.Fatalf("failed to build %s: %v", p, err)
+		}
+
+		out, outer, err := parseTraceOutput(data)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", p, err)
+		}
+		if outer != nil {
+			t.Errorf("unexpected outer header %s", outer)
+		}
+		if out.String() != p.String() {
+			t.Errorf("parsed %s, expected %s", out, p)
+		}
+	}
+}
+
+func TestPolicyRules(t *testing.T) {
+	dbg := bpf.PolicyDebugInfo{
+		PolicyInfo: asm.Insns{
+			{Comments: []string{"Start of tier default"}},
+			{Comments: []string{"Start of policy default.allow-dns"}},
+			{Comments: []string{"Start of rule action:\"allow\"", "Rule MatchID: 111"}},
+			{Comments: []string{"End of rule abc"}},
+			{Comments: []string{"Start of rule action:\"deny\"", "IPSets x", "Rule MatchID: 222"}},
+			{Comments: []string{"End of policy default.allow-dns"}},
+			{Comments: []string{"Start of policy default.deny-all"}},
+			{Comments: []string{"Start of rule action:\"deny\"", "Rule MatchID: 333"}},
+		},
+	}
+
+	expected := []traceRule{
+		{matchID: 111, tier: "default", policy: "default.allow-dns", rule: 0},
+		{matchID: 222, tier: "default", policy: "default.allow-dns", rule: 1},
+		{matchID: 333, tier: "default", policy: "default.deny-all", rule: 0},
+	}
+
+	rules := policyRules(dbg)
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("got rules %v, expected %v", rules, expected)
+	}
+}
+
+func TestStateIsOf(t *testing.T) {
+	p := tracePacket{proto: 6, srcIP: net.IPv4(10, 0, 0, 1), dstIP: net.IPv4(10, 0, 0, 2), srcPort: 12345, dstPort: 80}
+
+	st := state.State{SrcAddr: 0x0100000a, SrcPort: 12345}
+	if !stateIsOf(st, p, false) {
+		t.Errorf("state %+v should be of %s", st, p)
+	}
+	st.SrcPort = 54321
+	if stateIsOf(st, p, false) {
+		t.Errorf("state with another source port should not be of %s", p)
+	}
+
+	p6 := tracePacket{proto: 58, srcIP: net.ParseIP("dead::1"), dstIP: net.ParseIP("dead::2")}
+	st6 := state.State{SrcAddr: 0xadde, SrcAddr3: 0x01000000}
+	if !stateIsOf(st6, p6, true) {
+		t.Errorf("state %+v should be of %s", st6, p6)
+	}
+}
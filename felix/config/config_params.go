@@ -54,6 +54,8 @@ var (
 	IfaceParamRegexp         = regexp.MustCompile(`^[a-zA-Z0-9:._+-]{1,15}$`)
 	// Hostname  have to be valid ipv4, ipv6 or strings up to 64 characters.
 	HostAddressRegexp = regexp.MustCompile(`^[a-zA-Z0-9:._+-]{1,64}$`)
+	// CollectorRegexp matches the address of a TCP or UDP collector, such as "tcp://host:port".
+	CollectorRegexp = regexp.MustCompile(`^(tcp|udp)://[^/]+:\d+$`)
 )
 
 // Source of a config value.  Values from higher-numbered sources override
@@ -202,6 +204,9 @@ type Config struct {
 	BPFMapSizeConntrack                int               `config:"int;512000;non-zero"`
 	BPFMapSizePerCPUConntrack          int               `config:"int;0"`
 	BPFMapSizeConntrackCleanupQueue    int               `config:"int;100000;non-zero"`
+	BPFMapSizeConntrackScaling         string            `config:"oneof(Disabled,DoubleIfFull);Disabled;non-zero"`
+	BPFMapSizeConntrackScalingPercent  int               `config:"int(1:100);90;non-zero"`
+	BPFMapSizeConntrackScalingMax      int               `config:"int;4194304;non-zero"`
 	BPFMapSizeIPSets                   int               `config:"int;1048576;non-zero"`
 	BPFMapSizeIfState                  int               `config:"int;1000;non-zero"`
 	BPFHostConntrackBypass             bool              `config:"bool;false"`
@@ -212,6 +217,10 @@ type Config struct {
 	BPFExcludeCIDRsFromNAT             []string          `config:"cidr-list;;"`
 	BPFRedirectToPeer                  string            `config:"oneof(Disabled,Enabled,L2Only);L2Only;non-zero"`
 	BPFProfiling                       string            `config:"oneof(Disabled,Enabled);Disabled;non-zero"`
+	BPFXDPPreDNATDeny                  string            `config:"oneof(Disabled,Enabled);Disabled;non-zero"`
+	BPFXDPFailsafeRateLimit            int               `config:"int(0);0"`
+	BPFServiceStats                    string            `config:"oneof(Disabled,Enabled);Disabled;non-zero"`
+	BPFEgressBandwidth                 string            `config:"oneof(Disabled,Enabled);Disabled;non-zero"`
 
 	// DebugBPFCgroupV2 controls the cgroup v2 path that we apply the connect-time load balancer to.  Most distros
 	// are configured for cgroup v1, which prevents all but the root cgroup v2 from working so this is only useful
@@ -257,10 +266,20 @@ type Config struct {
 	// TyphaK8sNamespace namespace to look in when looking for Typha's service (see TyphaK8sServiceName).
 	TyphaK8sNamespace string `config:"string;kube-system;non-zero,local"`
 	// TyphaReadTimeout read timeout when reading from the Typha connection.  If typha sends no data for this long,
-	// Felix will exit and restart.  (Note that Typha sends regular pings so traffic is always expected.)
+	// Felix will reconnect (or exit and restart if it can't resume its session).  (Note that Typha sends regular
+	// pings so traffic is always expected.)
 	TyphaReadTimeout time.Duration `config:"seconds;30;local"`
 	// TyphaWriteTimeout write timeout when writing data to Typha.
 	TyphaWriteTimeout time.Duration `config:"seconds;10;local"`
+	// TyphaNodeFiltering asks Typha to filter out the resources that belong to other nodes and that Felix
+	// doesn't need, such as other nodes' configuration and the fields of remote workload endpoints that are only
+	// used for local endpoints.  This reduces the bandwidth and memory used for large clusters.  Ignored by older
+	// Typha versions.
+	TyphaNodeFiltering bool `config:"bool;false;local"`
+	// TyphaCompression is the compression algorithm that Felix asks Typha to use.  zstd uses more CPU than
+	// snappy but sends far fewer bytes, which is worthwhile for nodes on slow or metered links.  Typha falls back
+	// to snappy if it doesn't support zstd or has it disabled.
+	TyphaCompression string `config:"oneof(snappy,zstd);snappy;local"`
 
 	// TyphaKeyFile path to the TLS private key to use when communicating with Typha.  If this parameter is specified,
 	// the other TLS parameters must also be specified.
@@ -316,8 +335,17 @@ type Config struct {
 	IptablesFilterAllowAction   string `config:"oneof(ACCEPT,RETURN);ACCEPT;non-zero,die-on-fail"`
 	IptablesMangleAllowAction   string `config:"oneof(ACCEPT,RETURN);ACCEPT;non-zero,die-on-fail"`
 	IptablesFilterDenyAction    string `config:"oneof(DROP,REJECT);DROP;non-zero,die-on-fail"`
+	PolicyRejectRateLimit       int    `config:"int(0);100"`
 	LogPrefix                   string `config:"string;calico-packet"`
 
+	DNSTrustedServers []ServerPort  `config:"server-list;k8s-service:kube-dns"`
+	DNSExtraTTL       time.Duration `config:"seconds;0"`
+
+	PolicyAuditLogFilePath      string `config:"file;/var/log/calico/policy/audit.log"`
+	PolicyAuditLogFileMaxSizeMB int    `config:"int(1);100"`
+	PolicyAuditLogFileMaxFiles  int    `config:"int(0);5"`
+	PolicyAuditLogCollector     string `config:"collector;"`
+
 	LogFilePath string `config:"file;/var/log/calico/felix.log;die-on-fail"`
 
 	LogSeverityFile   string `config:"oneof(DEBUG,INFO,WARNING,ERROR,FATAL);INFO"`
@@ -366,6 +394,8 @@ type Config struct {
 	ReportingIntervalSecs time.Duration `config:"seconds;30"`
 	ReportingTTLSecs      time.Duration `config:"seconds;90"`
 
+	PolicyStatusReportInterval time.Duration `config:"seconds;0"`
+
 	EndpointReportingEnabled   bool          `config:"bool;false"`
 	EndpointReportingDelaySecs time.Duration `config:"seconds;1"`
 
@@ -1088,6 +1118,11 @@ func loadParams() {
 				Regexp: HostAddressRegexp,
 				Msg:    "invalid host address",
 			}
+		case "collector":
+			param = &RegexpParam{
+				Regexp: CollectorRegexp,
+				Msg:    "invalid collector address",
+			}
 		case "region":
 			param = &RegionParam{}
 		case "oneof":
@@ -66,6 +66,7 @@ import (
 	"github.com/projectcalico/calico/pod2daemon/binder"
 	"github.com/projectcalico/calico/typha/pkg/discovery"
 	"github.com/projectcalico/calico/typha/pkg/syncclient"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
 )
 
 const (
@@ -511,6 +512,10 @@ configRetry:
 				CAFile:       configParams.TyphaCAFile,
 				ServerCN:     configParams.TyphaCN,
 				ServerURISAN: configParams.TyphaURISAN,
+				// Reconnect and resume from where we left off rather than restarting Felix.
+				ResumeOnReconnect:    true,
+				FilterByNode:         configParams.TyphaNodeFiltering,
+				PreferredCompression: syncproto.CompressionAlgorithm(configParams.TyphaCompression),
 			},
 		)
 	} else {
@@ -656,6 +661,18 @@ configRetry:
 		statusReporter.Start()
 	}
 
+	if configParams.PolicyStatusReportInterval > 0 {
+		log.WithField("interval", configParams.PolicyStatusReportInterval).Info(
+			"Policy status reporting enabled, starting policy status reporter")
+		dpConnector.policyStatusReporter = statusrep.NewPolicyStatusReporter(
+			configParams.FelixHostname,
+			dpConnector.NewFromDataplaneConsumer(),
+			dpConnector.datastore,
+			configParams.PolicyStatusReportInterval,
+		)
+		dpConnector.policyStatusReporter.Start()
+	}
+
 	if configParams.EndpointStatusPathPrefix != "" {
 		if runtime.GOOS == "windows" {
 			log.WithField("os", runtime.GOOS).Info("EndpointStatusPathPrefix is currently unsupported on Windows. Ignoring config...")
@@ -672,6 +689,17 @@ configRetry:
 	// Start communicating with the dataplane driver.
 	dpConnector.Start()
 
+	if stoppable, ok := dpDriver.(interface{ Stop() }); ok {
+		// Give the in-process dataplane a chance to stop its background work.
+		sc := make(chan *sync.WaitGroup)
+		stopSignalChans = append(stopSignalChans, sc)
+		go func() {
+			wg := <-sc
+			stoppable.Stop()
+			wg.Done()
+		}()
+	}
+
 	if policySyncProcessor != nil {
 		log.WithField("policySyncPathPrefix", configParams.PolicySyncPathPrefix).Info(
 			"Policy sync API enabled.  Starting the policy sync server.")
@@ -1020,6 +1048,8 @@ type DataplaneConnector struct {
 
 	firstStatusReportSent bool
 
+	policyStatusReporter *statusrep.PolicyStatusReporter
+
 	wireguardStatUpdateFromDataplane chan *proto.WireguardStatusUpdate
 }
 
@@ -1280,6 +1310,13 @@ func (fc *DataplaneConnector) sendMessagesToDataplaneDriver() {
 		case *calc.DatastoreNotReady:
 			log.Warn("Datastore became unready, need to restart.")
 			fc.shutDownProcess("datastore became unready")
+		case *calc.PolicyGenerations:
+			// Sent after the policy updates that it covers, so those have already been
+			// queued to the dataplane.  Not a dataplane message.
+			if fc.policyStatusReporter != nil {
+				fc.policyStatusReporter.OnPolicyGenerations(msg.Generations)
+			}
+			continue
 		case *proto.Encapsulation:
 			encap := func() config.Encapsulation {
 				// Using a func() here to limit the scope of our defer.
@@ -269,6 +269,10 @@ func StartDataplaneDriver(
 				FilterAllowAction:    configParams.FilterAllowAction(),
 				MangleAllowAction:    configParams.MangleAllowAction(),
 				FilterDenyAction:     configParams.FilterDenyAction(),
+				RejectRateLimit:      configParams.PolicyRejectRateLimit,
+				DNSTrustedServers:    configParams.DNSTrustedServers,
+				PolicyAuditEnabled: configParams.PolicyAuditLogFilePath != "" ||
+					configParams.PolicyAuditLogCollector != "",
 
 				FailsafeInboundHostPorts:  configParams.FailsafeInboundHostPorts,
 				FailsafeOutboundHostPorts: configParams.FailsafeOutboundHostPorts,
@@ -321,6 +325,11 @@ func StartDataplaneDriver(
 			IptablesLockTimeout:            configParams.IptablesLockTimeoutSecs,
 			IptablesLockProbeInterval:      configParams.IptablesLockProbeIntervalMillis,
 			MaxIPSetSize:                   configParams.MaxIpsetSize,
+			DNSExtraTTL:                    configParams.DNSExtraTTL,
+			PolicyAuditLogFilePath:         configParams.PolicyAuditLogFilePath,
+			PolicyAuditLogFileMaxSizeMB:    configParams.PolicyAuditLogFileMaxSizeMB,
+			PolicyAuditLogFileMaxFiles:     configParams.PolicyAuditLogFileMaxFiles,
+			PolicyAuditLogCollector:        configParams.PolicyAuditLogCollector,
 			IPv6Enabled:                    configParams.Ipv6Support,
 			BPFIpv6Enabled:                 configParams.Ipv6Support && configParams.BPFEnabled,
 			BPFHostConntrackBypass:         configParams.BPFHostConntrackBypass,
@@ -373,6 +382,9 @@ func StartDataplaneDriver(
 			BPFMapSizeConntrack:                configParams.BPFMapSizeConntrack,
 			BPFMapSizePerCPUConntrack:          configParams.BPFMapSizePerCPUConntrack,
 			BPFMapSizeConntrackCleanupQueue:    configParams.BPFMapSizeConntrackCleanupQueue,
+			BPFMapSizeConntrackScaling:         configParams.BPFMapSizeConntrackScaling,
+			BPFMapSizeConntrackScalingPercent:  configParams.BPFMapSizeConntrackScalingPercent,
+			BPFMapSizeConntrackScalingMax:      configParams.BPFMapSizeConntrackScalingMax,
 			BPFMapSizeIPSets:                   configParams.BPFMapSizeIPSets,
 			BPFMapSizeIfState:                  configParams.BPFMapSizeIfState,
 			BPFEnforceRPF:                      configParams.BPFEnforceRPF,
@@ -386,6 +398,10 @@ func StartDataplaneDriver(
 			BPFExcludeCIDRsFromNAT:             configParams.BPFExcludeCIDRsFromNAT,
 			BPFRedirectToPeer:                  configParams.BPFRedirectToPeer,
 			BPFProfiling:                       configParams.BPFProfiling,
+			BPFXDPPreDNATDeny:                  configParams.BPFXDPPreDNATDeny,
+			BPFXDPFailsafeRateLimit:            configParams.BPFXDPFailsafeRateLimit,
+			BPFServiceStats:                    configParams.BPFServiceStats,
+			BPFEgressBandwidth:                 configParams.BPFEgressBandwidth,
 			ServiceLoopPrevention:              configParams.ServiceLoopPrevention,
 
 			KubeClientSet: k8sClientSet,
@@ -15,6 +15,8 @@
 package ipsets
 
 import (
+	"slices"
+
 	log "github.com/sirupsen/logrus"
 
 	"github.com/projectcalico/calico/felix/ipsets"
@@ -36,6 +38,11 @@ type IPSetsDataplane interface {
 	SetFilter(neededIPSets set.Set[string])
 }
 
+// DomainInfoStore provides the IPs that domain names currently resolve to.
+type DomainInfoStore interface {
+	GetDomainIPs(domain string) []string
+}
+
 // Except for domain IP sets, IPSetsManager simply passes through IP set updates from the datastore
 // to the ipsets.IPSets dataplane layer.  For domain IP sets - which hereafter we'll just call
 // "domain sets" - IPSetsManager handles the resolution from domain names to expiring IPs.
@@ -43,12 +50,30 @@ type IPSetsManager struct {
 	dataplanes []IPSetsDataplane
 	maxSize    int
 	lg         *log.Entry
+
+	domainStore DomainInfoStore
+	// domainSets holds the state of each IP set that has at least one domain member, indexed
+	// by set ID.
+	domainSets map[string]*domainSetData
+	// domainToSetIDs maps each domain, which may be a "*." wildcard, to the IDs of the sets
+	// that contain it.
+	domainToSetIDs map[string]set.Set[string]
+}
+
+type domainSetData struct {
+	domains set.Set[string]
+	// staticMembers are the IP and CIDR members that we were given directly.
+	staticMembers set.Set[string]
+	// domainIPs are the IPs that we have added to the dataplane for the domains.
+	domainIPs set.Set[string]
 }
 
 func NewIPSetsManager(name string, ipsets_ IPSetsDataplane, maxIPSetSize int) *IPSetsManager {
 	m := &IPSetsManager{
-		maxSize: maxIPSetSize,
-		lg:      log.WithField("name", name),
+		maxSize:        maxIPSetSize,
+		lg:             log.WithField("name", name),
+		domainSets:     map[string]*domainSetData{},
+		domainToSetIDs: map[string]set.Set[string]{},
 	}
 
 	if ipsets_ != nil {
@@ -58,6 +83,12 @@ func NewIPSetsManager(name string, ipsets_ IPSetsDataplane, maxIPSetSize int) *I
 	return m
 }
 
+// SetDomainStore sets the store used to resolve the domain members of IP sets.  Without one,
+// domain members are ignored.
+func (m *IPSetsManager) SetDomainStore(store DomainInfoStore) {
+	m.domainStore = store
+}
+
 func (m *IPSetsManager) AddDataplane(dp IPSetsDataplane) {
 	m.dataplanes = append(m.dataplanes, dp)
 }
@@ -87,9 +118,10 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 	// IP set-related messages, these are extremely common.
 	case *proto.IPSetDeltaUpdate:
 		m.lg.WithField("ipSetId", msg.Id).Debug("IP set delta update")
+		added, removed := m.onDeltaUpdate(msg.Id, msg.AddedMembers, msg.RemovedMembers)
 		for _, dp := range m.dataplanes {
-			dp.AddMembers(msg.Id, msg.AddedMembers)
-			dp.RemoveMembers(msg.Id, msg.RemovedMembers)
+			dp.AddMembers(msg.Id, added)
+			dp.RemoveMembers(msg.Id, removed)
 		}
 	case *proto.IPSetUpdate:
 		m.lg.WithField("ipSetId", msg.Id).Debug("IP set update")
@@ -109,11 +141,13 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 			SetID:   msg.Id,
 			MaxSize: m.maxSize,
 		}
+		members := m.onReplace(msg.Id, msg.Members)
 		for _, dp := range m.dataplanes {
-			dp.AddOrReplaceIPSet(metadata, msg.Members)
+			dp.AddOrReplaceIPSet(metadata, members)
 		}
 	case *proto.IPSetRemove:
 		m.lg.WithField("ipSetId", msg.Id).Debug("IP set remove")
+		m.removeDomainSet(msg.Id)
 		for _, dp := range m.dataplanes {
 			dp.RemoveIPSet(msg.Id)
 		}
@@ -124,3 +158,191 @@ func (m *IPSetsManager) CompleteDeferredWork() error {
 	// Nothing to do, we don't defer any work.
 	return nil
 }
+
+// OnDomainChange is called when the IPs for the given domain name may have changed.  It updates
+// the IP sets that contain the name, or a wildcard that matches it.
+func (m *IPSetsManager) OnDomainChange(name string) {
+	m.updateDomainSets(m.domainToSetIDs[name])
+	for i, c := range name {
+		if c == '.' {
+			m.updateDomainSets(m.domainToSetIDs["*"+name[i:]])
+		}
+	}
+}
+
+func (m *IPSetsManager) updateDomainSets(setIDs set.Set[string]) {
+	if setIDs == nil {
+		return
+	}
+	setIDs.Iter(func(setID string) error {
+		added, removed := m.refreshDomainIPs(setID, m.domainSets[setID])
+		if len(added) == 0 && len(removed) == 0 {
+			return nil
+		}
+		m.lg.WithFields(log.Fields{
+			"ipSetId": setID,
+			"added":   added,
+			"removed": removed,
+		}).Debug("Domain IPs changed")
+		for _, dp := range m.dataplanes {
+			dp.AddMembers(setID, added)
+			dp.RemoveMembers(setID, removed)
+		}
+		return nil
+	})
+}
+
+// onReplace records the domain members of a replaced IP set and returns the members to program,
+// with each domain replaced by its IPs.
+func (m *IPSetsManager) onReplace(setID string, members []string) []string {
+	static, domains := splitDomainMembers(members)
+	m.removeDomainSet(setID)
+	if len(domains) == 0 {
+		return members
+	}
+	d := &domainSetData{
+		domains:       set.New[string](),
+		staticMembers: set.FromArray(static),
+		domainIPs:     set.New[string](),
+	}
+	m.domainSets[setID] = d
+	m.addDomains(setID, d, domains)
+	m.refreshDomainIPs(setID, d)
+	d.domainIPs.Iter(func(ip string) error {
+		if !d.staticMembers.Contains(ip) {
+			static = append(static, ip)
+		}
+		return nil
+	})
+	return static
+}
+
+// onDeltaUpdate records the domain members of a delta update and returns the members to add
+// to and remove from the dataplane.
+func (m *IPSetsManager) onDeltaUpdate(setID string, addedMembers, removedMembers []string) (added, removed []string) {
+	addedStatic, addedDomains := splitDomainMembers(addedMembers)
+	removedStatic, removedDomains := splitDomainMembers(removedMembers)
+	d := m.domainSets[setID]
+	if d == nil {
+		if len(addedDomains) == 0 {
+			// Common case: nothing to do with domains.
+			return addedMembers, removedMembers
+		}
+		d = &domainSetData{
+			domains:       set.New[string](),
+			staticMembers: set.New[string](),
+			domainIPs:     set.New[string](),
+		}
+		if len(m.dataplanes) > 0 {
+			if current, err := m.dataplanes[0].GetDesiredMembers(setID); err == nil {
+				d.staticMembers.AddSet(current)
+			}
+		}
+		m.domainSets[setID] = d
+	}
+
+	for _, member := range addedStatic {
+		d.staticMembers.Add(member)
+		if d.domainIPs.Contains(member) {
+			// Already programmed for one of the domains.
+			continue
+		}
+		added = append(added, member)
+	}
+	for _, member := range removedStatic {
+		d.staticMembers.Discard(member)
+		if d.domainIPs.Contains(member) {
+			// Still wanted for one of the domains.
+			continue
+		}
+		removed = append(removed, member)
+	}
+	m.removeDomains(setID, d, removedDomains)
+	m.addDomains(setID, d, addedDomains)
+	domainAdded, domainRemoved := m.refreshDomainIPs(setID, d)
+	added = append(added, domainAdded...)
+	removed = append(removed, domainRemoved...)
+
+	if d.domains.Len() == 0 {
+		delete(m.domainSets, setID)
+	}
+	return
+}
+
+// refreshDomainIPs recalculates the IPs for the domains in the given set and returns the changes
+// that need to be made to the dataplane.
+func (m *IPSetsManager) refreshDomainIPs(setID string, d *domainSetData) (added, removed []string) {
+	if d == nil {
+		return
+	}
+	newIPs := set.New[string]()
+	if m.domainStore != nil {
+		d.domains.Iter(func(domain string) error {
+			newIPs.AddAll(m.domainStore.GetDomainIPs(domain))
+			return nil
+		})
+	}
+	newIPs.Iter(func(ip string) error {
+		if !d.domainIPs.Contains(ip) && !d.staticMembers.Contains(ip) {
+			added = append(added, ip)
+		}
+		return nil
+	})
+	d.domainIPs.Iter(func(ip string) error {
+		if !newIPs.Contains(ip) && !d.staticMembers.Contains(ip) {
+			removed = append(removed, ip)
+		}
+		return nil
+	})
+	d.domainIPs = newIPs
+	return
+}
+
+func (m *IPSetsManager) addDomains(setID string, d *domainSetData, domains []string) {
+	for _, domain := range domains {
+		d.domains.Add(domain)
+		setIDs := m.domainToSetIDs[domain]
+		if setIDs == nil {
+			setIDs = set.New[string]()
+			m.domainToSetIDs[domain] = setIDs
+		}
+		setIDs.Add(setID)
+	}
+}
+
+func (m *IPSetsManager) removeDomains(setID string, d *domainSetData, domains []string) {
+	for _, domain := range domains {
+		d.domains.Discard(domain)
+		if setIDs := m.domainToSetIDs[domain]; setIDs != nil {
+			setIDs.Discard(setID)
+			if setIDs.Len() == 0 {
+				delete(m.domainToSetIDs, domain)
+			}
+		}
+	}
+}
+
+func (m *IPSetsManager) removeDomainSet(setID string) {
+	d := m.domainSets[setID]
+	if d == nil {
+		return
+	}
+	m.removeDomains(setID, d, d.domains.Slice())
+	delete(m.domainSets, setID)
+}
+
+// splitDomainMembers splits IP set members into IP-based members and domain names.  Since most
+// IP sets have no domains, it avoids copying the members in that case.
+func splitDomainMembers(members []string) (static, domains []string) {
+	if !slices.ContainsFunc(members, ipsets.IsDomainMember) {
+		return members, nil
+	}
+	for _, member := range members {
+		if ipsets.IsDomainMember(member) {
+			domains = append(domains, member)
+		} else {
+			static = append(static, member)
+		}
+	}
+	return
+}
@@ -15,6 +15,8 @@
 package ipsets
 
 import (
+	"strings"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -144,4 +146,75 @@ var _ = Describe("IP Sets manager", func() {
 	for _, testCase := range ipsetsMgrTestCases {
 		IPsetsMgrTest1(testCase.ipsetID, testCase.ipsetType, testCase.ipsetMembers)
 	}
+
+	Describe("with domain members", func() {
+		var domainStore *mockDomainStore
+
+		BeforeEach(func() {
+			domainStore = &mockDomainStore{ips: map[string][]string{
+				"example.com":     {"1.2.3.4"},
+				"www.example.org": {"1.2.3.5", "10.0.0.1"},
+			}}
+			ipsetsMgr.SetDomainStore(domainStore)
+			ipsetsMgr.OnUpdate(&proto.IPSetUpdate{
+				Id:      "d1",
+				Members: []string{"10.0.0.1", "example.com", "*.example.org"},
+				Type:    proto.IPSetUpdate_NET,
+			})
+		})
+
+		It("should program the IPs of the domains", func() {
+			Expect(ipSets.Members["d1"]).To(Equal(set.From("10.0.0.1", "1.2.3.4", "1.2.3.5")))
+		})
+
+		It("should handle domain changes", func() {
+			domainStore.ips["example.com"] = []string{"1.2.3.6"}
+			ipsetsMgr.OnDomainChange("example.com")
+			domainStore.ips["www.example.org"] = nil
+			ipsetsMgr.OnDomainChange("www.example.org")
+			Expect(ipSets.Members["d1"]).To(Equal(set.From("10.0.0.1", "1.2.3.6")))
+		})
+
+		It("should ignore changes to other domains", func() {
+			domainStore.ips["example.org"] = []string{"1.2.3.7"}
+			ipsetsMgr.OnDomainChange("example.org")
+			Expect(ipSets.Members["d1"]).To(Equal(set.From("10.0.0.1", "1.2.3.4", "1.2.3.5")))
+		})
+
+		It("should handle delta updates", func() {
+			ipsetsMgr.OnUpdate(&proto.IPSetDeltaUpdate{
+				Id:             "d1",
+				AddedMembers:   []string{"1.2.3.4", "www.example.org"},
+				RemovedMembers: []string{"10.0.0.1", "example.com", "*.example.org"},
+			})
+			Expect(ipSets.Members["d1"]).To(Equal(set.From("1.2.3.4", "1.2.3.5", "10.0.0.1")))
+
+			domainStore.ips["www.example.org"] = nil
+			ipsetsMgr.OnDomainChange("www.example.org")
+			Expect(ipSets.Members["d1"]).To(Equal(set.From("1.2.3.4")))
+		})
+
+		It("should stop tracking the domains when the set is removed", func() {
+			ipsetsMgr.OnUpdate(&proto.IPSetRemove{Id: "d1"})
+			ipsetsMgr.OnDomainChange("example.com")
+			Expect(ipSets.Members).NotTo(HaveKey("d1"))
+		})
+	})
 })
+
+type mockDomainStore struct {
+	ips map[string][]string
+}
+
+func (s *mockDomainStore) GetDomainIPs(domain string) []string {
+	if suffix, ok := strings.CutPrefix(domain, "*"); ok {
+		var ips []string
+		for name, nameIPs := range s.ips {
+			if strings.HasSuffix(name, suffix) {
+				ips = append(ips, nameIPs...)
+			}
+		}
+		return ips
+	}
+	return s.ips[domain]
+}
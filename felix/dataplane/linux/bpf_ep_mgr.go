@@ -49,8 +49,10 @@ import (
 	"github.com/projectcalico/calico/felix/bpf"
 	bpfarp "github.com/projectcalico/calico/felix/bpf/arp"
 	"github.com/projectcalico/calico/felix/bpf/asm"
+	"github.com/projectcalico/calico/felix/bpf/bandwidth"
 	"github.com/projectcalico/calico/felix/bpf/bpfdefs"
 	"github.com/projectcalico/calico/felix/bpf/bpfmap"
+	"github.com/projectcalico/calico/felix/bpf/conntrack"
 	"github.com/projectcalico/calico/felix/bpf/counters"
 	"github.com/projectcalico/calico/felix/bpf/filter"
 	"github.com/projectcalico/calico/felix/bpf/hook"
@@ -165,6 +167,8 @@ type bpfDataplane interface {
 	ensureProgramLoaded(ap attachPoint, ipFamily proto.IPVersion) error
 	ensureNoProgram(attachPoint) error
 	ensureQdisc(iface string) (bool, error)
+	ensureFQQdisc(iface string) (bool, error)
+	removeFQQdisc(iface string) error
 	ensureBPFDevices() error
 	updatePolicyProgram(rules polprog.Rules, polDir string, ap attachPoint, ipFamily proto.IPVersion) error
 	removePolicyProgram(ap attachPoint, ipFamily proto.IPVersion) error
@@ -252,6 +256,9 @@ type bpfInterfaceState struct {
 	v4Readiness ifaceReadiness
 	v6Readiness ifaceReadiness
 	qdisc       qDiscInfo
+	// edtID is the id of the egress bandwidth limit of a workload, 0 if
+	// none is allocated.
+	edtID int
 }
 
 type bpfInterfaceJumpIndices struct {
@@ -329,6 +336,12 @@ type bpfEndpointManager struct {
 	startupOnce   sync.Once
 	copyDeltaOnce sync.Once
 
+	// ctMigrators copy the entries of resized conntrack maps in the
+	// background.  They are stopped by Stop().
+	ctMigratorsLock sync.Mutex
+	ctMigrators     []*conntrack.LiveResizeMigrator
+	stopped         bool
+
 	// onStillAlive is called from loops to reset the watchdog.
 	onStillAlive func()
 
@@ -353,7 +366,13 @@ type bpfEndpointManager struct {
 	opReporter logutils.OpRecorder
 
 	// XDP
-	xdpModes []bpf.XDPMode
+	xdpModes             []bpf.XDPMode
+	xdpDenyOnlyModes     []bpf.XDPMode
+	xdpPreDNATDeny       bool
+	xdpFailsafeRateLimit uint32
+
+	rejectRateLimit uint32
+	dnsSnoop        bool
 
 	// IPv6 Support
 	ipv6Enabled bool
@@ -372,6 +391,7 @@ type bpfEndpointManager struct {
 
 	bpfPolicyDebugEnabled bool
 	bpfRedirectToPeer     string
+	policyAuditEnabled    bool
 
 	routeTableV4     *routetable.ClassView
 	routeTableV6     *routetable.ClassView
@@ -379,6 +399,13 @@ type bpfEndpointManager struct {
 	dirtyServices    set.Set[serviceKey]
 	natExcludedCIDRs *ip.CIDRTrie
 	profiling        string
+	serviceStats     bool
+
+	// Egress bandwidth enforcement
+	egressBandwidth bool
+	edtIDAlloc      *jumpMapAlloc
+	edtIDsLock      sync.Mutex
+	edtIDToWEP      map[uint32]types.WorkloadEndpointID
 
 	// Maps for policy rule counters
 	polNameToMatchIDs map[string]set.Set[polprog.RuleMatchID]
@@ -533,12 +560,20 @@ func newBPFEndpointManager(
 		bpfDisableGROForIfaces: config.BPFDisableGROForIfaces,
 		bpfPolicyDebugEnabled:  config.BPFPolicyDebugEnabled,
 		bpfRedirectToPeer:      config.BPFRedirectToPeer,
+		policyAuditEnabled:     config.RulesConfig.PolicyAuditEnabled,
 		polNameToMatchIDs:      map[string]set.Set[polprog.RuleMatchID]{},
 		dirtyRules:             set.New[polprog.RuleMatchID](),
 
 		healthAggregator: healthAggregator,
 		features:         dataplanefeatures,
 		profiling:        config.BPFProfiling,
+		serviceStats:     config.BPFServiceStats == "Enabled",
+		egressBandwidth:  config.BPFEgressBandwidth == "Enabled",
+
+		xdpPreDNATDeny:       config.BPFXDPPreDNATDeny == "Enabled",
+		xdpFailsafeRateLimit: uint32(config.BPFXDPFailsafeRateLimit),
+		rejectRateLimit:      uint32(config.RulesConfig.RejectRateLimit),
+		dnsSnoop:             len(config.RulesConfig.DNSTrustedServers) > 0,
 	}
 
 	specialInterfaces := []string{"egress.calico"}
@@ -584,14 +619,22 @@ func newBPFEndpointManager(
 
 	// Calculate allowed XDP attachment modes.  Note, in BPF mode untracked ingress policy is
 	// _only_ implemented by XDP, so we _should_ fall back to XDPGeneric if necessary in order
-	// to preserve the semantics of untracked ingress policy.  (Therefore we are also saying
-	// here that the GenericXDPEnabled config setting, like XDPEnabled, is only relevant when
-	// BPFEnabled is false.)
+	// to preserve the semantics of untracked ingress policy.
 	m.xdpModes = []bpf.XDPMode{
 		bpf.XDPOffload,
 		bpf.XDPDriver,
 		bpf.XDPGeneric,
 	}
+	// On the other hand, the deny-only XDP program only accelerates policy that is also
+	// enforced by TC so, like in iptables mode, we fall back to XDPGeneric only if
+	// GenericXDPEnabled is set.
+	m.xdpDenyOnlyModes = []bpf.XDPMode{
+		bpf.XDPOffload,
+		bpf.XDPDriver,
+	}
+	if config.XDPAllowGeneric {
+		m.xdpDenyOnlyModes = append(m.xdpDenyOnlyModes, bpf.XDPGeneric)
+	}
 
 	// Clean all the files under /var/run/calico/bpf/prog to remove any information from the
 	// previous execution of the bpf dataplane, and make sure the directory exists.
@@ -664,6 +707,24 @@ func newBPFEndpointManager(
 		}
 	}
 
+	if m.egressBandwidth {
+		// The ids of the egress bandwidth limits are reallocated when we
+		// reattach the programs so the old limits are of no use.
+		err := m.commonMaps.BandwidthMap.Iter(func(k, v []byte) maps.IteratorAction {
+			return maps.IterDelete
+		})
+		if err != nil {
+			log.WithError(err).Warn("Failed to iterate over egress bandwidth map")
+		}
+
+		m.edtIDAlloc = newJumpMapAlloc(bandwidth.MaxID)
+		m.edtIDToWEP = map[uint32]types.WorkloadEndpointID{}
+		collector := bandwidth.NewCollector(m.commonMaps.BandwidthMap, m.edtIDToWorkload)
+		if err := prometheus.Register(collector); err != nil {
+			log.WithError(err).Warn("Failed to register egress bandwidth metrics.")
+		}
+	}
+
 	// If not running in test
 	if m.dp == m {
 		// Repin jump maps to a different path so that existing programs keep working
@@ -992,6 +1053,23 @@ func (m *bpfEndpointManager) reclaimFilterIdx(name string, iface *bpfInterface)
 	}
 }
 
+func (m *bpfEndpointManager) reclaimEDTID(name string, iface *bpfInterface) {
+	id := iface.dpState.edtID
+	if id == 0 {
+		return
+	}
+	if err := m.commonMaps.BandwidthMap.Delete(bandwidth.NewKey(uint32(id)).AsBytes()); err != nil && !maps.IsNotExists(err) {
+		log.WithError(err).Warn("Egress bandwidth limit may leak.")
+	}
+	if err := m.edtIDAlloc.Put(id-1, name); err != nil {
+		log.WithError(err).Error("Egress bandwidth id")
+	}
+	m.edtIDsLock.Lock()
+	delete(m.edtIDToWEP, uint32(id))
+	m.edtIDsLock.Unlock()
+	iface.dpState.edtID = 0
+}
+
 func (m *bpfEndpointManager) getIfTypeFlags(name string, ifaceType IfaceType) uint32 {
 	flags := uint32(0)
 	if m.isWorkloadIface(name) {
@@ -1047,6 +1125,7 @@ func (m *bpfEndpointManager) updateIfaceStateMap(name string, iface *bpfInterfac
 			m.reclaimPolicyIdx(name, 6, iface)
 		}
 		m.reclaimFilterIdx(name, iface)
+		m.reclaimEDTID(name, iface)
 		m.ifStateMap.Desired().Delete(k)
 		iface.dpState.clearJumps()
 	}
@@ -1592,6 +1671,46 @@ func (m *bpfEndpointManager) loadDefaultPolicies() error {
 	return nil
 }
 
+// startCTMigrators copies, in the background, the conntrack entries that the
+// programs created in the old map of a resized conntrack map between the copy
+// in EnsureExists and the moment they switched to the new map.
+func (m *bpfEndpointManager) startCTMigrators() {
+	m.ctMigratorsLock.Lock()
+	defer m.ctMigratorsLock.Unlock()
+
+	if m.stopped {
+		return
+	}
+	for _, d := range []*bpfEndpointManagerDataplane{m.v4, m.v6} {
+		if d == nil {
+			continue
+		}
+		if pm, ok := d.CtMap.(*maps.PinnedMap); !ok || !pm.LiveResizeInProgress() {
+			continue
+		}
+		migrator, err := conntrack.NewLiveResizeMigrator(d.CtMap, int(d.ipFamily))
+		if err != nil {
+			log.WithError(err).Error("Failed to start migration of resized conntrack map.")
+			continue
+		}
+		migrator.Start()
+		m.ctMigrators = append(m.ctMigrators, migrator)
+	}
+}
+
+// Stop stops the background work of the manager.  A migration of a resized
+// conntrack map that is interrupted resumes when felix starts again.
+func (m *bpfEndpointManager) Stop() {
+	m.ctMigratorsLock.Lock()
+	defer m.ctMigratorsLock.Unlock()
+
+	m.stopped = true
+	for _, migrator := range m.ctMigrators {
+		migrator.Stop()
+	}
+	m.ctMigrators = nil
+}
+
 func (m *bpfEndpointManager) CompleteDeferredWork() error {
 	defer func() {
 		log.Debug("CompleteDeferredWork done.")
@@ -1683,6 +1802,7 @@ func (m *bpfEndpointManager) CompleteDeferredWork() error {
 		if err != nil {
 			log.WithError(err).Debugf("Failed to copy data from old conntrack map %s", err)
 		}
+		m.startCTMigrators()
 	})
 
 	if m.dirtyIfaceNames.Len() == 0 {
@@ -1749,6 +1869,27 @@ func (m *bpfEndpointManager) doApplyPolicyToDataIface(iface, masterIface string,
 		hepIface = masterIface
 	}
 
+	enforceEgressBandwidth := m.egressBandwidth && xdpMode != XDPModeOnly && m.isEgressBandwidthIface(iface)
+	haveFQ := false
+	if enforceEgressBandwidth {
+		// The fq qdisc holds back the packets that the BPF program stamped
+		// with a departure time in the future.
+		haveFQ, err = m.dp.ensureFQQdisc(iface)
+		if err != nil {
+			return state, err
+		}
+		if !haveFQ {
+			log.WithField("iface", iface).Warn(
+				"Interface has a root qdisc that Felix did not create, egress bandwidth limits are not enforced on it.")
+		}
+	} else if m.isEgressBandwidthIface(iface) {
+		// Put back the default qdisc if we replaced it while the limits were
+		// enforced.
+		if err := m.dp.removeFQQdisc(iface); err != nil {
+			log.WithError(err).WithField("iface", iface).Warn("Failed to restore the root qdisc.")
+		}
+	}
+
 	var hepPtr *proto.HostEndpoint
 	if hep, hepExists := m.hostIfaceToEpMap[hepIface]; hepExists {
 		hepPtr = hep
@@ -1761,17 +1902,25 @@ func (m *bpfEndpointManager) doApplyPolicyToDataIface(iface, masterIface string,
 	var xdpAP4, xdpAP6 *xdp.AttachPoint
 
 	tcAttachPoint := m.calculateTCAttachPoint(iface)
+	tcAttachPoint.EgressBandwidth = enforceEgressBandwidth
+	tcAttachPoint.EgressBandwidthFQ = haveFQ
 	if err := m.dataIfaceStateFillJumps(tcAttachPoint, xdpMode, &state); err != nil {
 		return state, err
 	}
 
+	xdpProg := m.xdpProgramFor(hepPtr)
 	xdpAttachPoint := &xdp.AttachPoint{
 		AttachPoint: bpf.AttachPoint{
 			Hook:     hook.XDP,
 			Iface:    iface,
 			LogLevel: m.bpfLogLevel,
 		},
-		Modes: m.xdpModes,
+		Modes:             m.xdpModes,
+		FailsafeRateLimit: m.xdpFailsafeRateLimit,
+	}
+	if xdpProg == xdpProgramDenyOnly {
+		xdpAttachPoint.Modes = m.xdpDenyOnlyModes
+		xdpAttachPoint.CTFastPath = true
 	}
 
 	if m.v6 != nil {
@@ -1806,9 +1955,18 @@ func (m *bpfEndpointManager) doApplyPolicyToDataIface(iface, masterIface string,
 		defer parallelWG.Done()
 		xdpAP := mergeAttachPoints(xdpAP4, xdpAP6)
 		if xdpAP != nil {
-			if hepPtr != nil && len(hepPtr.UntrackedTiers) == 1 {
+			switch xdpProg {
+			case xdpProgramUntracked:
 				_, xdpErr = m.dp.ensureProgramAttached(xdpAP)
-			} else {
+			case xdpProgramDenyOnly:
+				// TC enforces the same policy so failing to attach the program, for
+				// instance because the driver does not support XDP, is not fatal.
+				if _, err := m.dp.ensureProgramAttached(xdpAP); err != nil {
+					m.updateRateLimitedLog.WithError(err).WithField("iface", iface).Warn(
+						"Failed to attach deny-only XDP program, policy is only enforced by TC.")
+					xdpErr = m.dp.ensureNoProgram(xdpAP)
+				}
+			default:
 				xdpErr = m.dp.ensureNoProgram(xdpAP)
 			}
 		}
@@ -2317,6 +2475,21 @@ func (m *bpfEndpointManager) doApplyPolicy(ifaceName string) (bpfInterfaceState,
 		return state, err
 	}
 
+	if m.egressBandwidth {
+		newID, err := m.wepStateFillEDT(ifaceName, &state, endpointID, wep)
+		if err != nil {
+			return state, err
+		}
+		if newID {
+			// The preamble program of the interface may still carry the id
+			// from before, for instance after a restart, which wiped the
+			// bandwidth map.  Reattach it with the new one.
+			v4Readiness = ifaceNotReady
+			v6Readiness = ifaceNotReady
+		}
+		ap.EDTID = uint32(state.edtID)
+	}
+
 	if m.v6 != nil {
 		wg.Add(1)
 		go func() {
@@ -2414,6 +2587,71 @@ func (m *bpfEndpointManager) doApplyPolicy(ifaceName string) (bpfInterfaceState,
 	return state, nil
 }
 
+// wepStateFillEDT allocates the id of the egress bandwidth limit of the
+// workload and writes the limit to the bandwidth map.  The id is baked into the
+// preamble program so it stays with the interface until the interface goes
+// down and the limit is updated in place.  It returns true if it allocated a
+// new id, which the preamble program must be reattached with.
+func (m *bpfEndpointManager) wepStateFillEDT(
+	ifaceName string,
+	state *bpfInterfaceState,
+	endpointID *types.WorkloadEndpointID,
+	wep *proto.WorkloadEndpoint,
+) (bool, error) {
+	newID := false
+	if state.edtID == 0 {
+		idx, err := m.edtIDAlloc.Get(ifaceName)
+		if err != nil {
+			return false, fmt.Errorf("egress bandwidth id: %w", err)
+		}
+		state.edtID = idx + 1
+		newID = true
+	}
+
+	id := uint32(state.edtID)
+
+	m.edtIDsLock.Lock()
+	if endpointID != nil {
+		m.edtIDToWEP[id] = *endpointID
+	} else {
+		delete(m.edtIDToWEP, id)
+	}
+	m.edtIDsLock.Unlock()
+
+	var rate uint64
+	if wep != nil && wep.QosControls != nil && wep.QosControls.EgressBandwidth > 0 {
+		rate = uint64(wep.QosControls.EgressBandwidth)
+	}
+
+	k := bandwidth.NewKey(id)
+	v := bandwidth.NewValue(rate)
+	// Keep the departure time and the counters that the BPF programs maintain,
+	// unless the id was just allocated and the entry is a leftover.
+	if vb, err := m.commonMaps.BandwidthMap.Get(k.AsBytes()); err == nil && !newID {
+		old := bandwidth.ValueFromBytes(vb)
+		if old.Rate() == v.Rate() {
+			return false, nil
+		}
+		v = old.WithRate(rate)
+	}
+
+	if err := m.commonMaps.BandwidthMap.Update(k.AsBytes(), v.AsBytes()); err != nil {
+		return newID, fmt.Errorf("egress bandwidth limit of %s: %w", ifaceName, err)
+	}
+	return newID, nil
+}
+
+func (m *bpfEndpointManager) edtIDToWorkload(id uint32) (string, string, bool) {
+	m.edtIDsLock.Lock()
+	defer m.edtIDsLock.Unlock()
+
+	wlID, ok := m.edtIDToWEP[id]
+	if !ok {
+		return "", "", false
+	}
+	return wlID.WorkloadId, wlID.EndpointId, true
+}
+
 func (m *bpfEndpointManager) ensureProgramAttached(ap attachPoint) (qDiscInfo, error) {
 	var qdisc qDiscInfo
 	res, err := ap.AttachProgram()
@@ -2771,7 +3009,8 @@ func (d *bpfEndpointManagerDataplane) attachXDPProgram(ap *xdp.AttachPoint, ep *
 	}
 
 	m := d.mgr
-	if ep != nil && len(ep.UntrackedTiers) == 1 {
+	switch m.xdpProgramFor(ep) {
+	case xdpProgramUntracked:
 		err := m.dp.ensureProgramLoaded(ap, d.ipFamily)
 		if err != nil {
 			return nil, err
@@ -2787,10 +3026,73 @@ func (d *bpfEndpointManagerDataplane) attachXDPProgram(ap *xdp.AttachPoint, ep *
 		err = m.updatePolicyProgramFn(rules, "xdp", ap, d.ipFamily)
 		ap.Log().WithError(err).Debugf("Applied untracked policy hep=%v", ep.Name)
 		return ap, err
+	case xdpProgramDenyOnly:
+		err := m.dp.ensureProgramLoaded(ap, d.ipFamily)
+		if err != nil {
+			return nil, err
+		}
+
+		// Always install a policy program, even an empty one, so that a program
+		// left in the jump map by untracked policy cannot be used.
+		rules := polprog.Rules{
+			ForHostInterface: true,
+			ForXDP:           true,
+			XDPDenyOnly:      true,
+		}
+		if m.xdpPreDNATDeny && ep != nil {
+			ap.Log().Infof("Building deny-only program for pre-DNAT policy hep=%v, family=%v", ep.Name, d.ipFamily)
+			rules.HostPreDnatTiers = m.extractTiers(ep.PreDnatTiers, PolDirnIngress, NoEndTierDrop)
+		}
+		err = m.updatePolicyProgramFn(rules, "xdp", ap, d.ipFamily)
+		ap.Log().WithError(err).Debug("Applied deny-only XDP policy")
+		return ap, err
 	}
 	return ap, nil
 }
 
+type xdpProgramKind int
+
+const (
+	xdpProgramNone xdpProgramKind = iota
+	// xdpProgramUntracked enforces untracked policy, which is only enforced in XDP.
+	xdpProgramUntracked
+	// xdpProgramDenyOnly enforces the Deny rules of pre-DNAT policy and the failsafe
+	// rate limit.  Everything else is left to TC.
+	xdpProgramDenyOnly
+)
+
+// xdpProgramFor returns what kind of XDP program, if any, is needed for a data
+// interface with the given host endpoint.
+func (m *bpfEndpointManager) xdpProgramFor(ep *proto.HostEndpoint) xdpProgramKind {
+	if ep != nil && len(ep.UntrackedTiers) == 1 {
+		return xdpProgramUntracked
+	}
+	if m.xdpFailsafeRateLimit > 0 || m.hasPreDNATDenyRules(ep) {
+		return xdpProgramDenyOnly
+	}
+	return xdpProgramNone
+}
+
+func (m *bpfEndpointManager) hasPreDNATDenyRules(ep *proto.HostEndpoint) bool {
+	if !m.xdpPreDNATDeny || ep == nil {
+		return false
+	}
+	for _, tier := range ep.PreDnatTiers {
+		for _, polName := range tier.IngressPolicies {
+			pol := m.policies[types.PolicyID{Tier: tier.Name, Name: polName}]
+			if pol == nil {
+				continue
+			}
+			for _, r := range pol.InboundRules {
+				if strings.EqualFold(r.Action, "deny") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // PolDirection is the Calico datamodel direction of policy.  On a host endpoint, ingress is towards the host.
 // On a workload endpoint, ingress is towards the workload.
 type PolDirection int
@@ -2909,6 +3211,7 @@ func (m *bpfEndpointManager) calculateTCAttachPoint(ifaceName string) *tc.Attach
 	}
 
 	ap.ToHostDrop = (m.epToHostAction == "DROP")
+	ap.NATStats = m.serviceStats
 	ap.FIB = m.fibLookupEnabled
 	ap.DSR = m.dsrEnabled
 	ap.DSROptoutCIDRs = m.dsrOptoutCidrs
@@ -2918,6 +3221,8 @@ func (m *bpfEndpointManager) calculateTCAttachPoint(ifaceName string) *tc.Attach
 	ap.PSNATEnd = m.psnatPorts.MaxPort
 	ap.TunnelMTU = uint16(m.vxlanMTU)
 	ap.Profiling = m.profiling
+	ap.RejectRateLimit = m.rejectRateLimit
+	ap.DNSSnoop = m.dnsSnoop
 
 	switch m.rpfEnforceOption {
 	case "Strict":
@@ -2990,12 +3295,17 @@ func (m *bpfEndpointManager) extractTiers(tiers []*proto.TierInfo, direction Pol
 				Policies: make([]polprog.Policy, len(directionalPols)),
 			}
 
+			allStaged := true
 			for i, polName := range directionalPols {
 				pol := m.policies[types.PolicyID{Tier: tier.Name, Name: polName}]
 				if pol == nil {
 					log.WithField("tier", tier).Warn("Tier refers to unknown policy!")
+					allStaged = false
 					continue
 				}
+				if !pol.Staged {
+					allStaged = false
+				}
 				var prules []*proto.Rule
 				if direction == PolDirnIngress {
 					prules = pol.InboundRules
@@ -3003,8 +3313,9 @@ func (m *bpfEndpointManager) extractTiers(tiers []*proto.TierInfo, direction Pol
 					prules = pol.OutboundRules
 				}
 				policy := polprog.Policy{
-					Name:  polName,
-					Rules: make([]polprog.Rule, len(prules)),
+					Name:   polName,
+					Rules:  make([]polprog.Rule, len(prules)),
+					Staged: pol.Staged,
 				}
 
 				for ri, r := range prules {
@@ -3017,7 +3328,8 @@ func (m *bpfEndpointManager) extractTiers(tiers []*proto.TierInfo, direction Pol
 				polTier.Policies[i] = policy
 			}
 
-			if endTierDrop && tier.DefaultAction != string(apiv3.Pass) {
+			// A tier that only has staged policies must not drop traffic.
+			if endTierDrop && tier.DefaultAction != string(apiv3.Pass) && !allStaged {
 				polTier.EndAction = polprog.TierEndDeny
 			} else {
 				polTier.EndAction = polprog.TierEndPass
@@ -3474,6 +3786,25 @@ func (m *bpfEndpointManager) ensureQdisc(iface string) (bool, error) {
 	return tc.EnsureQdisc(iface)
 }
 
+func (m *bpfEndpointManager) ensureFQQdisc(iface string) (bool, error) {
+	return tc.EnsureFQQdisc(iface)
+}
+
+func (m *bpfEndpointManager) removeFQQdisc(iface string) error {
+	return tc.RemoveFQQdisc(iface)
+}
+
+// isEgressBandwidthIface returns true if the egress bandwidth limits of
+// workloads are enforced on the interface, that is, if traffic from workloads
+// leaves the host through it.
+func (m *bpfEndpointManager) isEgressBandwidthIface(iface string) bool {
+	switch m.getEndpointType(iface) {
+	case tcdefs.EpTypeHost, tcdefs.EpTypeTunnel, tcdefs.EpTypeL3Device:
+		return true
+	}
+	return false
+}
+
 func (m *bpfEndpointManager) loadTCObj(at hook.AttachType) (hook.Layout, error) {
 	pm := m.commonMaps.ProgramsMap.(*hook.ProgramsMap)
 
@@ -3832,6 +4163,9 @@ func (m *bpfEndpointManager) doUpdatePolicyProgram(
 		stride = jump.XDPMaxEntryPoints
 	}
 	opts = append(opts, polprog.WithPolicyMapIndexAndStride(polJumpMapIdx, stride))
+	if m.policyAuditEnabled && hk != hook.XDP {
+		opts = append(opts, polprog.WithAuditEventsMapFD(m.commonMaps.AuditEventsMap.MapFD()))
+	}
 	progFDs, insns, err := m.loadPolicyProgramFn(
 		progName,
 		ipFamily,
@@ -4157,6 +4491,12 @@ func (m *bpfEndpointManager) addRuleInfo(rule *proto.Rule, idx int,
 }
 
 func (m *bpfEndpointManager) ruleMatchID(dir, action, owner, name string, idx int) polprog.RuleMatchID {
+	return bpfRuleMatchID(dir, action, owner, name, idx)
+}
+
+// bpfRuleMatchID returns the ID that the policy programs use for a rule, it is
+// what the rule counters and the audit events refer to.
+func bpfRuleMatchID(dir, action, owner, name string, idx int) polprog.RuleMatchID {
 	h := fnv.New64a()
 	h.Write([]byte(action + owner + dir + strconv.Itoa(idx) + name))
 	return h.Sum64()
@@ -34,6 +34,7 @@ import (
 
 	"github.com/projectcalico/calico/felix/bpf"
 	"github.com/projectcalico/calico/felix/bpf/asm"
+	"github.com/projectcalico/calico/felix/bpf/bandwidth"
 	"github.com/projectcalico/calico/felix/bpf/bpfmap"
 	"github.com/projectcalico/calico/felix/bpf/conntrack"
 	"github.com/projectcalico/calico/felix/bpf/counters"
@@ -68,6 +69,7 @@ type mockDataplane struct {
 	lastProgID  int
 	progs       map[string]int
 	numAttaches map[string]int
+	fqQdiscs    set.Set[string]
 	policy      map[string]polprog.Rules
 	routes      map[ip.CIDR]struct{}
 	netlinkShim netlinkshim.Interface
@@ -87,6 +89,7 @@ func newMockDataplane() *mockDataplane {
 		lastProgID:  5,
 		progs:       map[string]int{},
 		numAttaches: map[string]int{},
+		fqQdiscs:    set.New[string](),
 		policy:      map[string]polprog.Rules{},
 		routes:      map[ip.CIDR]struct{}{},
 		netlinkShim: netlinkShim,
@@ -164,6 +167,20 @@ func (m *mockDataplane) ensureQdisc(iface string) (bool, error) {
 	return false, nil
 }
 
+func (m *mockDataplane) ensureFQQdisc(iface string) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.fqQdiscs.Add(iface)
+	return true, nil
+}
+
+func (m *mockDataplane) removeFQQdisc(iface string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.fqQdiscs.Discard(iface)
+	return nil
+}
+
 func (m *mockDataplane) updatePolicyProgram(rules polprog.Rules, polDir string, ap attachPoint, ipFamily proto.IPVersion) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -355,6 +372,9 @@ var _ = Describe("BPF Endpoint Manager", func() {
 		ipSetIDAllocatorV6   *idalloc.IDAllocator
 		vxlanMTU             int
 		nodePortDSR          bool
+		xdpPreDNATDeny       string
+		xdpFailsafeRateLimit int
+		egressBandwidth      string
 		maps                 *bpfmap.Maps
 		v4Maps               *bpfmap.IPMaps
 		v6Maps               *bpfmap.IPMaps
@@ -379,6 +399,9 @@ var _ = Describe("BPF Endpoint Manager", func() {
 		ipSetIDAllocatorV6 = idalloc.New()
 		vxlanMTU = 0
 		nodePortDSR = true
+		xdpPreDNATDeny = "Disabled"
+		xdpFailsafeRateLimit = 0
+		egressBandwidth = "Disabled"
 
 		bpfmaps.EnableRepin()
 
@@ -402,6 +425,7 @@ var _ = Describe("BPF Endpoint Manager", func() {
 		countersMap = mock.NewMockMap(cparams)
 		commonMaps.CountersMap = countersMap
 		commonMaps.RuleCountersMap = mock.NewMockMap(counters.PolicyMapParameters)
+		commonMaps.BandwidthMap = mock.NewMockMap(bandwidth.MapParams)
 
 		progsParams := bpfmaps.MapParameters{
 			Type:       "prog_array",
@@ -467,6 +491,9 @@ var _ = Describe("BPF Endpoint Manager", func() {
 				BPFHostNetworkedNAT:     "Enabled",
 				BPFPolicyDebugEnabled:   true,
 				BPFIpv6Enabled:          ipv6Enabled,
+				BPFXDPPreDNATDeny:       xdpPreDNATDeny,
+				BPFXDPFailsafeRateLimit: xdpFailsafeRateLimit,
+				BPFEgressBandwidth:      egressBandwidth,
 			},
 			maps,
 			fibLookupEnabled,
@@ -1007,6 +1034,69 @@ var _ = Describe("BPF Endpoint Manager", func() {
 		})
 	})
 
+	Context("with egress bandwidth enabled", func() {
+		BeforeEach(func() {
+			egressBandwidth = "Enabled"
+		})
+
+		genWLUpdateWithBandwidth := func(name string, bw int64) func() {
+			return func() {
+				bpfEpMgr.OnUpdate(&proto.WorkloadEndpointUpdate{
+					Id: &proto.WorkloadEndpointID{
+						OrchestratorId: "k8s",
+						WorkloadId:     "default/" + name,
+						EndpointId:     "eth0",
+					},
+					Endpoint: &proto.WorkloadEndpoint{
+						Name:        name,
+						QosControls: &proto.QoSControls{EgressBandwidth: bw},
+					},
+				})
+				err := bpfEpMgr.CompleteDeferredWork()
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+
+		getLimit := func(id int) (bandwidth.Value, error) {
+			vb, err := commonMaps.BandwidthMap.Get(bandwidth.NewKey(uint32(id)).AsBytes())
+			return bandwidth.ValueFromBytes(vb), err
+		}
+
+		It("should enforce the limits of workloads on the host interfaces", func() {
+			genIfaceUpdate("eth0", ifacemonitor.StateUp, 3)()
+			Expect(dp.fqQdiscs.Contains("eth0")).To(BeTrue())
+
+			genWLUpdateWithBandwidth("cali12345", 10000000)()
+			genIfaceUpdate("cali12345", ifacemonitor.StateUp, 15)()
+			Expect(dp.fqQdiscs.Contains("cali12345")).To(BeFalse())
+
+			id := bpfEpMgr.nameToIface["cali12345"].dpState.edtID
+			Expect(id).NotTo(BeZero())
+			v, err := getLimit(id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Rate()).To(Equal(uint64(1250000)))
+
+			workload, endpoint, ok := bpfEpMgr.edtIDToWorkload(uint32(id))
+			Expect(ok).To(BeTrue())
+			Expect(workload).To(Equal("default/cali12345"))
+			Expect(endpoint).To(Equal("eth0"))
+
+			By("removing the limit")
+			genWLUpdateWithBandwidth("cali12345", 0)()
+			Expect(bpfEpMgr.nameToIface["cali12345"].dpState.edtID).To(Equal(id))
+			v, err = getLimit(id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Rate()).To(BeZero())
+
+			By("removing the interface")
+			genIfaceUpdate("cali12345", ifacemonitor.StateNotPresent, 15)()
+			_, err = getLimit(id)
+			Expect(bpfmaps.IsNotExists(err)).To(BeTrue())
+			_, _, ok = bpfEpMgr.edtIDToWorkload(uint32(id))
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Context("Ifacetype detection", func() {
 		JustBeforeEach(func() {
 			err := dp.createIface("vxlan0", 10, "vxlan")
@@ -1390,6 +1480,67 @@ var _ = Describe("BPF Endpoint Manager", func() {
 			})
 		})
 
+		Context("with eth0 host endpoint and XDP pre-DNAT deny enabled", func() {
+			BeforeEach(func() {
+				xdpPreDNATDeny = "Enabled"
+			})
+			JustBeforeEach(genHEPUpdate("eth0", hostEp))
+
+			It("enforces pre-DNAT deny rules in XDP", func() {
+				var eth0X *polprog.Rules
+
+				// Check no XDP while the policy has no deny rules.
+				Eventually(dp.setAndReturn(&eth0X, "eth0:xdp")).Should(BeNil())
+
+				By("adding a deny rule to the pre-DNAT policy")
+				bpfEpMgr.OnUpdate(&proto.ActivePolicyUpdate{
+					Id: &proto.PolicyID{Tier: "default", Name: "default.mypolicy"},
+					Policy: &proto.Policy{
+						InboundRules: []*proto.Rule{{Action: "Deny", SrcNet: []string{"10.0.0.0/8"}}},
+					},
+				})
+				err := bpfEpMgr.CompleteDeferredWork()
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(dp.setAndReturn(&eth0X, "eth0:xdp")).ShouldNot(BeNil())
+				Expect(eth0X.ForXDP).To(BeTrue())
+				Expect(eth0X.XDPDenyOnly).To(BeTrue())
+				Expect(eth0X.HostPreDnatTiers).To(HaveLen(1))
+				Expect(eth0X.HostPreDnatTiers[0].Policies).To(HaveLen(1))
+				Expect(eth0X.HostPreDnatTiers[0].EndAction).To(Equal(polprog.TierEndPass))
+
+				By("adding untracked policy")
+				genUntracked("default", "untracked1")()
+				newHEP := googleproto.Clone(hostEp).(*proto.HostEndpoint)
+				newHEP.UntrackedTiers = []*proto.TierInfo{{
+					Name:            "default",
+					IngressPolicies: []string{"untracked1"},
+				}}
+				genHEPUpdate("eth0", newHEP)()
+
+				// Untracked policy takes over the XDP program.
+				Eventually(dp.setAndReturn(&eth0X, "eth0:xdp")).ShouldNot(BeNil())
+				Expect(eth0X.XDPDenyOnly).To(BeFalse())
+				Expect(eth0X.HostNormalTiers).To(HaveLen(1))
+			})
+		})
+
+		Context("with failsafe rate limit", func() {
+			BeforeEach(func() {
+				xdpFailsafeRateLimit = 100
+			})
+
+			It("attaches XDP without a host endpoint", func() {
+				var eth0X *polprog.Rules
+
+				Eventually(dp.setAndReturn(&eth0X, "eth0:xdp")).ShouldNot(BeNil())
+				Expect(eth0X.ForXDP).To(BeTrue())
+				Expect(eth0X.XDPDenyOnly).To(BeTrue())
+				Expect(eth0X.HostPreDnatTiers).To(BeEmpty())
+				Expect(dp.programAttached("eth0:xdp")).To(BeTrue())
+			})
+		})
+
 		Context("with host-* endpoint", func() {
 			JustBeforeEach(genHEPUpdate(allInterfaces, hostEp))
 
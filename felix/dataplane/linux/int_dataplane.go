@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"reflect"
@@ -36,7 +37,9 @@ import (
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/projectcalico/calico/felix/auditlog"
 	"github.com/projectcalico/calico/felix/bpf"
+	bpfaudit "github.com/projectcalico/calico/felix/bpf/audit"
 	"github.com/projectcalico/calico/felix/bpf/bpfmap"
 	bpfconntrack "github.com/projectcalico/calico/felix/bpf/conntrack"
 	"github.com/projectcalico/calico/felix/bpf/failsafes"
@@ -45,6 +48,7 @@ import (
 	bpfmaps "github.com/projectcalico/calico/felix/bpf/maps"
 	"github.com/projectcalico/calico/felix/bpf/nat"
 	bpfnat "github.com/projectcalico/calico/felix/bpf/nat"
+	"github.com/projectcalico/calico/felix/bpf/perf"
 	bpfproxy "github.com/projectcalico/calico/felix/bpf/proxy"
 	bpfroutes "github.com/projectcalico/calico/felix/bpf/routes"
 	"github.com/projectcalico/calico/felix/bpf/tc"
@@ -54,6 +58,7 @@ import (
 	"github.com/projectcalico/calico/felix/dataplane/common"
 	dpsets "github.com/projectcalico/calico/felix/dataplane/ipsets"
 	"github.com/projectcalico/calico/felix/dataplane/linux/dataplanedefs"
+	"github.com/projectcalico/calico/felix/dnsresolver"
 	"github.com/projectcalico/calico/felix/environment"
 	"github.com/projectcalico/calico/felix/generictables"
 	"github.com/projectcalico/calico/felix/idalloc"
@@ -65,6 +70,7 @@ import (
 	"github.com/projectcalico/calico/felix/labelindex"
 	"github.com/projectcalico/calico/felix/logutils"
 	"github.com/projectcalico/calico/felix/netlinkshim"
+	"github.com/projectcalico/calico/felix/nfnetlink"
 	"github.com/projectcalico/calico/felix/nftables"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/routerule"
@@ -150,6 +156,13 @@ type Config struct {
 
 	MaxIPSetSize int
 
+	DNSExtraTTL time.Duration
+
+	PolicyAuditLogFilePath      string
+	PolicyAuditLogFileMaxSizeMB int
+	PolicyAuditLogFileMaxFiles  int
+	PolicyAuditLogCollector     string
+
 	RouteSyncDisabled              bool
 	IptablesBackend                string
 	IPSetsRefreshInterval          time.Duration
@@ -219,6 +232,9 @@ type Config struct {
 	BPFMapSizeConntrack                int
 	BPFMapSizePerCPUConntrack          int
 	BPFMapSizeConntrackCleanupQueue    int
+	BPFMapSizeConntrackScaling         string
+	BPFMapSizeConntrackScalingPercent  int
+	BPFMapSizeConntrackScalingMax      int
 	BPFMapSizeNATFrontend              int
 	BPFMapSizeNATBackend               int
 	BPFMapSizeNATAffinity              int
@@ -231,6 +247,10 @@ type Config struct {
 	BPFExcludeCIDRsFromNAT             []string
 	BPFRedirectToPeer                  string
 	BPFProfiling                       string
+	BPFXDPPreDNATDeny                  string
+	BPFXDPFailsafeRateLimit            int
+	BPFServiceStats                    string
+	BPFEgressBandwidth                 string
 	KubeProxyMinSyncPeriod             time.Duration
 	SidecarAccelerationEnabled         bool
 	ServiceLoopPrevention              string
@@ -314,11 +334,21 @@ type InternalDataplane struct {
 	ifaceMonitor *ifacemonitor.InterfaceMonitor
 	ifaceUpdates chan any
 
+	// domainStore holds the domain name mappings learned by snooping on DNS responses.  Nil
+	// if there are no trusted DNS servers.
+	domainStore *dnsresolver.DomainStore
+
+	// auditLogger writes the audit records of policy rules with audit enabled.  Nil if there
+	// is no sink for them.  In BPF mode, the records come from auditEventsMap.
+	auditLogger    *auditlog.Logger
+	auditEventsMap bpfmaps.Map
+
 	endpointStatusCombiner *endpointStatusCombiner
 
 	allManagers             []Manager
 	managersWithRouteTables []ManagerWithRouteTables
 	managersWithRouteRules  []ManagerWithRouteRules
+	managersWithStop        []ManagerWithStop
 	ruleRenderer            rules.RuleRenderer
 
 	// datastoreInSync is set to true after we receive the "in sync" message from the datastore.
@@ -725,6 +755,32 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 
 	ipsetsManager := dpsets.NewIPSetsManager("ipv4", ipSetsV4, config.MaxIPSetSize)
 	ipsetsManagerV6 := dpsets.NewIPSetsManager("ipv6", nil, config.MaxIPSetSize)
+	if len(config.RulesConfig.DNSTrustedServers) > 0 {
+		dp.domainStore = dnsresolver.NewDomainStore(config.RulesConfig.DNSTrustedServers, config.DNSExtraTTL)
+		ipsetsManager.SetDomainStore(dp.domainStore)
+		dp.domainStore.RegisterHandler(ipsetsManager)
+		ipsetsManagerV6.SetDomainStore(dp.domainStore)
+		dp.domainStore.RegisterHandler(ipsetsManagerV6)
+	}
+	if config.RulesConfig.PolicyAuditEnabled {
+		var sinks []io.WriteCloser
+		if config.PolicyAuditLogFilePath != "" {
+			sinks = append(sinks, auditlog.NewFileSink(
+				config.PolicyAuditLogFilePath,
+				config.PolicyAuditLogFileMaxSizeMB,
+				config.PolicyAuditLogFileMaxFiles,
+			))
+		}
+		if config.PolicyAuditLogCollector != "" {
+			sinks = append(sinks, auditlog.NewCollectorSink(config.PolicyAuditLogCollector))
+		}
+		var matchIDFunc auditlog.MatchIDFunc
+		if config.BPFEnabled {
+			matchIDFunc = bpfRuleMatchID
+		}
+		dp.auditLogger = auditlog.New(sinks, matchIDFunc)
+		dp.RegisterManager(dp.auditLogger)
+	}
 
 	var mangleTableV6, natTableV6, rawTableV6, filterTableV6 generictables.Table
 	var nftablesV6RootTable *nftables.NftablesTable
@@ -800,6 +856,7 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	bpfnat.SetMapSizes(config.BPFMapSizeNATFrontend, config.BPFMapSizeNATBackend, config.BPFMapSizeNATAffinity)
 	bpfroutes.SetMapSize(config.BPFMapSizeRoute)
 	bpfconntrack.SetMapSize(bpfMapSizeConntrack)
+	bpfconntrack.SetMapAutoScaling(config.BPFMapSizeConntrackScaling == string(apiv3.BPFConntrackMapScalingDoubleIfFull))
 	bpfconntrack.SetCleanupMapSize(config.BPFMapSizeConntrackCleanupQueue)
 	bpfifstate.SetMapSize(config.BPFMapSizeIfState)
 
@@ -812,6 +869,7 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		if err != nil {
 			log.WithError(err).Panic("error creating bpf maps")
 		}
+		dp.auditEventsMap = bpfMaps.CommonMaps.AuditEventsMap
 
 		// Register map managers first since they create the maps that will be used by the endpoint manager.
 		// Important that we create the maps before we load a BPF program with TC since we make sure the map
@@ -897,7 +955,8 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 
 			// Activate the connect-time load balancer.
 			err = bpfnat.InstallConnectTimeLoadBalancer(true, config.BPFIpv6Enabled,
-				config.BPFCgroupV2, logLevel, config.BPFConntrackTimeouts.UDPTimeout, excludeUDP)
+				config.BPFCgroupV2, logLevel, config.BPFConntrackTimeouts.UDPTimeout, excludeUDP,
+				config.BPFServiceStats == "Enabled")
 			if err != nil {
 				log.WithError(err).Panic("BPFConnTimeLBEnabled but failed to attach connect-time load balancer, bailing out.")
 			}
@@ -1387,6 +1446,13 @@ type ManagerWithRouteRules interface {
 	GetRouteRules() []routeRules
 }
 
+// ManagerWithStop is a Manager that runs work in the background that needs to
+// be stopped when felix shuts down.
+type ManagerWithStop interface {
+	Manager
+	Stop()
+}
+
 type routeRules interface {
 	SetRule(rule *routerule.Rule)
 	RemoveRule(rule *routerule.Rule)
@@ -1425,6 +1491,11 @@ func (d *InternalDataplane) RegisterManager(mgr Manager) {
 		log.WithField("manager", mgr).Debug("registering ManagerWithRouteRules")
 		d.managersWithRouteRules = append(d.managersWithRouteRules, rulesMgr)
 	}
+
+	stopMgr, ok := mgr.(ManagerWithStop)
+	if ok {
+		d.managersWithStop = append(d.managersWithStop, stopMgr)
+	}
 	d.allManagers = append(d.allManagers, mgr)
 }
 
@@ -1437,6 +1508,71 @@ func (d *InternalDataplane) Start() {
 	go d.loopReportingStatus()
 	go d.ifaceMonitor.MonitorInterfaces()
 	go d.monitorHostMTU()
+
+	if d.domainStore != nil {
+		d.startDNSSnooping()
+	}
+	if d.auditLogger != nil {
+		d.startPolicyAuditLogging()
+	}
+}
+
+// startDNSSnooping starts learning domain name mappings from the DNS responses that our
+// iptables or nftables rules copy to the DNS NFLOG group.
+func (d *InternalDataplane) startDNSSnooping() {
+	packets := make(chan *nfnetlink.NflogPacket, 1000)
+	err := nfnetlink.NflogSubscribePackets(rules.DNSNflogGroup, 2*1024*1024, packets, make(chan struct{}))
+	if err != nil {
+		log.WithError(err).Error("Failed to subscribe to DNS NFLOG group; domain-based rules will not match")
+		return
+	}
+	d.domainStore.Start(packets)
+}
+
+// startPolicyAuditLogging starts writing audit records for the connections that match rules
+// with audit enabled.  The BPF policy programs write an event to the audit events map for
+// those connections, the iptables and nftables rules copy their first packet to the audit
+// NFLOG group.
+func (d *InternalDataplane) startPolicyAuditLogging() {
+	if d.auditEventsMap != nil {
+		reader, err := perf.NewReader(d.auditEventsMap, 64)
+		if err != nil {
+			log.WithError(err).Error("Failed to open policy audit events; no audit records will be written")
+			return
+		}
+		events := make(chan bpfaudit.Event, 1000)
+		go func() {
+			defer close(events)
+			defer reader.Close()
+			err := reader.Read(make(chan struct{}), func(sample []byte) {
+				e, err := bpfaudit.ParseEvent(sample)
+				if err != nil {
+					log.WithError(err).Warn("Failed to parse policy audit event")
+					return
+				}
+				events <- e
+			})
+			log.WithError(err).Error("Stopped reading policy audit events")
+		}()
+		d.auditLogger.Start(nil, events)
+		return
+	}
+
+	packets := make(chan *nfnetlink.NflogPacket, 1000)
+	err := nfnetlink.NflogSubscribePackets(rules.AuditNflogGroup, 2*1024*1024, packets, make(chan struct{}))
+	if err != nil {
+		log.WithError(err).Error("Failed to subscribe to policy audit NFLOG group; no audit records will be written")
+		return
+	}
+	d.auditLogger.Start(packets, nil)
+}
+
+// Stop stops the background work of the managers.  It is called when felix
+// shuts down.
+func (d *InternalDataplane) Stop() {
+	for _, mgr := range d.managersWithStop {
+		mgr.Stop()
+	}
 }
 
 // onIfaceInSync is used as a callback from the interface monitor.  We use it to send a message back to
@@ -1575,17 +1711,19 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 
 	rulesConfig := d.config.RulesConfig
 	for _, t := range d.filterTables {
-		fwdRules := []generictables.Rule{
-			{
-				// Bypass is a strong signal from the BPF program, it means that the flow is approved
-				// by the program at both ingress and egress.
-				Comment: []string{"Pre-approved by BPF programs."},
-				Match:   d.newMatch().MarkMatchesWithMask(tcdefs.MarkSeenBypass, tcdefs.MarkSeenBypassMask),
-				Action:  d.actions.Allow(),
-			},
-		}
-
-		var inputRules, outputRules []generictables.Rule
+		// The BPF programs send DNS responses through the host stack so that we can
+		// copy them to Felix before they are accepted.
+		fwdRules := d.ruleRenderer.DNSSnoopRules()
+		fwdRules = append(fwdRules, generictables.Rule{
+			// Bypass is a strong signal from the BPF program, it means that the flow is approved
+			// by the program at both ingress and egress.
+			Comment: []string{"Pre-approved by BPF programs."},
+			Match:   d.newMatch().MarkMatchesWithMask(tcdefs.MarkSeenBypass, tcdefs.MarkSeenBypassMask),
+			Action:  d.actions.Allow(),
+		})
+
+		var inputRules []generictables.Rule
+		outputRules := d.ruleRenderer.DNSSnoopRules()
 
 		// Handle packets for flows that pre-date the BPF programs.  The BPF program doesn't have any conntrack
 		// state for these so it allows them to fall through to iptables with a mark set.
@@ -1925,6 +2063,14 @@ func (d *InternalDataplane) shutdownXDPCompletely() error {
 	return fmt.Errorf("Failed to wipe the XDP state after %v tries over %v seconds: Error %v", maxTries, waitInterval, err)
 }
 
+// domainUpdatesC returns the domain store's update channel, or nil if there is no domain store.
+func (d *InternalDataplane) domainUpdatesC() <-chan struct{} {
+	if d.domainStore == nil {
+		return nil
+	}
+	return d.domainStore.UpdatesReadyChannel()
+}
+
 func (d *InternalDataplane) loopUpdatingDataplane() {
 	log.Info("Started internal iptables dataplane driver loop")
 	healthTicks := time.NewTicker(healthInterval).C
@@ -1969,6 +2115,9 @@ func (d *InternalDataplane) loopUpdatingDataplane() {
 			log.Debug("Refreshing XDP")
 			d.forceXDPRefresh = true
 			d.dataplaneNeedsSync = true
+		case <-d.domainUpdatesC():
+			d.domainStore.HandleUpdates()
+			d.dataplaneNeedsSync = true
 		case <-d.reschedC:
 			log.Debug("Reschedule kick received")
 			d.dataplaneNeedsSync = true
@@ -2538,6 +2687,10 @@ func startBPFDataplaneComponents(
 		bpfproxyOpts = append(bpfproxyOpts, bpfproxy.WithTopologyNodeZone(config.NodeZone))
 	}
 
+	if config.BPFServiceStats == "Enabled" {
+		bpfproxyOpts = append(bpfproxyOpts, bpfproxy.WithServiceStats())
+	}
+
 	if len(config.BPFExcludeCIDRsFromNAT) > 0 {
 		bpfproxyOpts = append(bpfproxyOpts, bpfproxy.WithExcludedCIDRs(config.BPFExcludeCIDRsFromNAT))
 	}
@@ -2599,6 +2752,20 @@ func startBPFDataplaneComponents(
 		bpfRTMgr.setHostIPUpdatesCallBack(kp.OnHostIPsUpdate)
 		bpfRTMgr.setRoutesCallBacks(kp.OnRouteUpdate, kp.OnRouteDelete)
 		conntrackScanner.AddUnlocked(bpfconntrack.NewStaleNATScanner(kp))
+		if config.BPFMapSizeConntrackScaling == string(apiv3.BPFConntrackMapScalingDoubleIfFull) {
+			// Added last so that it only counts the entries that survive the scan.
+			autoScaler, err := bpfconntrack.NewAutoScaler(
+				bpfmaps.CtMap,
+				int(ipFamily),
+				config.BPFMapSizeConntrackScalingPercent,
+				config.BPFMapSizeConntrackScalingMax,
+				config.ConfigChangedRestartCallback,
+			)
+			if err != nil {
+				log.WithError(err).Panic("Failed to create conntrack map auto scaler.")
+			}
+			conntrackScanner.AddUnlocked(autoScaler)
+		}
 		conntrackScanner.Start()
 	} else {
 		log.Info("BPF enabled but no Kubernetes client available, unable to run kube-proxy module.")
@@ -300,7 +300,7 @@ func (d *MockDataplane) OnEvent(event interface{}) {
 
 	// Test wrapping the message for the external dataplane
 	switch event := event.(type) {
-	case *calc.DatastoreNotReady:
+	case *calc.DatastoreNotReady, *calc.PolicyGenerations:
 	default:
 		_, err := extdataplane.WrapPayloadWithEnvelope(event, 0)
 		Expect(err).To(BeNil())
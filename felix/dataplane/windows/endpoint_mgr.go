@@ -31,6 +31,7 @@ import (
 	"github.com/projectcalico/calico/felix/dataplane/windows/policysets"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/types"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/names"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
@@ -255,7 +256,7 @@ func (m *endpointManager) refreshPendingWlEpUpdates(updatedPolicies []string) {
 			activePolicyNames = append(activePolicyNames, prependAll(policysets.PolicyNamePrefix, workload.Tiers[0].IngressPolicies)...)
 			activePolicyNames = append(activePolicyNames, prependAll(policysets.PolicyNamePrefix, workload.Tiers[0].EgressPolicies)...)
 
-			if len(workload.Tiers[0].IngressPolicies) > 0 && len(workload.Tiers[0].EgressPolicies) > 0 {
+			if len(enforcedPolicies(workload.Tiers[0].IngressPolicies)) > 0 && len(enforcedPolicies(workload.Tiers[0].EgressPolicies)) > 0 {
 				profilesApply = false
 			}
 		}
@@ -369,18 +370,21 @@ func (m *endpointManager) CompleteDeferredWork() error {
 			for _, t := range workload.Tiers {
 				log.Debugf("windows workload %v, tiers: %v", workload.Name, t.Name)
 				endOfTierDrop := (t.DefaultAction != string(v3.Pass))
-				if len(t.IngressPolicies) > 0 {
+				// Staged policies are not enforced on Windows.
+				ingressPolicies := enforcedPolicies(t.IngressPolicies)
+				egressPolicies := enforcedPolicies(t.EgressPolicies)
+				if len(ingressPolicies) > 0 {
 					if t.Name == names.DefaultTierName {
 						defaultTierIngressAppliesToEP = true
 					}
-					policyNames := prependAll(policysets.PolicyNamePrefix, t.IngressPolicies)
+					policyNames := prependAll(policysets.PolicyNamePrefix, ingressPolicies)
 					ingressRules = append(ingressRules, m.policysetsDataplane.GetPolicySetRules(policyNames, true, endOfTierDrop))
 				}
-				if len(t.EgressPolicies) > 0 {
+				if len(egressPolicies) > 0 {
 					if t.Name == names.DefaultTierName {
 						defaultTierEgressAppliesToEP = true
 					}
-					policyNames := prependAll(policysets.PolicyNamePrefix, t.EgressPolicies)
+					policyNames := prependAll(policysets.PolicyNamePrefix, egressPolicies)
 					egressRules = append(egressRules, m.policysetsDataplane.GetPolicySetRules(policyNames, false, endOfTierDrop))
 				}
 			}
@@ -573,6 +577,17 @@ func prependAll(prefix string, in []string) (out []string) {
 	return
 }
 
+// enforcedPolicies returns the given policy names without the staged policies.
+func enforcedPolicies(in []string) (out []string) {
+	for _, name := range in {
+		if model.PolicyIsStaged(name) {
+			continue
+		}
+		out = append(out, name)
+	}
+	return
+}
+
 // loopPollingForInterfaceAddrs periodically checks the IP addresses on the host and sends updates on the channel
 // when the IPs change.
 func loopPollingForInterfaceAddrs(c chan []string) {
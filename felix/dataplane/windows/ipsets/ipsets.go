@@ -15,6 +15,7 @@
 package ipsets
 
 import (
+	"net"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -141,6 +142,14 @@ func (s *IPSets) GetIPSetMembers(setID string) []string {
 
 // filterMembers filters out any members which are not of the correct
 // ip family for the IPSet
+// isDomain returns true if the member is a domain name rather than an IP, CIDR or IP and port.
+func isDomain(member string) bool {
+	if strings.ContainsAny(member, ":/,") {
+		return false
+	}
+	return net.ParseIP(member) == nil
+}
+
 func (s *IPSets) filterMembers(members []string, setType IPSetType) set.Set[string] {
 	filtered := set.New[string]()
 	wantIPV6 := s.IPVersionConfig.Family == IPFamilyV6
@@ -160,6 +169,10 @@ func (s *IPSets) filterMembers(members []string, setType IPSetType) set.Set[stri
 	}
 
 	for _, member := range members {
+		if isDomain(member) {
+			// Domain-based rules are not supported on Windows.
+			continue
+		}
 		if wantIPV6 != memberIsIPv6(member) {
 			continue
 		}
@@ -349,7 +349,8 @@ func (s *PolicySets) protoRuleToHnsRules(policyId string, pRule *proto.Rule, isI
 	switch strings.ToLower(ruleCopy.Action) {
 	case "", "allow":
 		aclPolicy.Action = hns.Allow
-	case "deny":
+	case "deny", "reject":
+		// HNS has no reject, the closest we can do is to drop.
 		aclPolicy.Action = hns.Block
 	case "next-tier", "pass":
 		aclPolicy.Action = ActionPass
@@ -0,0 +1,364 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dnsresolver learns the IPs behind domain names by snooping on the DNS responses that
+// trusted DNS servers send to local workloads.  The learned mappings are used to populate the IP
+// sets that back domain-based policy rules.
+package dnsresolver
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/config"
+	"github.com/projectcalico/calico/felix/nfnetlink"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+var (
+	gaugeLearnedMappings = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "felix_dns_learned_mappings",
+		Help: "Number of domain name to IP or CNAME mappings that Felix has learned from DNS responses.",
+	})
+	counterVecResponses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_dns_responses",
+		Help: "Number of DNS responses seen by Felix, broken down by result.",
+	}, []string{"result"})
+	counterMappingsExpired = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "felix_dns_mappings_expired",
+		Help: "Number of learned domain name mappings that have expired.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		gaugeLearnedMappings,
+		counterVecResponses,
+		counterMappingsExpired,
+	)
+}
+
+const (
+	resultProcessed = "processed"
+	resultUntrusted = "untrusted"
+	resultInvalid   = "invalid"
+
+	// maxCNAMEDepth bounds how far we follow a chain of CNAMEs, to protect against loops.
+	maxCNAMEDepth = 10
+
+	defaultExpiryInterval = time.Second
+)
+
+// DomainChangeHandler is implemented by components that need to know when the IPs for a domain
+// name may have changed.  OnDomainChange is called on the goroutine that calls HandleUpdates.
+type DomainChangeHandler interface {
+	OnDomainChange(name string)
+}
+
+type valueData struct {
+	expiry time.Time
+	// isName is true if the value is the target of a CNAME, rather than an IP.
+	isName bool
+}
+
+type nameData struct {
+	values map[string]*valueData
+	// parents are the names that have a CNAME to this name.
+	parents set.Set[string]
+}
+
+// DomainStore holds the domain name to IP and CNAME mappings learned from DNS responses.  Each
+// mapping expires after the TTL from the DNS response, plus the configured extra TTL.
+type DomainStore struct {
+	mutex    sync.Mutex
+	mappings map[string]*nameData
+
+	trustedServers set.Set[string]
+	extraTTL       time.Duration
+
+	// changedNames holds the names whose IPs may have changed since the last HandleUpdates.
+	changedNames set.Set[string]
+	updatesC     chan struct{}
+	handlers     []DomainChangeHandler
+
+	expiryInterval time.Duration
+	nowFunc        func() time.Time
+}
+
+func NewDomainStore(trustedServers []config.ServerPort, extraTTL time.Duration) *DomainStore {
+	s := &DomainStore{
+		mappings:       map[string]*nameData{},
+		trustedServers: set.New[string](),
+		extraTTL:       extraTTL,
+		changedNames:   set.New[string](),
+		updatesC:       make(chan struct{}, 1),
+		expiryInterval: defaultExpiryInterval,
+		nowFunc:        time.Now,
+	}
+	for _, sp := range trustedServers {
+		s.trustedServers.Add(serverKey(net.ParseIP(sp.IP), int(sp.Port)))
+	}
+	return s
+}
+
+func serverKey(ip net.IP, port int) string {
+	return net.JoinHostPort(ip.String(), fmt.Sprint(port))
+}
+
+// RegisterHandler adds a handler to be told about domain changes.  It must be called before Start.
+func (s *DomainStore) RegisterHandler(h DomainChangeHandler) {
+	s.handlers = append(s.handlers, h)
+}
+
+// UpdatesReadyChannel returns a channel that is signalled when HandleUpdates has work to do.
+func (s *DomainStore) UpdatesReadyChannel() <-chan struct{} {
+	return s.updatesC
+}
+
+// Start processes the packets from the given channel, which should carry the DNS responses
+// copied to Felix by NFLOG, and expires mappings in the background.  It returns immediately.
+func (s *DomainStore) Start(packets <-chan *nfnetlink.NflogPacket) {
+	go s.loop(packets)
+}
+
+func (s *DomainStore) loop(packets <-chan *nfnetlink.NflogPacket) {
+	expiryTicker := time.NewTicker(s.expiryInterval)
+	defer expiryTicker.Stop()
+	for {
+		select {
+		case p, ok := <-packets:
+			if !ok {
+				log.Warn("DNS snooping channel closed; no more domain mappings will be learned")
+				packets = nil
+				continue
+			}
+			s.processPacket(p)
+		case <-expiryTicker.C:
+			s.expireMappings()
+		}
+	}
+}
+
+// HandleUpdates tells the registered handlers about the names whose IPs may have changed.
+// It should be called from the dataplane's main loop after UpdatesReadyChannel fires.
+func (s *DomainStore) HandleUpdates() {
+	s.mutex.Lock()
+	changed := s.changedNames
+	s.changedNames = set.New[string]()
+	s.mutex.Unlock()
+
+	changed.Iter(func(name string) error {
+		for _, h := range s.handlers {
+			h.OnDomainChange(name)
+		}
+		return nil
+	})
+}
+
+// GetDomainIPs returns the IPs that the given domain currently resolves to, following CNAMEs.
+// A domain starting with "*." matches any subdomain, at any depth, of the rest of the name.
+func (s *DomainStore) GetDomainIPs(domain string) []string {
+	domain = strings.ToLower(domain)
+	ips := set.New[string]()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if suffix, ok := strings.CutPrefix(domain, "*"); ok {
+		for name := range s.mappings {
+			if strings.HasSuffix(name, suffix) {
+				s.collectIPs(name, ips, 0)
+			}
+		}
+	} else {
+		s.collectIPs(domain, ips, 0)
+	}
+	return ips.Slice()
+}
+
+func (s *DomainStore) collectIPs(name string, ips set.Set[string], depth int) {
+	if depth > maxCNAMEDepth {
+		log.WithField("name", name).Warn("CNAME chain too long, ignoring the rest")
+		return
+	}
+	nd := s.mappings[name]
+	if nd == nil {
+		return
+	}
+	for value, vd := range nd.values {
+		if vd.isName {
+			s.collectIPs(value, ips, depth+1)
+		} else {
+			ips.Add(value)
+		}
+	}
+}
+
+func (s *DomainStore) processPacket(p *nfnetlink.NflogPacket) {
+	var firstLayer gopacket.LayerType
+	if len(p.Payload) == 0 {
+		counterVecResponses.WithLabelValues(resultInvalid).Inc()
+		return
+	}
+	switch p.Payload[0] >> 4 {
+	case 4:
+		firstLayer = layers.LayerTypeIPv4
+	case 6:
+		firstLayer = layers.LayerTypeIPv6
+	default:
+		counterVecResponses.WithLabelValues(resultInvalid).Inc()
+		return
+	}
+	packet := gopacket.NewPacket(p.Payload, firstLayer, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+
+	var srcIP net.IP
+	if ipv4, ok := packet.NetworkLayer().(*layers.IPv4); ok {
+		srcIP = ipv4.SrcIP
+	} else if ipv6, ok := packet.NetworkLayer().(*layers.IPv6); ok {
+		srcIP = ipv6.SrcIP
+	}
+	udp, _ := packet.Layer(layers.LayerTypeUDP).(*layers.UDP)
+	if srcIP == nil || udp == nil {
+		counterVecResponses.WithLabelValues(resultInvalid).Inc()
+		return
+	}
+	// Decode the DNS layer ourselves; gopacket only does so automatically for port 53.
+	dns := &layers.DNS{}
+	if err := dns.DecodeFromBytes(udp.Payload, gopacket.NilDecodeFeedback); err != nil || !dns.QR {
+		log.WithError(err).Debug("Ignoring packet that is not a DNS response")
+		counterVecResponses.WithLabelValues(resultInvalid).Inc()
+		return
+	}
+
+	// The response may come straight from a trusted server or, if the trusted server is a
+	// Kubernetes service, from one of its backends; in that case the conntrack entry tells us
+	// which service the request was sent to.
+	trusted := s.trustedServers.Contains(serverKey(srcIP, int(udp.SrcPort)))
+	if !trusted && p.IsDNAT {
+		ct := p.OriginalTuple
+		trusted = s.trustedServers.Contains(serverKey(net.IP(ct.Dst[:]), ct.L4Dst.Port))
+	}
+	if !trusted {
+		log.WithField("src", srcIP).Debug("Ignoring DNS response from untrusted server")
+		counterVecResponses.WithLabelValues(resultUntrusted).Inc()
+		return
+	}
+	counterVecResponses.WithLabelValues(resultProcessed).Inc()
+
+	for _, rr := range dns.Answers {
+		s.processResourceRecord(rr)
+	}
+}
+
+func (s *DomainStore) processResourceRecord(rr layers.DNSResourceRecord) {
+	if rr.Class != layers.DNSClassIN {
+		return
+	}
+	name := strings.ToLower(string(rr.Name))
+	ttl := time.Duration(rr.TTL) * time.Second
+	switch rr.Type {
+	case layers.DNSTypeA, layers.DNSTypeAAAA:
+		s.storeMapping(name, rr.IP.String(), ttl, false)
+	case layers.DNSTypeCNAME:
+		s.storeMapping(name, strings.ToLower(string(rr.CNAME)), ttl, true)
+	}
+}
+
+func (s *DomainStore) storeMapping(name, value string, ttl time.Duration, isName bool) {
+	expiry := s.nowFunc().Add(ttl + s.extraTTL)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	nd := s.getOrCreateNameData(name)
+	if vd := nd.values[value]; vd != nil {
+		// Already known; just extend its lifetime.
+		if expiry.After(vd.expiry) {
+			vd.expiry = expiry
+		}
+		return
+	}
+	log.WithFields(log.Fields{"name": name, "value": value, "ttl": ttl}).Debug("Learned new domain mapping")
+	nd.values[value] = &valueData{expiry: expiry, isName: isName}
+	if isName {
+		s.getOrCreateNameData(value).parents.Add(name)
+	}
+	gaugeLearnedMappings.Inc()
+	s.markChanged(name, 0)
+}
+
+func (s *DomainStore) getOrCreateNameData(name string) *nameData {
+	nd := s.mappings[name]
+	if nd == nil {
+		nd = &nameData{values: map[string]*valueData{}, parents: set.New[string]()}
+		s.mappings[name] = nd
+	}
+	return nd
+}
+
+func (s *DomainStore) expireMappings() {
+	now := s.nowFunc()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for name, nd := range s.mappings {
+		for value, vd := range nd.values {
+			if vd.expiry.After(now) {
+				continue
+			}
+			log.WithFields(log.Fields{"name": name, "value": value}).Debug("Domain mapping expired")
+			delete(nd.values, value)
+			if vd.isName {
+				if target := s.mappings[value]; target != nil {
+					target.parents.Discard(name)
+				}
+			}
+			gaugeLearnedMappings.Dec()
+			counterMappingsExpired.Inc()
+			s.markChanged(name, 0)
+		}
+	}
+	// Clean up names that are no longer referenced.  Done as a second pass so that parents are
+	// still known while the changes above are propagated.
+	for name, nd := range s.mappings {
+		if len(nd.values) == 0 && nd.parents.Len() == 0 {
+			delete(s.mappings, name)
+		}
+	}
+}
+
+// markChanged records that the IPs of name, and of any name with a CNAME to it, may have
+// changed.  Must be called with the mutex held.
+func (s *DomainStore) markChanged(name string, depth int) {
+	if depth > maxCNAMEDepth || s.changedNames.Contains(name) {
+		return
+	}
+	s.changedNames.Add(name)
+	if nd := s.mappings[name]; nd != nil {
+		nd.parents.Iter(func(parent string) error {
+			s.markChanged(parent, depth+1)
+			return nil
+		})
+	}
+	select {
+	case s.updatesC <- struct{}{}:
+	default:
+	}
+}
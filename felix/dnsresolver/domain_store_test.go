@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsresolver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/config"
+	"github.com/projectcalico/calico/felix/nfnetlink"
+)
+
+type recordingHandler struct {
+	names []string
+}
+
+func (h *recordingHandler) OnDomainChange(name string) {
+	h.names = append(h.names, name)
+}
+
+func aRecord(name, ip string, ttl uint32) layers.DNSResourceRecord {
+	return layers.DNSResourceRecord{
+		Name:  []byte(name),
+		Type:  layers.DNSTypeA,
+		Class: layers.DNSClassIN,
+		TTL:   ttl,
+		IP:    net.ParseIP(ip).To4(),
+	}
+}
+
+func cnameRecord(name, target string, ttl uint32) layers.DNSResourceRecord {
+	return layers.DNSResourceRecord{
+		Name:  []byte(name),
+		Type:  layers.DNSTypeCNAME,
+		Class: layers.DNSClassIN,
+		TTL:   ttl,
+		CNAME: []byte(target),
+	}
+}
+
+func dnsResponse(t *testing.T, src string, srcPort uint16, answers ...layers.DNSResourceRecord) *nfnetlink.NflogPacket {
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.ParseIP(src).To4(),
+		DstIP:    net.ParseIP("10.65.0.2").To4(),
+	}
+	udp := &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: 40000}
+	Expect(udp.SetNetworkLayerForChecksum(ip)).To(Succeed())
+	dns := &layers.DNS{
+		ID:      1,
+		QR:      true,
+		ANCount: uint16(len(answers)),
+		Answers: answers,
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, ip, udp, dns); err != nil {
+		t.Fatalf("Failed to serialize packet: %v", err)
+	}
+	return &nfnetlink.NflogPacket{Payload: buf.Bytes()}
+}
+
+func newTestStore() (*DomainStore, *time.Time) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewDomainStore([]config.ServerPort{{IP: "10.96.0.10", Port: 53}}, 5*time.Second)
+	s.nowFunc = func() time.Time { return now }
+	return s, &now
+}
+
+func TestDomainStoreLearnsFromTrustedServer(t *testing.T) {
+	RegisterTestingT(t)
+	s, _ := newTestStore()
+	h := &recordingHandler{}
+	s.RegisterHandler(h)
+
+	s.processPacket(dnsResponse(t, "10.96.0.10", 53, aRecord("Example.com", "1.2.3.4", 30)))
+	Expect(s.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4"))
+	Expect(s.UpdatesReadyChannel()).To(Receive())
+
+	s.HandleUpdates()
+	Expect(h.names).To(ConsistOf("example.com"))
+}
+
+func TestDomainStoreIgnoresUntrustedServer(t *testing.T) {
+	RegisterTestingT(t)
+	s, _ := newTestStore()
+
+	s.processPacket(dnsResponse(t, "10.96.0.11", 53, aRecord("example.com", "1.2.3.4", 30)))
+	s.processPacket(dnsResponse(t, "10.96.0.10", 5353, aRecord("example.com", "1.2.3.5", 30)))
+	Expect(s.GetDomainIPs("example.com")).To(BeEmpty())
+	Expect(s.UpdatesReadyChannel()).NotTo(Receive())
+}
+
+func TestDomainStoreTrustsServiceBackend(t *testing.T) {
+	RegisterTestingT(t)
+	s, _ := newTestStore()
+
+	p := dnsResponse(t, "10.65.1.7", 53, aRecord("example.com", "1.2.3.4", 30))
+	p.IsDNAT = true
+	copy(p.OriginalTuple.Dst[:], net.ParseIP("10.96.0.10").To16())
+	p.OriginalTuple.L4Dst.Port = 53
+	s.processPacket(p)
+	Expect(s.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4"))
+}
+
+func TestDomainStoreCNAMEsAndWildcards(t *testing.T) {
+	RegisterTestingT(t)
+	s, _ := newTestStore()
+	h := &recordingHandler{}
+	s.RegisterHandler(h)
+
+	s.processPacket(dnsResponse(t, "10.96.0.10", 53,
+		cnameRecord("www.example.com", "lb.example.net", 30),
+		aRecord("lb.example.net", "1.2.3.4", 30),
+	))
+	Expect(s.GetDomainIPs("www.example.com")).To(ConsistOf("1.2.3.4"))
+	Expect(s.GetDomainIPs("*.example.com")).To(ConsistOf("1.2.3.4"))
+	Expect(s.GetDomainIPs("*.www.example.com")).To(BeEmpty())
+	s.HandleUpdates()
+
+	// A new IP behind the CNAME should be reported against the name that points to it too.
+	h.names = nil
+	s.processPacket(dnsResponse(t, "10.96.0.10", 53, aRecord("lb.example.net", "1.2.3.5", 30)))
+	Expect(s.GetDomainIPs("www.example.com")).To(ConsistOf("1.2.3.4", "1.2.3.5"))
+	s.HandleUpdates()
+	Expect(h.names).To(ConsistOf("lb.example.net", "www.example.com"))
+}
+
+func TestDomainStoreExpiry(t *testing.T) {
+	RegisterTestingT(t)
+	s, now := newTestStore()
+	h := &recordingHandler{}
+	s.RegisterHandler(h)
+
+	s.processPacket(dnsResponse(t, "10.96.0.10", 53,
+		aRecord("example.com", "1.2.3.4", 30),
+		aRecord("example.com", "1.2.3.5", 60),
+	))
+	s.HandleUpdates()
+	h.names = nil
+
+	// The extra TTL keeps the mapping alive past its TTL.
+	*now = now.Add(32 * time.Second)
+	s.expireMappings()
+	Expect(s.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4", "1.2.3.5"))
+	Expect(h.names).To(BeEmpty())
+
+	*now = now.Add(5 * time.Second)
+	s.expireMappings()
+	Expect(s.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.5"))
+	s.HandleUpdates()
+	Expect(h.names).To(ConsistOf("example.com"))
+
+	*now = now.Add(time.Minute)
+	s.expireMappings()
+	Expect(s.GetDomainIPs("example.com")).To(BeEmpty())
+	Expect(s.mappings).To(BeEmpty())
+}
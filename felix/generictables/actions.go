@@ -27,6 +27,7 @@ type ActionFactory interface {
 	Jump(target string) Action
 	NoTrack() Action
 	Log(prefix string) Action
+	Nflog(group uint16, prefix string, size int) Action
 	SNAT(ip string) Action
 	DNAT(ip string, port uint16) Action
 	Masq(toPorts string) Action
@@ -36,7 +37,13 @@ type ActionFactory interface {
 
 type RejectWith string
 
-const RejectWithTCPReset RejectWith = "tcp-reset"
+const (
+	RejectWithTCPReset RejectWith = "tcp-reset"
+	// RejectWithAdminProhibited and RejectWithAdminProhibitedV6 reply with an ICMP or ICMPv6
+	// "administratively prohibited" error.
+	RejectWithAdminProhibited   RejectWith = "icmp-admin-prohibited"
+	RejectWithAdminProhibitedV6 RejectWith = "icmp6-adm-prohibited"
+)
 
 type Action interface {
 	ToFragment(features *environment.Features) string
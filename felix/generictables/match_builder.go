@@ -71,6 +71,9 @@ type MatchCriteria interface {
 	NotICMPV6Type(t uint8) MatchCriteria
 	ICMPV6TypeAndCode(t, c uint8) MatchCriteria
 	NotICMPV6TypeAndCode(t, c uint8) MatchCriteria
+	// Limit matches at most perSecond packets per second, after an initial burst.  It
+	// must come after the other criteria since it counts every packet that reaches it.
+	Limit(perSecond, burst int) MatchCriteria
 
 	// Only supported in nftables.
 	InInterfaceVMAP(mapname string) MatchCriteria
@@ -16,6 +16,7 @@ package ipsets
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 
@@ -112,6 +113,17 @@ func (p Port) String() string {
 	return fmt.Sprintf("%d", p)
 }
 
+// IsDomainMember returns true if the given IP set member is a domain name, which needs to be
+// resolved to IPs before it can be programmed.
+func IsDomainMember(member string) bool {
+	// IPv6 addresses, CIDRs and IP-port members all contain one of these characters; only
+	// IPv4 addresses need to be parsed.
+	if strings.ContainsAny(member, ":/,") {
+		return false
+	}
+	return net.ParseIP(member) == nil
+}
+
 func (t IPSetType) IsMemberIPV6(member string) bool {
 	switch t {
 	case IPSetTypeHashIP, IPSetTypeHashNet:
@@ -72,6 +72,10 @@ func (s *actionFactory) Log(prefix string) generictables.Action {
 	return LogAction{Prefix: prefix}
 }
 
+func (s *actionFactory) Nflog(group uint16, prefix string, size int) generictables.Action {
+	return NflogAction{Group: group, Prefix: prefix, Size: size}
+}
+
 func (s *actionFactory) SNAT(ip string) generictables.Action {
 	return SNATAction{ToAddr: ip}
 }
@@ -196,6 +200,22 @@ func (g LogAction) String() string {
 	return "Log"
 }
 
+// NflogAction sends a copy of the packet, truncated to Size bytes, to the given NFLOG group.
+type NflogAction struct {
+	Group     uint16
+	Prefix    string
+	Size      int
+	TypeNflog struct{}
+}
+
+func (g NflogAction) ToFragment(features *environment.Features) string {
+	return fmt.Sprintf(`--jump NFLOG --nflog-group %d --nflog-prefix "%s" --nflog-size %d`, g.Group, g.Prefix, g.Size)
+}
+
+func (g NflogAction) String() string {
+	return fmt.Sprintf("Nflog->%d", g.Group)
+}
+
 type AcceptAction struct {
 	TypeAccept struct{}
 }
@@ -33,6 +33,7 @@ var _ = DescribeTable("Actions",
 	Entry("DropAction", environment.Features{}, DropAction{}, "--jump DROP"),
 	Entry("AcceptAction", environment.Features{}, AcceptAction{}, "--jump ACCEPT"),
 	Entry("LogAction", environment.Features{}, LogAction{Prefix: "prefix"}, `--jump LOG --log-prefix "prefix: " --log-level 5`),
+	Entry("NflogAction", environment.Features{}, NflogAction{Group: 3, Prefix: "DNS", Size: 1024}, `--jump NFLOG --nflog-group 3 --nflog-prefix "DNS" --nflog-size 1024`),
 	Entry("DNATAction", environment.Features{}, DNATAction{DestAddr: "10.0.0.1", DestPort: 8081}, "--jump DNAT --to-destination 10.0.0.1:8081"),
 	Entry("SNATAction", environment.Features{}, SNATAction{ToAddr: "10.0.0.1"}, "--jump SNAT --to-source 10.0.0.1"),
 	Entry("SNATAction fully random", environment.Features{SNATFullyRandom: true}, SNATAction{ToAddr: "10.0.0.1"}, "--jump SNAT --to-source 10.0.0.1 --random-fully"),
@@ -301,6 +301,10 @@ func (m matchCriteria) NotICMPV6TypeAndCode(t, c uint8) generictables.MatchCrite
 	return append(m, fmt.Sprintf("-m icmp6 ! --icmpv6-type %d/%d", t, c))
 }
 
+func (m matchCriteria) Limit(perSecond, burst int) generictables.MatchCriteria {
+	return append(m, fmt.Sprintf("-m limit --limit %d/second --limit-burst %d", perSecond, burst))
+}
+
 func (m matchCriteria) InInterfaceVMAP(mapname string) generictables.MatchCriteria {
 	log.Panic("InInterfaceVMAP not supported in iptables")
 	return m
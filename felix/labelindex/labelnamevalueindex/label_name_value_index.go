@@ -16,6 +16,7 @@ package labelnamevalueindex
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/sirupsen/logrus"
 
@@ -122,16 +123,26 @@ func (idx *LabelNameValueIndex[ItemID, Item]) StrategyFor(labelName string, r pa
 		return FullScanStrategy[ItemID, Item]{allItems: idx.allItems}
 	}
 
-	if r.MustHaveOneOfValues == nil {
-		// A selector such as "has(labelName)", which matches the label but
-		// not any particular value.
-		if vals, ok := idx.labelNameToValueToIDs[labelName]; !ok {
+	candidateValues := r.MustHaveOneOfValues
+	if candidateValues == nil {
+		vals, ok := idx.labelNameToValueToIDs[labelName]
+		if !ok {
 			logrus.Debugf("Found no matches for %s with %s=<any>", idx.nameOfTrackedItems, labelName)
 			return NoMatchStrategy[ItemID]{}
-		} else {
+		}
+		if len(r.MustMatchValueFilters) == 0 {
+			// A selector such as "has(labelName)", which matches the label but
+			// not any particular value.
 			logrus.Debugf("Found %d %s with %s=<any>", vals.count, idx.nameOfTrackedItems, labelName)
 			return LabelNameStrategy[ItemID]{label: labelName, values: vals}
 		}
+		// A selector such as "labelName > 10", which matches values that
+		// we can only find by checking each value that we're tracking.
+		candidateValues = make([]string, 0, len(vals.m))
+		for v := range vals.m {
+			candidateValues = append(candidateValues, v)
+		}
+		sort.Strings(candidateValues)
 	}
 
 	// If we get here, then the selector does match on this label, and it cares
@@ -139,7 +150,10 @@ func (idx *LabelNameValueIndex[ItemID, Item]) StrategyFor(labelName string, r pa
 	// match objects that we're tracking.
 	var filteredMustHaves []string
 	var idSets []set.Set[ItemID]
-	for _, v := range r.MustHaveOneOfValues {
+	for _, v := range candidateValues {
+		if !matchesAll(r.MustMatchValueFilters, v) {
+			continue
+		}
 		if idsSet := idx.labelNameToValueToIDs[labelName].m[v]; idsSet != nil {
 			filteredMustHaves = append(filteredMustHaves, v)
 			idSets = append(idSets, idsSet)
@@ -150,7 +164,7 @@ func (idx *LabelNameValueIndex[ItemID, Item]) StrategyFor(labelName string, r pa
 		// We filtered all values out!  That means that the selector cannot
 		// match anything.  If it could match something, we'd have found it
 		// in the index.
-		logrus.Debugf("No %s with %s=%v", idx.nameOfTrackedItems, labelName, r.MustHaveOneOfValues)
+		logrus.Debugf("No %s with %s=%v", idx.nameOfTrackedItems, labelName, candidateValues)
 		return NoMatchStrategy[ItemID]{}
 	}
 
@@ -171,6 +185,15 @@ func (idx *LabelNameValueIndex[ItemID, Item]) StrategyFor(labelName string, r pa
 	}
 }
 
+func matchesAll(filters []parser.ValueFilter, value string) bool {
+	for _, f := range filters {
+		if !f.Matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
 // FullScanStrategy returns a scan strategy that scans all items.
 func (idx *LabelNameValueIndex[ItemID, Item]) FullScanStrategy() ScanStrategy[ItemID] {
 	return FullScanStrategy[ItemID, Item]{allItems: idx.allItems}
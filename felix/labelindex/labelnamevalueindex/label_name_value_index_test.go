@@ -163,3 +163,40 @@ type labels map[string]string
 func (l labels) OwnLabels() map[string]string {
 	return l
 }
+
+func TestLabelValueIndexFilterStrategies(t *testing.T) {
+	RegisterTestingT(t)
+	idx := New[string, labels]("item")
+
+	idx.Add("n1", labels{"n": "1"})
+	idx.Add("n5", labels{"n": "5"})
+	idx.Add("n10", labels{"n": "10"})
+	idx.Add("nx", labels{"n": "x"})
+	idx.Add("m10", labels{"n": "10", "m": "y"})
+
+	strategyFor := func(sel string) ScanStrategy[string] {
+		s, err := parser.Parse(sel)
+		Expect(err).NotTo(HaveOccurred())
+		return idx.StrategyFor("n", s.LabelRestrictions()["n"])
+	}
+
+	t.Log("Comparison matching one value")
+	strat := strategyFor("n > 5")
+	Expect(strat).To(BeAssignableToTypeOf(LabelNameSingleValueStrategy[string]{}))
+	Expect(scan(strat)).To(ConsistOf("n10", "m10"))
+
+	t.Log("Comparison matching several values")
+	strat = strategyFor("n >= 5")
+	Expect(strat).To(BeAssignableToTypeOf(LabelNameMultiValueStrategy[string]{}))
+	Expect(scan(strat)).To(ConsistOf("n5", "n10", "m10"))
+
+	t.Log("Regex")
+	strat = strategyFor("n =~ '1.*'")
+	Expect(strat).To(BeAssignableToTypeOf(LabelNameMultiValueStrategy[string]{}))
+	Expect(scan(strat)).To(ConsistOf("n1", "n10", "m10"))
+
+	t.Log("No matching values")
+	strat = strategyFor("n > 100")
+	Expect(strat).To(BeAssignableToTypeOf(NoMatchStrategy[string]{}))
+	Expect(scan(strat)).To(BeEmpty())
+}
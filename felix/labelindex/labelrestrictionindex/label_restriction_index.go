@@ -210,6 +210,10 @@ func scoreLabelRestriction(lr parser.LabelRestriction) int {
 	if lr.MustBePresent {
 		score += 10
 	}
+	if len(lr.MustMatchValueFilters) > 0 {
+		// Narrower than has(), but we can't tell by how much.
+		score += 50
+	}
 	if lr.MustHaveOneOfValues != nil {
 		s := 10000 - len(lr.MustHaveOneOfValues)
 		if s < 100 {
@@ -190,6 +190,12 @@ func TestFindMostRestrictedLabel(t *testing.T) {
 	})).To(Equal("b"),
 		"findMostRestrictedLabel should prefer fewer values")
 
+	Expect(findMostRestrictedLabel(mustParseSelector("a > 3 && has(b) && b == 'B'").LabelRestrictions())).To(Equal("b"),
+		"findMostRestrictedLabel should prefer 'value' labels over 'filtered' labels")
+
+	Expect(findMostRestrictedLabel(mustParseSelector("has(a) && b =~ 'B.*'").LabelRestrictions())).To(Equal("b"),
+		"findMostRestrictedLabel should prefer 'filtered' labels over 'present' labels")
+
 	Expect(findMostRestrictedLabel(map[string]parser.LabelRestriction{
 		"a": {MustBePresent: true, MustHaveOneOfValues: []string{}},
 		"b": {MustBePresent: true},
@@ -74,6 +74,7 @@ func init() {
 type endpointData struct {
 	labels  map[string]string
 	nets    []ip.CIDR
+	domains []string
 	ports   []model.EndpointPort
 	parents []*npParentData
 
@@ -164,6 +165,9 @@ type IPSetMember struct {
 	CIDR       ip.CIDR
 	Protocol   IPSetPortProtocol
 	PortNumber uint16
+	// Domain is set, instead of the other fields, for members that come from a network set's
+	// domain names.  The dataplane resolves them to IPs.
+	Domain string
 }
 
 type ipSetData struct {
@@ -229,6 +233,9 @@ func (d *endpointData) Equals(other *endpointData) bool {
 	if len(d.nets) != len(other.nets) {
 		return false
 	}
+	if len(d.domains) != len(other.domains) {
+		return false
+	}
 	if len(d.parents) != len(other.parents) {
 		return false
 	}
@@ -249,6 +256,11 @@ func (d *endpointData) Equals(other *endpointData) bool {
 			return false
 		}
 	}
+	for i, dom := range d.domains {
+		if other.domains[i] != dom {
+			return false
+		}
+	}
 	for i, p := range d.parents {
 		// Note: this is a pointer comparison; we know that pointers will be shared.
 		if other.parents[i] != p {
@@ -358,6 +370,7 @@ func (idx *SelectorAndNamedPortIndex) OnUpdate(update api.Update) (_ bool) {
 				key,
 				endpoint.Labels,
 				extractCIDRsFromWorkloadEndpoint(endpoint),
+				nil,
 				endpoint.Ports,
 				profileIDs)
 		} else {
@@ -374,6 +387,7 @@ func (idx *SelectorAndNamedPortIndex) OnUpdate(update api.Update) (_ bool) {
 				key,
 				endpoint.Labels,
 				extractCIDRsFromHostEndpoint(endpoint),
+				nil,
 				endpoint.Ports,
 				profileIDs)
 		} else {
@@ -390,6 +404,7 @@ func (idx *SelectorAndNamedPortIndex) OnUpdate(update api.Update) (_ bool) {
 				key,
 				netSet.Labels,
 				extractCIDRsFromNetworkSet(netSet),
+				extractDomainsFromNetworkSet(netSet),
 				nil,
 				profileIDs)
 		} else {
@@ -476,6 +491,19 @@ func extractCIDRsFromNetworkSet(netSet *model.NetworkSet) []ip.CIDR {
 	return combined
 }
 
+// extractDomainsFromNetworkSet returns the network set's domain names in lower case, which is
+// how the dataplane matches them against DNS responses.
+func extractDomainsFromNetworkSet(netSet *model.NetworkSet) []string {
+	if len(netSet.Domains) == 0 {
+		return nil
+	}
+	domains := make([]string, len(netSet.Domains))
+	for i, d := range netSet.Domains {
+		domains[i] = strings.ToLower(d)
+	}
+	return domains
+}
+
 var defaultLogCtx = log.WithField("fieldsSuppressedAtThisLogLevel", "true")
 
 func (idx *SelectorAndNamedPortIndex) UpdateIPSet(ipSetID string, sel selector.Selector, namedPortProtocol IPSetPortProtocol, namedPort string) {
@@ -588,6 +616,7 @@ func (idx *SelectorAndNamedPortIndex) UpdateEndpointOrSet(
 	id any,
 	labels map[string]string,
 	nets []ip.CIDR,
+	domains []string,
 	ports []model.EndpointPort,
 	parentIDs []string,
 ) {
@@ -596,6 +625,7 @@ func (idx *SelectorAndNamedPortIndex) UpdateEndpointOrSet(
 			"endpointOrSetID": id,
 			"newLabels":       labels,
 			"CIDRs":           nets,
+			"domains":         domains,
 			"ports":           ports,
 			"parentIDs":       parentIDs,
 		}).Debug("Updating endpoint/network set")
@@ -616,6 +646,9 @@ func (idx *SelectorAndNamedPortIndex) UpdateEndpointOrSet(
 	if len(nets) > 0 {
 		newEndpointData.nets = nets
 	}
+	if len(domains) > 0 {
+		newEndpointData.domains = domains
+	}
 	if len(ports) > 0 {
 		newEndpointData.ports = ports
 	}
@@ -669,7 +702,7 @@ func (idx *SelectorAndNamedPortIndex) UpdateEndpointOrSet(
 // removals for previously sent members that are now masked.
 // For example, we don't need to send updates for both 10.0.0.0/24 and 10.0.0.1/32.
 func (idx *SelectorAndNamedPortIndex) onMemberAdded(ipSetID string, member IPSetMember) {
-	if member.Protocol == ProtocolNone && member.PortNumber == 0 {
+	if member.Domain == "" && member.Protocol == ProtocolNone && member.PortNumber == 0 {
 		// We only deduplicate for IP set members that are CIDRs. Named port members are always unique.
 		add, removes := idx.suppressor.Add(ipSetID, member.CIDR)
 		if add != nil {
@@ -692,7 +725,7 @@ func (idx *SelectorAndNamedPortIndex) onMemberAdded(ipSetID string, member IPSet
 // deduplicate any members that are masked by another member of the set, sending any necessary IPSet member
 // IPSet member adds for members that were previously masked by the removed member.
 func (idx *SelectorAndNamedPortIndex) onMemberRemoved(ipSetID string, member IPSetMember) {
-	if member.Protocol == ProtocolNone && member.PortNumber == 0 {
+	if member.Domain == "" && member.Protocol == ProtocolNone && member.PortNumber == 0 {
 		// We only deduplicate for IP set members that are CIDRs. Named port members are always unique.
 		rem, adds := idx.suppressor.Remove(ipSetID, member.CIDR)
 		if rem != nil {
@@ -874,12 +907,17 @@ func (idx *SelectorAndNamedPortIndex) CalculateEndpointContribution(d *endpointD
 			}
 		}
 	} else {
-		// Non-named port match, simply return the CIDRs.
+		// Non-named port match, simply return the CIDRs and domains.
 		for _, addr := range d.nets {
 			contrib = append(contrib, IPSetMember{
 				CIDR: addr,
 			})
 		}
+		for _, domain := range d.domains {
+			contrib = append(contrib, IPSetMember{
+				Domain: domain,
+			})
+		}
 	}
 	return
 }
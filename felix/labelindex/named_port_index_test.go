@@ -996,6 +996,9 @@ func (s namedPortState) CheckRecordedState(t *testing.T, rec *testRecorder) {
 
 // Copied from event sequencer.
 func memberToProto(member IPSetMember) string {
+	if member.Domain != "" {
+		return member.Domain
+	}
 	switch member.Protocol {
 	case ProtocolNone:
 		return member.CIDR.String()
@@ -1065,7 +1068,7 @@ func applyStateTransition(idx *SelectorAndNamedPortIndex, rec *testRecorder, s1,
 		k := k
 		ep := ep
 		ops = append(ops, func() {
-			idx.UpdateEndpointOrSet(k, ep.Labels, ep.CIDRs(), ep.Ports, ep.Parents)
+			idx.UpdateEndpointOrSet(k, ep.Labels, ep.CIDRs(), nil, ep.Ports, ep.Parents)
 		})
 	}
 	for k := range s1.Endpoints {
@@ -1347,6 +1350,45 @@ var _ = Describe("SelectorAndNamedPortIndex", func() {
 		})
 	})
 
+	Describe("NetworkSet domains", func() {
+		It("should add domains as IP set members alongside CIDRs", func() {
+			nsKVP := model.KVPair{
+				Key: model.NetworkSetKey{Name: "pacman"},
+				Value: &model.NetworkSet{
+					Nets: []calinet.IPNet{
+						{IPNet: net.IPNet{
+							IP:   net.IP{192, 168, 20, 1},
+							Mask: net.IPMask{255, 255, 0, 0},
+						}},
+					},
+					Domains: []string{"Example.com", "*.example.org"},
+					Labels:  map[string]string{"villain": "ghost"},
+				},
+			}
+			uut.OnUpdate(api.Update{KVPair: nsKVP})
+			s, err := selector.Parse("villain == 'ghost'")
+			Expect(err).ToNot(HaveOccurred())
+			uut.UpdateIPSet("villains", s, ProtocolNone, "")
+			Expect(recorder.ipsets["villains"]).To(Equal(map[IPSetMember]bool{
+				{CIDR: ip.MustParseCIDROrIP("192.168.0.0/16")}: true,
+				{Domain: "example.com"}:                        true,
+				{Domain: "*.example.org"}:                      true,
+			}))
+
+			// Named port IP sets don't include domains.
+			uut.UpdateIPSet("villain-ports", s, ProtocolTCP, "http")
+			Expect(recorder.ipsets).NotTo(HaveKey("villain-ports"))
+
+			// Removing a domain removes its member.
+			nsKVP.Value.(*model.NetworkSet).Domains = []string{"example.com"}
+			uut.OnUpdate(api.Update{KVPair: nsKVP})
+			Expect(recorder.ipsets["villains"]).To(Equal(map[IPSetMember]bool{
+				{CIDR: ip.MustParseCIDROrIP("192.168.0.0/16")}: true,
+				{Domain: "example.com"}:                        true,
+			}))
+		})
+	})
+
 	Describe("NetworkSet profiles", func() {
 		It("should inherit labels from profiles", func() {
 			uut.OnUpdate(api.Update{
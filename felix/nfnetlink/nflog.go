@@ -63,6 +63,9 @@ type NflogPacket struct {
 	Bytes         int
 	IsDNAT        bool
 	OriginalTuple CtTuple
+	// Payload is the packet, starting at the IP header.  It is only valid for as long as
+	// the netlink message that it was parsed from, unless it has been copied.
+	Payload []byte
 }
 
 type NflogPacketAggregate struct {
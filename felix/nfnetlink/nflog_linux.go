@@ -102,6 +102,46 @@ func NflogSubscribe(groupNum int, bufSize int, ch chan<- map[NflogPacketTuple]*N
 	return nil
 }
 
+// NflogSubscribePackets subscribes to the given NFLOG group and sends each packet, with a copy of
+// its payload and its conntrack entry, to ch as soon as it arrives.  Unlike NflogSubscribe, there
+// is no aggregation; it is used for snooping on DNS responses, where latency matters.
+func NflogSubscribePackets(groupNum int, bufSize int, ch chan<- *NflogPacket, done <-chan struct{}) error {
+	resChan, err := openAndReadNFNLSocket(groupNum, bufSize, done, 2*cap(ch), true, true)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer close(ch)
+		logCtx := rll.WithFields(log.Fields{
+			"groupNum": groupNum,
+		})
+		numParseErrors := counterVecParseErrors.WithLabelValues(fmt.Sprint(groupNum))
+		for {
+			select {
+			case res := <-resChan:
+				for _, m := range res {
+					msg := nfnl.DeserializeNfGenMsg(m)
+					nflogPacket, err := parseNflog(m[msg.Len():])
+					if err != nil {
+						logCtx.Warnf("Error parsing NFLOG %v", err)
+						numParseErrors.Inc()
+						continue
+					}
+					nflogPacket.Payload = append([]byte(nil), nflogPacket.Payload...)
+					select {
+					case ch <- &nflogPacket:
+					case <-done:
+						return
+					}
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
 func openAndReadNFNLSocket(
 	groupNum int, bufSize int, done <-chan struct{}, chanCap int, immediateFlush bool, includeConnTrack bool,
 ) (chan [][]byte, error) {
@@ -374,6 +414,7 @@ func parseNflog(m []byte) (NflogPacket, error) {
 		case nfnl.NFULA_PAYLOAD:
 			parsePacketHeader(&nflogPacket.Tuple, nflogPacket.Header.HwProtocol, attr.Value)
 			nflogPacket.Bytes = len(attr.Value)
+			nflogPacket.Payload = attr.Value
 		case nfnl.NFULA_PREFIX:
 			p := NflogPrefix{Len: len(attr.Value) - 1}
 			copy(p.Prefix[:], attr.Value[:len(attr.Value)-1])
@@ -51,6 +51,10 @@ func (s *actionSet) Reject(with generictables.RejectWith) generictables.Action {
 	switch with {
 	case generictables.RejectWithTCPReset:
 		return RejectAction{With: "tcp reset"}
+	case generictables.RejectWithAdminProhibited:
+		return RejectAction{With: "icmp type admin-prohibited"}
+	case generictables.RejectWithAdminProhibitedV6:
+		return RejectAction{With: "icmpv6 type admin-prohibited"}
 	}
 	if with != "" {
 		logrus.WithField("reject-with", with).Panic("Unknown reject-with value")
@@ -87,6 +91,10 @@ func (s *actionSet) Log(prefix string) generictables.Action {
 	return LogAction{Prefix: prefix}
 }
 
+func (s *actionSet) Nflog(group uint16, prefix string, size int) generictables.Action {
+	return NflogAction{Group: group, Prefix: prefix, Size: size}
+}
+
 func (s *actionSet) SNAT(ip string) generictables.Action {
 	return SNATAction{ToAddr: ip}
 }
@@ -236,6 +244,22 @@ func (g LogAction) String() string {
 	return "Log"
 }
 
+// NflogAction sends a copy of the packet, truncated to Size bytes, to the given NFLOG group.
+type NflogAction struct {
+	Group     uint16
+	Prefix    string
+	Size      int
+	TypeNflog struct{}
+}
+
+func (g NflogAction) ToFragment(features *environment.Features) string {
+	return fmt.Sprintf(`log prefix "%s" group %d snaplen %d`, g.Prefix, g.Group, g.Size)
+}
+
+func (g NflogAction) String() string {
+	return fmt.Sprintf("Nflog->%d", g.Group)
+}
+
 type AcceptAction struct {
 	TypeAccept struct{}
 }
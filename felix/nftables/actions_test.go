@@ -33,6 +33,7 @@ var _ = DescribeTable("Actions",
 	Entry("DropAction", environment.Features{}, DropAction{}, "drop"),
 	Entry("AcceptAction", environment.Features{}, AcceptAction{}, "accept"),
 	Entry("LogAction", environment.Features{}, LogAction{Prefix: "prefix"}, "log prefix prefix level info"),
+	Entry("NflogAction", environment.Features{}, NflogAction{Group: 3, Prefix: "DNS", Size: 1024}, `log prefix "DNS" group 3 snaplen 1024`),
 	Entry("DNATAction", environment.Features{}, DNATAction{DestAddr: "10.0.0.1", DestPort: 8081}, "dnat to 10.0.0.1:8081"),
 	Entry("SNATAction", environment.Features{}, SNATAction{ToAddr: "10.0.0.1"}, "snat to 10.0.0.1"),
 	Entry("SNATAction fully random", environment.Features{SNATFullyRandom: true}, SNATAction{ToAddr: "10.0.0.1"}, "snat to 10.0.0.1 fully-random"),
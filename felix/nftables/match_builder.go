@@ -475,6 +475,11 @@ func (m nftMatch) NotICMPV6TypeAndCode(t, c uint8) generictables.MatchCriteria {
 	return m
 }
 
+func (m nftMatch) Limit(perSecond, burst int) generictables.MatchCriteria {
+	m.clauses = append(m.clauses, fmt.Sprintf("limit rate %d/second burst %d packets", perSecond, burst))
+	return m
+}
+
 func (m nftMatch) InInterfaceVMAP(name string) generictables.MatchCriteria {
 	m.clauses = append(m.clauses, fmt.Sprintf("iifname vmap @%s", LegalizeSetName(name)))
 	return m
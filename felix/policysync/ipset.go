@@ -52,15 +52,25 @@ func newIPSet(update *proto.IPSetUpdate) *ipSetInfo {
 func (s *ipSetInfo) replaceMembers(update *proto.IPSetUpdate) {
 	s.members = set.New[ipsets.IPSetMember]()
 	for _, ms := range update.GetMembers() {
+		if ipsets.IsDomainMember(ms) {
+			// Domains are resolved by the dataplane; they have no meaning to policy sync clients.
+			continue
+		}
 		s.members.Add(ipsets.CanonicaliseMember(s.Type, ms))
 	}
 }
 
 func (s *ipSetInfo) deltaUpdate(update *proto.IPSetDeltaUpdate) {
 	for _, ms := range update.GetAddedMembers() {
+		if ipsets.IsDomainMember(ms) {
+			continue
+		}
 		s.members.Add(ipsets.CanonicaliseMember(s.Type, ms))
 	}
 	for _, ms := range update.GetRemovedMembers() {
+		if ipsets.IsDomainMember(ms) {
+			continue
+		}
 		s.members.Discard(ipsets.CanonicaliseMember(s.Type, ms))
 	}
 }
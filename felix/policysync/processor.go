@@ -19,6 +19,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/projectcalico/calico/felix/calc"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/types"
 )
@@ -208,6 +209,8 @@ func (p *Processor) handleDataplane(update interface{}) {
 		p.handleIPSetDeltaUpdate(update)
 	case *proto.IPSetRemove:
 		p.handleIPSetRemove(update)
+	case *calc.PolicyGenerations:
+		// Only used for policy status reporting.
 	default:
 		log.WithFields(log.Fields{
 			"type": reflect.TypeOf(update),
@@ -28,6 +28,7 @@ import (
 	"github.com/projectcalico/calico/felix/hashutils"
 	"github.com/projectcalico/calico/felix/iptables"
 	"github.com/projectcalico/calico/felix/types"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 )
 
 const (
@@ -509,7 +510,7 @@ func (r *DefaultRuleRenderer) endpointIptablesChain(
 			}
 
 			if chainType == chainTypeNormal || chainType == chainTypeForward {
-				if tier.DefaultAction != string(v3.Pass) {
+				if tier.DefaultAction != string(v3.Pass) && tierHasEnforcedPolicy(policyGroups) {
 					// When rendering normal and forward rules, if no policy marked the packet as "pass", drop the
 					// packet.
 					//
@@ -617,6 +618,19 @@ const MaxPolicyGroupUIDLength = iptables.MaxChainNameLength - len(PolicyGroupInb
 // PolicyGroup represents a sequence of one or more policies extracted from
 // a list of policies.  If large enough (currently >1 entry) it will be
 // programmed into its own chain.
+// tierHasEnforcedPolicy returns true if any of the groups contains a policy that is
+// not staged.  A tier with only staged policies must not drop traffic at its end.
+func tierHasEnforcedPolicy(groups []*PolicyGroup) bool {
+	for _, g := range groups {
+		for _, name := range g.PolicyNames {
+			if !model.PolicyIsStaged(name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type PolicyGroup struct {
 	// Tier is only used in enterprise.  There can be policies with the same
 	// name in different tiers so we need to disambiguate.
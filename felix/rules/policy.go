@@ -32,7 +32,15 @@ import (
 
 // ruleRenderer defined in rules_defs.go.
 
+// stagedRuleAction is the internal action that the rules of a staged policy are
+// rendered with.  Such rules still match (and count) packets but they return to the
+// calling chain without setting a verdict mark.
+const stagedRuleAction = "staged"
+
 func (r *DefaultRuleRenderer) PolicyToIptablesChains(policyID *types.PolicyID, policy *proto.Policy, ipVersion uint8) []*generictables.Chain {
+	if policy.Staged {
+		return r.stagedPolicyToIptablesChains(policyID, policy, ipVersion)
+	}
 	inbound := generictables.Chain{
 		Name: PolicyChainName(PolicyInboundPfx, policyID, r.NFTables),
 		// Note that the policy name includes the tier, so it does not need to be separately specified.
@@ -46,6 +54,35 @@ func (r *DefaultRuleRenderer) PolicyToIptablesChains(policyID *types.PolicyID, p
 	return []*generictables.Chain{&inbound, &outbound}
 }
 
+// stagedPolicyToIptablesChains renders a staged policy.  Each rule keeps its match
+// criteria, so that the chain's counters show what the policy would have done, but
+// its verdict is replaced so that the policy never affects traffic.
+func (r *DefaultRuleRenderer) stagedPolicyToIptablesChains(policyID *types.PolicyID, policy *proto.Policy, ipVersion uint8) []*generictables.Chain {
+	inbound := generictables.Chain{
+		Name:  PolicyChainName(PolicyInboundPfx, policyID, r.NFTables),
+		Rules: r.protoRulesToIptablesRules(stagedRules(policy.InboundRules), ipVersion, false, fmt.Sprintf("Staged policy %s ingress", policyID.Name)),
+	}
+	outbound := generictables.Chain{
+		Name:  PolicyChainName(PolicyOutboundPfx, policyID, r.NFTables),
+		Rules: r.protoRulesToIptablesRules(stagedRules(policy.OutboundRules), ipVersion, false, fmt.Sprintf("Staged policy %s egress", policyID.Name)),
+	}
+	return []*generictables.Chain{&inbound, &outbound}
+}
+
+// stagedRules returns copies of the given rules with every verdict replaced by the
+// staged action.  Log rules are kept as they are.
+func stagedRules(protoRules []*proto.Rule) []*proto.Rule {
+	out := make([]*proto.Rule, 0, len(protoRules))
+	for _, pRule := range protoRules {
+		if pRule.Action != "log" {
+			pRule = googleproto.Clone(pRule).(*proto.Rule)
+			pRule.Action = stagedRuleAction
+		}
+		out = append(out, pRule)
+	}
+	return out
+}
+
 func (r *DefaultRuleRenderer) ProfileToIptablesChains(profileID *types.ProfileID, profile *proto.Profile, ipVersion uint8) (inbound, outbound *generictables.Chain) {
 	inbound = &generictables.Chain{
 		Name:  ProfileChainName(ProfileInboundPfx, profileID, r.NFTables),
@@ -59,13 +96,18 @@ func (r *DefaultRuleRenderer) ProfileToIptablesChains(profileID *types.ProfileID
 }
 
 func (r *DefaultRuleRenderer) ProtoRulesToIptablesRules(protoRules []*proto.Rule, ipVersion uint8, chainComments ...string) []generictables.Rule {
+	return r.protoRulesToIptablesRules(protoRules, ipVersion, true, chainComments...)
+}
+
+func (r *DefaultRuleRenderer) protoRulesToIptablesRules(protoRules []*proto.Rule, ipVersion uint8, stripReturns bool, chainComments ...string) []generictables.Rule {
 	var rules []generictables.Rule
 	for _, protoRule := range protoRules {
 		rules = append(rules, r.ProtoRuleToIptablesRules(protoRule, ipVersion)...)
 	}
 	// Strip off any return rules at the end of the chain.  No matter their
-	// match criteria, they're effectively no-ops.
-	for len(rules) > 0 {
+	// match criteria, they're effectively no-ops.  Staged chains keep them
+	// since their only purpose is to count packets.
+	for stripReturns && len(rules) > 0 {
 		if _, ok := rules[len(rules)-1].Action.(generictables.ReturnActionMarker); ok {
 			rules = rules[:len(rules)-1]
 		} else {
@@ -168,12 +210,16 @@ func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVers
 	//     - our datamodel includes named ports, which we render as (IP, port) IP sets, these are
 	//       or-ed with the numeric ports; the "or" operation can't be done in a single rule.
 	//
+	//     - our datamodel allows for a list of ICMP types (and codes) to be or-ed together but
+	//       there can only be one ICMP type match in a rule.
+	//
 	// To work around these limitations, where needed, we break the rule into blocks,
 	// each of which implements a part of the match as follows:
 	//
 	//     rule to initialise mark bits
 	//     positive matches on source ports
 	//     positive matches on dest ports
+	//     positive matches on ICMP types
 	//     positive matches on source address
 	//     positive matches on dest address
 	//     negated matches on source address
@@ -232,6 +278,14 @@ func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVers
 		ruleCopy.DstNamedPortIpSetIds = nil
 	}
 
+	// Similarly, a list of more than one ICMP type needs a block.
+	if icmp, ok := ruleCopy.Icmp.(*proto.Rule_IcmpTypesAndCodes); ok {
+		if icmpTypes := splitICMPTypes(icmp.IcmpTypesAndCodes); len(icmpTypes) > 1 {
+			matchBlockBuilder.AppendICMPMatchBlock(ruleCopy.Protocol, icmpTypes, ipVersion)
+			ruleCopy.Icmp = nil
+		}
+	}
+
 	// If there's more than one positive source/destination CIDR match, we have to render a block.
 	// Otherwise, if there's exactly one, we'll include it in the main rule below.
 	if len(ruleCopy.SrcNet) > 1 {
@@ -274,31 +328,48 @@ func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVers
 	}
 
 	// Render the rest of the rule.
-	match := r.CalculateRuleMatch(ruleCopy, ipVersion)
-
-	if matchBlockBuilder.UsingMatchBlocks {
-		// The CIDR or port matches in the rule overflowed and we rendered them
-		// as additional rules, which set the markAllBlocksPass bit on
-		// success.  Add a match on that bit to the calculated rule.
-		match = match.MarkSingleBitSet(matchBlockBuilder.markAllBlocksPass)
+	ruleMatch := func(rule *proto.Rule) generictables.MatchCriteria {
+		match := r.CalculateRuleMatch(rule, ipVersion)
+		if matchBlockBuilder.UsingMatchBlocks {
+			// The CIDR or port matches in the rule overflowed and we rendered them
+			// as additional rules, which set the markAllBlocksPass bit on
+			// success.  Add a match on that bit to the calculated rule.
+			match = match.MarkSingleBitSet(matchBlockBuilder.markAllBlocksPass)
+		}
+		return match
 	}
-	markBit, actions := r.CalculateActions(ruleCopy, ipVersion)
 	rs := matchBlockBuilder.Rules
-	if markBit != 0 {
-		// The rule needs to do more than one action. Render a rule that
-		// executes the match criteria and sets the given mark bit if it
-		// matches, then render the actions as separate rules below.
+	if pRule.Audit && r.PolicyAuditEnabled && pRule.RuleId != "" {
+		// Copy the packet to Felix, which writes the audit record, before the
+		// rule's own action; NFLOG doesn't stop rule processing.
 		rs = append(rs, generictables.Rule{
-			Match:  match,
-			Action: r.SetMark(markBit),
+			Match:  ruleMatch(ruleCopy),
+			Action: r.Nflog(AuditNflogGroup, AuditNflogPrefix+pRule.RuleId, AuditNflogSize),
 		})
-		match = r.NewMatch().MarkSingleBitSet(markBit)
 	}
-	for _, action := range actions {
-		rs = append(rs, generictables.Rule{
-			Match:  match,
-			Action: action,
-		})
+	if ruleCopy.Action == "reject" {
+		// Reject replies differently to TCP and other protocols and only up to
+		// the rate limit, so it needs a rule for each case.
+		rs = append(rs, r.rejectRules(ruleCopy, ipVersion, ruleMatch)...)
+	} else {
+		match := ruleMatch(ruleCopy)
+		markBit, actions := r.CalculateActions(ruleCopy, ipVersion)
+		if markBit != 0 {
+			// The rule needs to do more than one action. Render a rule that
+			// executes the match criteria and sets the given mark bit if it
+			// matches, then render the actions as separate rules below.
+			rs = append(rs, generictables.Rule{
+				Match:  match,
+				Action: r.SetMark(markBit),
+			})
+			match = r.NewMatch().MarkSingleBitSet(markBit)
+		}
+		for _, action := range actions {
+			rs = append(rs, generictables.Rule{
+				Match:  match,
+				Action: action,
+			})
+		}
 	}
 
 	// Render rule annotations as comments on each rule.
@@ -311,6 +382,64 @@ func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVers
 	return rs
 }
 
+// rejectRules renders a rule with the Reject action.  TCP packets are answered with a
+// reset and other packets with an ICMP administratively prohibited error.  Each kind of
+// reply is limited to RejectRateLimit per second, packets over the limit are dropped.
+func (r *DefaultRuleRenderer) rejectRules(
+	pRule *proto.Rule,
+	ipVersion uint8,
+	ruleMatch func(*proto.Rule) generictables.MatchCriteria,
+) (rs []generictables.Rule) {
+	rate := r.RejectRateLimit
+	onlyTCP := protocolIsTCP(pRule.Protocol)
+	if onlyTCP || (pRule.Protocol == nil && !protocolIsTCP(pRule.NotProtocol)) {
+		// iptables allows only one protocol match.  Matching TCP already excludes
+		// any other negated protocol, so the negated match can go.
+		tcpRule := googleproto.Clone(pRule).(*proto.Rule)
+		tcpRule.Protocol = &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "tcp"}}
+		tcpRule.NotProtocol = nil
+		if rate > 0 {
+			rs = append(rs, generictables.Rule{
+				Match:  ruleMatch(tcpRule).Limit(rate, rate),
+				Action: r.Reject(generictables.RejectWithTCPReset),
+			})
+		}
+		rs = append(rs, generictables.Rule{
+			Match:  ruleMatch(tcpRule),
+			Action: r.Drop(),
+		})
+	}
+	if onlyTCP {
+		return
+	}
+
+	with := generictables.RejectWithAdminProhibited
+	if ipVersion == 6 {
+		with = generictables.RejectWithAdminProhibitedV6
+	}
+	if rate > 0 {
+		rs = append(rs, generictables.Rule{
+			Match:  ruleMatch(pRule).Limit(rate, rate),
+			Action: r.Reject(with),
+		})
+	}
+	rs = append(rs, generictables.Rule{
+		Match:  ruleMatch(pRule),
+		Action: r.Drop(),
+	})
+	return
+}
+
+func protocolIsTCP(p *proto.Protocol) bool {
+	switch n := p.GetNumberOrName().(type) {
+	case *proto.Protocol_Name:
+		return strings.EqualFold(n.Name, "tcp")
+	case *proto.Protocol_Number:
+		return n.Number == ProtoTCP
+	}
+	return false
+}
+
 type matchBlockBuilder struct {
 	UsingMatchBlocks            bool
 	doneFirstPositiveMatchBlock bool
@@ -363,6 +492,34 @@ func (r *matchBlockBuilder) AppendPortMatchBlock(
 	r.finishPositiveBlock()
 }
 
+func (r *matchBlockBuilder) AppendICMPMatchBlock(
+	protocol *proto.Protocol,
+	icmpTypes []*proto.IcmpTypesAndCodes,
+	ipVersion uint8,
+) {
+	// Write out the initial "reset" rule if this is the first block.
+	r.maybeAppendInitialRule(0)
+	// Figure out which bit to set.  See comment in positiveBlockMarkToSet() for details.
+	markToSet := r.positiveBlockMarkToSet()
+
+	logCxt := log.WithFields(log.Fields{
+		"protocol":  protocol,
+		"icmpTypes": icmpTypes,
+	})
+	// The ICMP type match needs the protocol match to be in the same rule.
+	for _, tcs := range icmpTypes {
+		m := appendProtocolMatch(r.newMatch(), protocol, logCxt)
+		m = appendICMPTypesMatch(m, tcs, ipVersion, false)
+		r.Rules = append(r.Rules, generictables.Rule{
+			Match:  m,
+			Action: r.actions.SetMark(markToSet),
+		})
+	}
+
+	// Append the end-of-block rules.
+	r.finishPositiveBlock()
+}
+
 func (r *matchBlockBuilder) AppendCIDRMatchBlock(cidrs []string, srcOrDst srcOrDst) {
 	// Write out the initial "reset" rule if this is the first block.
 	r.maybeAppendInitialRule(0)
@@ -548,6 +705,13 @@ func (r *DefaultRuleRenderer) CalculateActions(pRule *proto.Rule, ipVersion uint
 	case "log":
 		// This rule should log.
 		actions = append(actions, r.Log(r.LogPrefix))
+	case "reject":
+		// Reject is rendered by rejectRules; when it can't send a reply it drops.
+		actions = append(actions, r.Drop())
+	case stagedRuleAction:
+		// Rule of a staged policy; return to the calling chain without setting
+		// any mark so that the packet carries on as if the policy didn't match.
+		actions = append(actions, r.Return())
 	default:
 		log.WithField("action", pRule.Action).Panic("Unknown rule action")
 	}
@@ -694,6 +858,13 @@ func (r *DefaultRuleRenderer) CalculateRuleMatch(pRule *proto.Rule, ipVersion ui
 			match = match.ICMPV6Type(uint8(icmp.IcmpType))
 		}
 	}
+	if icmp, ok := pRule.Icmp.(*proto.Rule_IcmpTypesAndCodes); ok {
+		if len(splitICMPTypes(icmp.IcmpTypesAndCodes)) > 1 {
+			log.WithField("rule", pRule).Panic("CalculateRuleMatch() passed more than one ICMP type in Icmp.")
+		}
+		logCxt.WithField("icmpTypes", icmp).Debug("Adding ICMP type list match.")
+		match = appendICMPTypesMatch(match, icmp.IcmpTypesAndCodes, ipVersion, false)
+	}
 
 	// Now, the negated versions.
 
@@ -797,6 +968,57 @@ func (r *DefaultRuleRenderer) CalculateRuleMatch(pRule *proto.Rule, ipVersion ui
 			match = match.NotICMPV6Type(uint8(icmp.NotIcmpType))
 		}
 	}
+	if icmp, ok := pRule.NotIcmp.(*proto.Rule_NotIcmpTypesAndCodes); ok {
+		// Negated matches are and-ed together so the whole list fits in one rule.
+		logCxt.WithField("icmpTypes", icmp).Debug("Adding negated ICMP type list match.")
+		match = appendICMPTypesMatch(match, icmp.NotIcmpTypesAndCodes, ipVersion, true)
+	}
+	return match
+}
+
+// splitICMPTypes splits a list of ICMP types and type/code pairs into single-entry lists.
+func splitICMPTypes(tcs *proto.IcmpTypesAndCodes) (splits []*proto.IcmpTypesAndCodes) {
+	for _, t := range tcs.GetTypes() {
+		splits = append(splits, &proto.IcmpTypesAndCodes{Types: []int32{t}})
+	}
+	for _, tc := range tcs.GetTypeCodes() {
+		splits = append(splits, &proto.IcmpTypesAndCodes{TypeCodes: []*proto.IcmpTypeAndCode{tc}})
+	}
+	return
+}
+
+// appendICMPTypesMatch adds a match for each of the ICMP types and type/code pairs in the list.
+// The matches are and-ed together so, for a positive match, the list should have only one entry.
+func appendICMPTypesMatch(
+	match generictables.MatchCriteria,
+	tcs *proto.IcmpTypesAndCodes,
+	ipVersion uint8,
+	negate bool,
+) generictables.MatchCriteria {
+	for _, t := range tcs.GetTypes() {
+		switch {
+		case ipVersion == 4 && !negate:
+			match = match.ICMPType(uint8(t))
+		case ipVersion == 4:
+			match = match.NotICMPType(uint8(t))
+		case !negate:
+			match = match.ICMPV6Type(uint8(t))
+		default:
+			match = match.NotICMPV6Type(uint8(t))
+		}
+	}
+	for _, tc := range tcs.GetTypeCodes() {
+		switch {
+		case ipVersion == 4 && !negate:
+			match = match.ICMPTypeAndCode(uint8(tc.Type), uint8(tc.Code))
+		case ipVersion == 4:
+			match = match.NotICMPTypeAndCode(uint8(tc.Type), uint8(tc.Code))
+		case !negate:
+			match = match.ICMPV6TypeAndCode(uint8(tc.Type), uint8(tc.Code))
+		default:
+			match = match.NotICMPV6TypeAndCode(uint8(tc.Type), uint8(tc.Code))
+		}
+	}
 	return match
 }
 
@@ -18,6 +18,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	googleproto "google.golang.org/protobuf/proto"
 
 	"github.com/projectcalico/calico/felix/environment"
 	"github.com/projectcalico/calico/felix/generictables"
@@ -71,6 +72,14 @@ var ruleTestData = []TableEntry{
 	Entry("ICMP with code", 6,
 		&proto.Rule{Icmp: &proto.Rule_IcmpTypeCode{IcmpTypeCode: &proto.IcmpTypeAndCode{Type: 10, Code: 12}}},
 		"-m icmp6 --icmpv6-type 10/12"),
+	Entry("ICMP list with one type", 4,
+		&proto.Rule{Icmp: &proto.Rule_IcmpTypesAndCodes{IcmpTypesAndCodes: &proto.IcmpTypesAndCodes{Types: []int32{8}}}},
+		"-m icmp --icmp-type 8"),
+	Entry("ICMP list with one type and code", 6,
+		&proto.Rule{Icmp: &proto.Rule_IcmpTypesAndCodes{IcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+			TypeCodes: []*proto.IcmpTypeAndCode{{Type: 1, Code: 4}},
+		}}},
+		"-m icmp6 --icmpv6-type 1/4"),
 
 	Entry("Dest net", 4,
 		&proto.Rule{DstNet: []string{"10.0.0.0/16"}},
@@ -147,6 +156,17 @@ var ruleTestData = []TableEntry{
 	Entry("ICMP with code", 6,
 		&proto.Rule{NotIcmp: &proto.Rule_NotIcmpTypeCode{NotIcmpTypeCode: &proto.IcmpTypeAndCode{Type: 10, Code: 12}}},
 		"-m icmp6 ! --icmpv6-type 10/12"),
+	Entry("ICMP list", 4,
+		&proto.Rule{NotIcmp: &proto.Rule_NotIcmpTypesAndCodes{NotIcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+			Types:     []int32{0, 8},
+			TypeCodes: []*proto.IcmpTypeAndCode{{Type: 3, Code: 4}},
+		}}},
+		"-m icmp ! --icmp-type 0 -m icmp ! --icmp-type 8 -m icmp ! --icmp-type 3/4"),
+	Entry("ICMP list", 6,
+		&proto.Rule{NotIcmp: &proto.Rule_NotIcmpTypesAndCodes{NotIcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+			Types: []int32{128, 129},
+		}}},
+		"-m icmp6 ! --icmpv6-type 128 -m icmp6 ! --icmpv6-type 129"),
 
 	Entry("Dest net", 4,
 		&proto.Rule{NotDstNet: []string{"10.0.0.0/16"}},
@@ -275,6 +295,35 @@ var _ = Describe("Protobuf rule to iptables rule conversion", func() {
 		ruleTestData...,
 	)
 
+	DescribeTable(
+		"Audited rules should copy packets to NFLOG before their action",
+		func(ipVer int, in *proto.Rule, expMatch string) {
+			rrConfigAudit := rrConfigNormal
+			rrConfigAudit.PolicyAuditEnabled = true
+			renderer := NewRenderer(rrConfigAudit)
+			auditRule := googleproto.Clone(in).(*proto.Rule)
+			auditRule.Action = "deny"
+			auditRule.Audit = true
+			auditRule.RuleId = "abcdefghijklmnop"
+			rules := renderer.ProtoRuleToIptablesRules(auditRule, uint8(ipVer))
+			Expect(len(rules)).To(Equal(2))
+			Expect(rules[0].Match.Render()).To(Equal(expMatch))
+			Expect(rules[0].Action).To(Equal(iptables.NflogAction{
+				Group:  4,
+				Prefix: "AUDIT|abcdefghijklmnop",
+				Size:   128,
+			}))
+			Expect(rules[1].Match.Render()).To(Equal(expMatch))
+			Expect(rules[1].Action).To(Equal(iptables.DropAction{}))
+
+			By("ignoring the audit flag when there is no audit sink")
+			rules = NewRenderer(rrConfigNormal).ProtoRuleToIptablesRules(auditRule, uint8(ipVer))
+			Expect(len(rules)).To(Equal(1))
+			Expect(rules[0].Action).To(Equal(iptables.DropAction{}))
+		},
+		ruleTestData...,
+	)
+
 	DescribeTable(
 		"Deny (DROP) rules should be correctly rendered",
 		func(ipVer int, in *proto.Rule, expMatch string) {
@@ -307,6 +356,73 @@ var _ = Describe("Protobuf rule to iptables rule conversion", func() {
 		ruleTestData...,
 	)
 
+	DescribeTable(
+		"Reject rules should be correctly rendered",
+		func(ipVer int, rate int, in *proto.Rule, expected []generictables.Rule) {
+			rrConfigRejectRule := rrConfigNormal
+			rrConfigRejectRule.RejectRateLimit = rate
+			renderer := NewRenderer(rrConfigRejectRule)
+			in.Action = "reject"
+			rules := renderer.ProtoRuleToIptablesRules(in, uint8(ipVer))
+			Expect(rules).To(Equal(expected))
+		},
+		Entry("Any protocol", 4, 10, &proto.Rule{}, []generictables.Rule{
+			{
+				Match:  iptables.Match().Protocol("tcp").Limit(10, 10),
+				Action: iptables.RejectAction{With: generictables.RejectWithTCPReset},
+			},
+			{Match: iptables.Match().Protocol("tcp"), Action: iptables.DropAction{}},
+			{
+				Match:  iptables.Match().Limit(10, 10),
+				Action: iptables.RejectAction{With: generictables.RejectWithAdminProhibited},
+			},
+			{Match: iptables.Match(), Action: iptables.DropAction{}},
+		}),
+		Entry("Any protocol, IPv6", 6, 10, &proto.Rule{}, []generictables.Rule{
+			{
+				Match:  iptables.Match().Protocol("tcp").Limit(10, 10),
+				Action: iptables.RejectAction{With: generictables.RejectWithTCPReset},
+			},
+			{Match: iptables.Match().Protocol("tcp"), Action: iptables.DropAction{}},
+			{
+				Match:  iptables.Match().Limit(10, 10),
+				Action: iptables.RejectAction{With: generictables.RejectWithAdminProhibitedV6},
+			},
+			{Match: iptables.Match(), Action: iptables.DropAction{}},
+		}),
+		Entry("TCP", 4, 10, &proto.Rule{
+			Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Number{Number: 6}},
+		}, []generictables.Rule{
+			{
+				Match:  iptables.Match().Protocol("tcp").Limit(10, 10),
+				Action: iptables.RejectAction{With: generictables.RejectWithTCPReset},
+			},
+			{Match: iptables.Match().Protocol("tcp"), Action: iptables.DropAction{}},
+		}),
+		Entry("UDP", 4, 10, &proto.Rule{
+			Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "udp"}},
+		}, []generictables.Rule{
+			{
+				Match:  iptables.Match().Protocol("udp").Limit(10, 10),
+				Action: iptables.RejectAction{With: generictables.RejectWithAdminProhibited},
+			},
+			{Match: iptables.Match().Protocol("udp"), Action: iptables.DropAction{}},
+		}),
+		Entry("Not TCP", 4, 10, &proto.Rule{
+			NotProtocol: &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "tcp"}},
+		}, []generictables.Rule{
+			{
+				Match:  iptables.Match().NotProtocol("tcp").Limit(10, 10),
+				Action: iptables.RejectAction{With: generictables.RejectWithAdminProhibited},
+			},
+			{Match: iptables.Match().NotProtocol("tcp"), Action: iptables.DropAction{}},
+		}),
+		Entry("Rate limit 0 drops", 4, 0, &proto.Rule{}, []generictables.Rule{
+			{Match: iptables.Match().Protocol("tcp"), Action: iptables.DropAction{}},
+			{Match: iptables.Match(), Action: iptables.DropAction{}},
+		}),
+	)
+
 	const (
 		clearBothMarksRule       = "-A test --jump MARK --set-mark 0x0/0x600"
 		preSetAllBlocksMarkRule  = "-A test --jump MARK --set-mark 0x200/0x600"
@@ -731,6 +847,28 @@ var _ = Describe("Protobuf rule to iptables rule conversion", func() {
 			returnRule,
 		),
 
+		// ICMP type lists.
+		namedPortEntry(
+			"ICMP type list needs a block",
+			&proto.Rule{
+				Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "icmp"}},
+				Icmp: &proto.Rule_IcmpTypesAndCodes{IcmpTypesAndCodes: &proto.IcmpTypesAndCodes{
+					Types:     []int32{0, 8},
+					TypeCodes: []*proto.IcmpTypeAndCode{{Type: 3, Code: 4}},
+				}},
+				SrcNet: []string{"10.1.0.0/16", "11.0.0.0/8"},
+			},
+			clearBothMarksRule,
+			"-A test -p icmp -m icmp --icmp-type 0 --jump MARK --set-mark 0x200/0x200",
+			"-A test -p icmp -m icmp --icmp-type 8 --jump MARK --set-mark 0x200/0x200",
+			"-A test -p icmp -m icmp --icmp-type 3/4 --jump MARK --set-mark 0x200/0x200",
+			"-A test --source 10.1.0.0/16 --jump MARK --set-mark 0x400/0x400",
+			"-A test --source 11.0.0.0/8 --jump MARK --set-mark 0x400/0x400",
+			allBlocksPassAndEqThisBlockPassRule,
+			"-A test -p icmp -m mark --mark 0x200/0x200 --jump MARK --set-mark 0x80/0x80",
+			returnRule,
+		),
+
 		// CIDRs + named ports.
 		namedPortEntry(
 			"numeric, named ports and CIDRs",
@@ -1118,6 +1256,48 @@ var _ = Describe("rule metadata tests", func() {
 			},
 		))
 	})
+	It("should render staged policy rules without a verdict", func() {
+		renderer := NewRenderer(rrConfigNormal)
+		chains := renderer.PolicyToIptablesChains(
+			&types.PolicyID{
+				Tier: "default",
+				Name: "staged:pol",
+			},
+			&proto.Policy{
+				InboundRules: []*proto.Rule{{Action: "deny"}, {Action: "allow"}},
+				Staged:       true,
+			},
+			4,
+		)
+		Expect(chains).To(ConsistOf(
+			&generictables.Chain{
+				Name: "cali-pi-default/staged:pol",
+				Rules: []generictables.Rule{
+					{
+						Match:  iptables.Match(),
+						Action: iptables.ReturnAction{},
+						Comment: []string{
+							"Staged policy staged:pol ingress",
+						},
+					},
+					{
+						Match:  iptables.Match(),
+						Action: iptables.ReturnAction{},
+					},
+				},
+			},
+			&generictables.Chain{
+				Name: "cali-po-default/staged:pol",
+				Rules: []generictables.Rule{
+					{
+						Comment: []string{
+							"Staged policy staged:pol egress",
+						},
+					},
+				},
+			},
+		))
+	})
 	It("should include a chain name comment", func() {
 		renderer := NewRenderer(rrConfigNormal)
 		inbound, outbound := renderer.ProfileToIptablesChains(
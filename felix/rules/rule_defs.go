@@ -68,6 +68,21 @@ const (
 	IPSetIDAllVXLANSourceNets = "all-vxlan-net"
 	IPSetIDThisHostIPs        = "this-host"
 
+	// DNSNflogGroup is the NFLOG group that DNS responses to workloads are copied to.
+	DNSNflogGroup  = 3
+	DNSNflogPrefix = "DNS"
+	// DNSNflogSize is the number of bytes of each DNS response that are copied; enough for the
+	// answers of typical responses.
+	DNSNflogSize = 1024
+
+	// AuditNflogGroup is the NFLOG group that the first packet of connections matching policy
+	// rules with audit enabled is copied to.  The prefix is followed by the ID of the rule.
+	AuditNflogGroup  = 4
+	AuditNflogPrefix = "AUDIT|"
+	// AuditNflogSize is the number of bytes of each packet that are copied; enough for the
+	// IP and layer 4 headers.
+	AuditNflogSize = 128
+
 	ChainFIPDnat = ChainNamePrefix + "fip-dnat"
 	ChainFIPSnat = ChainNamePrefix + "fip-snat"
 
@@ -259,6 +274,7 @@ type RuleRenderer interface {
 
 	FilterInputChainAllowWG(ipVersion uint8, c Config, allowAction generictables.Action) []generictables.Rule
 	ICMPv6Filter(action generictables.Action) []generictables.Rule
+	DNSSnoopRules() []generictables.Rule
 }
 
 type DefaultRuleRenderer struct {
@@ -349,6 +365,17 @@ type Config struct {
 	FilterAllowAction    string
 	MangleAllowAction    string
 	FilterDenyAction     string
+	// RejectRateLimit is the number of replies per second that each Reject rule may
+	// send, 0 means that rejected packets are always dropped.
+	RejectRateLimit int
+
+	// DNSTrustedServers are the servers whose DNS responses to workloads are copied to
+	// Felix, which learns the IPs of the domains in policy from them.
+	DNSTrustedServers []config.ServerPort
+
+	// PolicyAuditEnabled is true if there is a sink for the audit records of policy rules,
+	// in which case audited rules copy the packets they match to Felix.
+	PolicyAuditEnabled bool
 
 	FailsafeInboundHostPorts  []config.ProtoPort
 	FailsafeOutboundHostPorts []config.ProtoPort
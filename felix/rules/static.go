@@ -16,6 +16,7 @@ package rules
 
 import (
 	"fmt"
+	"slices"
 
 	log "github.com/sirupsen/logrus"
 
@@ -368,6 +369,34 @@ func (r *DefaultRuleRenderer) filterInputChain(ipVersion uint8) *generictables.C
 	}
 }
 
+// DNSSnoopRules returns rules that copy DNS responses that are going to local workloads to
+// Felix, which learns the IPs of the domains used in policy from them.  We can't match on the
+// trusted servers' IPs here because responses from a service have yet to be un-DNATted, so
+// Felix checks the source of each response instead.
+func (r *DefaultRuleRenderer) DNSSnoopRules() []generictables.Rule {
+	var ports []uint16
+	for _, s := range r.DNSTrustedServers {
+		ports = append(ports, s.Port)
+	}
+	if len(ports) == 0 {
+		return nil
+	}
+	slices.Sort(ports)
+	ports = slices.Compact(ports)
+
+	var rules []generictables.Rule
+	for _, prefix := range r.WorkloadIfacePrefixes {
+		rules = append(rules, generictables.Rule{
+			Match: r.NewMatch().
+				Protocol("udp").
+				SourcePorts(ports...).
+				OutInterface(prefix + r.wildcard),
+			Action: r.Nflog(DNSNflogGroup, DNSNflogPrefix, DNSNflogSize),
+		})
+	}
+	return rules
+}
+
 func (r *DefaultRuleRenderer) ICMPv6Filter(action generictables.Action) []generictables.Rule {
 	var rules []generictables.Rule
 
@@ -606,7 +635,8 @@ func (r *DefaultRuleRenderer) failsafeOutChain(table string, ipVersion uint8) *g
 }
 
 func (r *DefaultRuleRenderer) StaticFilterForwardChains() []*generictables.Chain {
-	rules := []generictables.Rule{}
+	// Copy DNS responses to Felix before anything else can accept or drop them.
+	rules := r.DNSSnoopRules()
 
 	// Rules for filter forward chains dispatches the packet to our dispatch chains if it is going
 	// to/from an interface that we're responsible for.  Note: the dispatch chains represent "allow"
@@ -702,7 +732,8 @@ func (r *DefaultRuleRenderer) StaticFilterOutputChains(ipVersion uint8) []*gener
 }
 
 func (r *DefaultRuleRenderer) filterOutputChain(ipVersion uint8) *generictables.Chain {
-	var rules []generictables.Rule
+	// Copy DNS responses from host-networked servers to Felix.
+	rules := r.DNSSnoopRules()
 
 	// Accept immediately if we've already accepted this packet in the raw or mangle table.
 	rules = append(rules, r.acceptAlreadyAccepted()...)
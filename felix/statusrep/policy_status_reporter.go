@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statusrep
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/proto"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+)
+
+// PolicyStatusReporter writes this node's NodePolicyReport, which lists the generation of each
+// active policy that has been passed to the dataplane.  kube-controllers aggregates the reports
+// into the status of each policy.  Reports are only written once the dataplane is in sync, and
+// at most once per interval.
+type PolicyStatusReporter struct {
+	hostname      string
+	generationsC  chan map[model.PolicyKey]int64
+	fromDataplane <-chan interface{}
+	datastore     datastore
+	interval      time.Duration
+	newTimer      func(time.Duration) <-chan time.Time
+}
+
+func NewPolicyStatusReporter(
+	hostname string,
+	fromDataplane <-chan interface{},
+	datastore datastore,
+	interval time.Duration,
+) *PolicyStatusReporter {
+	return &PolicyStatusReporter{
+		hostname:      hostname,
+		generationsC:  make(chan map[model.PolicyKey]int64, 1),
+		fromDataplane: fromDataplane,
+		datastore:     datastore,
+		interval:      interval,
+		newTimer:      time.After,
+	}
+}
+
+// OnPolicyGenerations queues the generations of the policies that have been sent to the
+// dataplane.  It never blocks; only the latest set of generations is kept.
+func (r *PolicyStatusReporter) OnPolicyGenerations(gens map[model.PolicyKey]int64) {
+	for {
+		select {
+		case r.generationsC <- gens:
+			return
+		default:
+		}
+		// Discard the stale update, if the loop hasn't picked it up already.
+		select {
+		case <-r.generationsC:
+		default:
+		}
+	}
+}
+
+func (r *PolicyStatusReporter) Start() {
+	go r.loop(context.Background())
+}
+
+func (r *PolicyStatusReporter) loop(ctx context.Context) {
+	log.WithField("interval", r.interval).Info("Starting policy status reporter")
+	var (
+		inSync  bool
+		pending map[model.PolicyKey]int64
+		dirty   bool
+		// rateLimitC is non-nil while we're waiting for the interval to pass after a write.
+		rateLimitC <-chan time.Time
+	)
+	for {
+		select {
+		case gens := <-r.generationsC:
+			pending = gens
+			dirty = true
+		case msg := <-r.fromDataplane:
+			if _, ok := msg.(*proto.DataplaneInSync); ok {
+				log.Debug("Dataplane in sync, policy status reports enabled")
+				inSync = true
+			}
+		case <-rateLimitC:
+			rateLimitC = nil
+		case <-ctx.Done():
+			log.Info("Policy status reporter stopping")
+			return
+		}
+		if !inSync || !dirty || rateLimitC != nil {
+			continue
+		}
+		if err := r.writeReport(ctx, pending); err != nil {
+			log.WithError(err).Warn("Failed to write policy status report, will retry")
+		} else {
+			dirty = false
+		}
+		rateLimitC = r.newTimer(r.interval)
+	}
+}
+
+func (r *PolicyStatusReporter) writeReport(ctx context.Context, gens map[model.PolicyKey]int64) error {
+	report := libapiv3.NewNodePolicyReport()
+	report.Name = r.hostname
+	report.Spec.Node = r.hostname
+	report.Spec.Policies = policyGenerations(gens)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := r.datastore.Apply(ctx, &model.KVPair{
+		Key:   model.ResourceKey{Kind: libapiv3.KindNodePolicyReport, Name: r.hostname},
+		Value: report,
+	})
+	if err == nil {
+		log.WithField("numPolicies", len(report.Spec.Policies)).Debug("Wrote policy status report")
+	}
+	return err
+}
+
+// policyGenerations converts the generations keyed by Felix's policy keys to the sorted list that
+// goes in the report.  Policies that don't come from a Calico NetworkPolicy or GlobalNetworkPolicy
+// resource, such as those converted from Kubernetes policies, are left out.
+func policyGenerations(gens map[model.PolicyKey]int64) []libapiv3.PolicyGeneration {
+	policies := make([]libapiv3.PolicyGeneration, 0, len(gens))
+	for key, gen := range gens {
+		pg := libapiv3.PolicyGeneration{Kind: apiv3.KindGlobalNetworkPolicy, Name: key.Name, Generation: gen}
+		if ns, name, ok := strings.Cut(key.Name, "/"); ok {
+			pg = libapiv3.PolicyGeneration{Kind: apiv3.KindNetworkPolicy, Namespace: ns, Name: name, Generation: gen}
+		}
+		if isKubernetesPolicyName(pg.Name) {
+			continue
+		}
+		policies = append(policies, pg)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		a, b := policies[i], policies[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return policies
+}
+
+func isKubernetesPolicyName(name string) bool {
+	return strings.HasPrefix(name, names.K8sNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(name, names.K8sAdminNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(name, names.K8sBaselineAdminNetworkPolicyNamePrefix)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statusrep
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/proto"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+var reportKey = model.ResourceKey{Kind: libapiv3.KindNodePolicyReport, Name: hostname}
+
+var _ = Describe("PolicyStatusReporter", func() {
+	var (
+		psr          *PolicyStatusReporter
+		fromDP       chan interface{}
+		datastore    *mockDatastore
+		rateLimitC   chan time.Time
+		cancel       context.CancelFunc
+		reportedPols = func() []libapiv3.PolicyGeneration {
+			snap := datastore.snapshot()
+			r, ok := snap[reportKey]
+			if !ok {
+				return nil
+			}
+			return r.(libapiv3.NodePolicyReport).Spec.Policies
+		}
+	)
+
+	BeforeEach(func() {
+		fromDP = make(chan interface{})
+		datastore = newMockDatastore()
+		rateLimitC = make(chan time.Time)
+		psr = NewPolicyStatusReporter(hostname, fromDP, datastore, 10*time.Second)
+		psr.newTimer = func(d time.Duration) <-chan time.Time {
+			Expect(d).To(Equal(10 * time.Second))
+			return rateLimitC
+		}
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		go psr.loop(ctx)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should not report until the dataplane is in sync", func() {
+		psr.OnPolicyGenerations(map[model.PolicyKey]int64{{Name: "gnp"}: 1})
+		Consistently(datastore.numKVs, "100ms").Should(Equal(0))
+		fromDP <- &proto.DataplaneInSync{}
+		Eventually(reportedPols).Should(Equal([]libapiv3.PolicyGeneration{
+			{Kind: "GlobalNetworkPolicy", Name: "gnp", Generation: 1},
+		}))
+	})
+
+	It("should convert and sort policies, skipping Kubernetes policies", func() {
+		fromDP <- &proto.DataplaneInSync{}
+		psr.OnPolicyGenerations(map[model.PolicyKey]int64{
+			{Name: "ns2/np"}:                                   4,
+			{Name: "ns1/np"}:                                   3,
+			{Name: "gnp"}:                                      2,
+			{Name: "ns1/knp.default.foo"}:                      1,
+			{Name: "kanp.adminnetworkpolicy.bar"}:              1,
+			{Name: "kbanp.baselineadminnetworkpolicy.default"}: 1,
+		})
+		Eventually(reportedPols).Should(Equal([]libapiv3.PolicyGeneration{
+			{Kind: "GlobalNetworkPolicy", Name: "gnp", Generation: 2},
+			{Kind: "NetworkPolicy", Namespace: "ns1", Name: "np", Generation: 3},
+			{Kind: "NetworkPolicy", Namespace: "ns2", Name: "np", Generation: 4},
+		}))
+		snap := datastore.snapshot()
+		Expect(snap[reportKey].(libapiv3.NodePolicyReport).Spec.Node).To(Equal(hostname))
+	})
+
+	It("should rate limit reports", func() {
+		fromDP <- &proto.DataplaneInSync{}
+		psr.OnPolicyGenerations(map[model.PolicyKey]int64{{Name: "gnp"}: 1})
+		Eventually(reportedPols).Should(HaveLen(1))
+
+		psr.OnPolicyGenerations(map[model.PolicyKey]int64{{Name: "gnp"}: 2})
+		Consistently(reportedPols, "100ms").Should(ConsistOf(
+			libapiv3.PolicyGeneration{Kind: "GlobalNetworkPolicy", Name: "gnp", Generation: 1},
+		))
+		rateLimitC <- time.Now()
+		Eventually(reportedPols).Should(ConsistOf(
+			libapiv3.PolicyGeneration{Kind: "GlobalNetworkPolicy", Name: "gnp", Generation: 2},
+		))
+	})
+
+	It("should retry after a failed write", func() {
+		datastore.ApplyErrs = []error{errors.New("dummy error")}
+		fromDP <- &proto.DataplaneInSync{}
+		psr.OnPolicyGenerations(map[model.PolicyKey]int64{{Name: "gnp"}: 1})
+		Consistently(datastore.numKVs, "100ms").Should(Equal(0))
+		rateLimitC <- time.Now()
+		Eventually(reportedPols).Should(HaveLen(1))
+	})
+})
@@ -43,6 +43,8 @@ import (
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/networkpolicy"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/node"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/pod"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/policyrecommendation"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/policystatus"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/serviceaccount"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/utils"
 	"github.com/projectcalico/calico/kube-controllers/pkg/status"
@@ -465,6 +467,19 @@ func (cc *controllerControl) InitControllers(ctx context.Context, cfg config.Run
 		cc.controllers["LoadBalancer"] = loadBalancerController
 		cc.registerInformers(serviceInformer)
 	}
+
+	if cfg.Controllers.PolicyStatus != nil {
+		policyStatusController := policystatus.NewPolicyStatusController(ctx, calicoClient, *cfg.Controllers.PolicyStatus)
+		cc.controllers["PolicyStatus"] = policyStatusController
+	}
+
+	if cfg.Controllers.PolicyRecommendation != nil {
+		policyRecommendationController, err := policyrecommendation.NewPolicyRecommendationController(ctx, calicoClient, *cfg.Controllers.PolicyRecommendation)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to create policy recommendation controller")
+		}
+		cc.controllers["PolicyRecommendation"] = policyRecommendationController
+	}
 }
 
 // registerInformers registers the given informers, if not already registered. Registered informers
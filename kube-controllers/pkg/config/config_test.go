@@ -120,10 +120,11 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        true,
-					AutoHostEndpoints: false,
-					DeleteNodes:       true,
-					LeakGracePeriod:   &v1.Duration{Duration: 15 * time.Minute},
+					SyncLabels:                true,
+					AutoHostEndpoints:         false,
+					CreateDefaultHostEndpoint: true,
+					DeleteNodes:               true,
+					LeakGracePeriod:           &v1.Duration{Duration: 15 * time.Minute},
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Minute * 5,
@@ -194,8 +195,14 @@ var _ = Describe("Config", func() {
 						Node: &v3.NodeControllerConfig{
 							ReconcilerPeriod: nil,
 							SyncLabels:       v3.Disabled,
-							HostEndpoint:     &v3.AutoHostEndpointConfig{AutoCreate: v3.Enabled},
-							LeakGracePeriod:  &v1.Duration{Duration: 20 * time.Minute},
+							HostEndpoint: &v3.AutoHostEndpointConfig{
+								AutoCreate:                v3.Enabled,
+								CreateDefaultHostEndpoint: v3.Disabled,
+								Templates: []v3.AutoHostEndpointTemplate{
+									{GenerateName: "eth0", NodeSelector: "has(edge)", InterfaceName: "eth0"},
+								},
+							},
+							LeakGracePeriod: &v1.Duration{Duration: 20 * time.Minute},
 						},
 						Policy: &v3.PolicyControllerConfig{
 							ReconcilerPeriod: &v1.Duration{Duration: time.Second * 30}},
@@ -208,6 +215,13 @@ var _ = Describe("Config", func() {
 						LoadBalancer: &v3.LoadBalancerControllerConfig{
 							AssignIPs: v3.RequestedServicesOnly,
 						},
+						PolicyStatus: &v3.PolicyStatusControllerConfig{
+							ReconcilerPeriod: &v1.Duration{Duration: time.Second * 34}},
+						PolicyRecommendation: &v3.PolicyRecommendationControllerConfig{
+							ReconcilerPeriod: &v1.Duration{Duration: time.Second * 35},
+							LearningPeriod:   &v1.Duration{Duration: time.Hour * 2},
+							Namespaces:       []string{"ns1"},
+						},
 					},
 				}
 				m = &mockKCC{get: kcc}
@@ -227,10 +241,14 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        false,
-					AutoHostEndpoints: true,
-					DeleteNodes:       true,
-					LeakGracePeriod:   &v1.Duration{Duration: 20 * time.Minute},
+					SyncLabels:                false,
+					AutoHostEndpoints:         true,
+					CreateDefaultHostEndpoint: false,
+					HostEndpointTemplates: []v3.AutoHostEndpointTemplate{
+						{GenerateName: "eth0", NodeSelector: "has(edge)", InterfaceName: "eth0"},
+					},
+					DeleteNodes:     true,
+					LeakGracePeriod: &v1.Duration{Duration: 20 * time.Minute},
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Second * 30,
@@ -251,6 +269,15 @@ var _ = Describe("Config", func() {
 				Expect(rc.LoadBalancer).To(Equal(&config.LoadBalancerControllerConfig{
 					AssignIPs: v3.RequestedServicesOnly,
 				}))
+				Expect(rc.PolicyStatus).To(Equal(&config.GenericControllerConfig{
+					ReconcilerPeriod: time.Second * 34,
+				}))
+				Expect(rc.PolicyRecommendation).To(Equal(&config.PolicyRecommendationControllerConfig{
+					ReconcilerPeriod: time.Second * 35,
+					GoldmaneAddress:  "goldmane.calico-system.svc:443",
+					LearningPeriod:   time.Hour * 2,
+					Namespaces:       []string{"ns1"},
+				}))
 				close(done)
 			})
 
@@ -453,10 +480,11 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        false,
-					AutoHostEndpoints: true,
-					DeleteNodes:       true,
-					LeakGracePeriod:   &v1.Duration{Duration: 15 * time.Minute},
+					SyncLabels:                false,
+					AutoHostEndpoints:         true,
+					CreateDefaultHostEndpoint: true,
+					DeleteNodes:               true,
+					LeakGracePeriod:           &v1.Duration{Duration: 15 * time.Minute},
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Second * 105,
@@ -546,9 +574,10 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        false,
-					AutoHostEndpoints: true,
-					DeleteNodes:       true,
+					SyncLabels:                false,
+					AutoHostEndpoints:         true,
+					CreateDefaultHostEndpoint: true,
+					DeleteNodes:               true,
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Second * 105,
@@ -86,12 +86,14 @@ type RunConfig struct {
 }
 
 type ControllersConfig struct {
-	Node             *NodeControllerConfig
-	Policy           *GenericControllerConfig
-	WorkloadEndpoint *GenericControllerConfig
-	ServiceAccount   *GenericControllerConfig
-	Namespace        *GenericControllerConfig
-	LoadBalancer     *LoadBalancerControllerConfig
+	Node                 *NodeControllerConfig
+	Policy               *GenericControllerConfig
+	WorkloadEndpoint     *GenericControllerConfig
+	ServiceAccount       *GenericControllerConfig
+	Namespace            *GenericControllerConfig
+	LoadBalancer         *LoadBalancerControllerConfig
+	PolicyStatus         *GenericControllerConfig
+	PolicyRecommendation *PolicyRecommendationControllerConfig
 }
 
 type GenericControllerConfig struct {
@@ -103,6 +105,11 @@ type NodeControllerConfig struct {
 	SyncLabels        bool
 	AutoHostEndpoints bool
 
+	// Whether to create the default all-interfaces host endpoint for each node, and the
+	// templates for any other host endpoints, when AutoHostEndpoints is enabled.
+	CreateDefaultHostEndpoint bool
+	HostEndpointTemplates     []v3.AutoHostEndpointTemplate
+
 	// Should the Node controller delete Calico nodes?  Generally, this is
 	// true for etcdv3 datastores.
 	DeleteNodes bool
@@ -117,6 +124,13 @@ type LoadBalancerControllerConfig struct {
 	AssignIPs v3.AssignIPs
 }
 
+type PolicyRecommendationControllerConfig struct {
+	ReconcilerPeriod time.Duration
+	GoldmaneAddress  string
+	LearningPeriod   time.Duration
+	Namespaces       []string
+}
+
 type RunConfigController struct {
 	out chan RunConfig
 }
@@ -372,9 +386,37 @@ func mergeConfig(envVars map[string]string, envCfg Config, apiCfg v3.KubeControl
 		}
 	}
 
+	if rc.PolicyRecommendation != nil {
+		mergePolicyRecommendation(&status, &rCfg, apiCfg)
+	}
+
 	return rCfg, status
 }
 
+// mergePolicyRecommendation merges the policy recommendation settings, which are only configurable
+// through the API.
+func mergePolicyRecommendation(status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec) {
+	rc := rCfg.Controllers.PolicyRecommendation
+	sc := status.RunningConfig.Controllers.PolicyRecommendation
+	rc.GoldmaneAddress = "goldmane.calico-system.svc:443"
+	rc.LearningPeriod = time.Hour
+
+	ac := apiCfg.Controllers.PolicyRecommendation
+	if ac == nil {
+		return
+	}
+	if ac.GoldmaneAddress != "" {
+		rc.GoldmaneAddress = ac.GoldmaneAddress
+	}
+	if ac.LearningPeriod != nil {
+		rc.LearningPeriod = ac.LearningPeriod.Duration
+	}
+	rc.Namespaces = ac.Namespaces
+	sc.GoldmaneAddress = ac.GoldmaneAddress
+	sc.LearningPeriod = ac.LearningPeriod
+	sc.Namespaces = ac.Namespaces
+}
+
 func mergeAutoHostEndpoints(envVars map[string]string, status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec) {
 	// make these names shorter
 	rc := &rCfg.Controllers
@@ -399,6 +441,17 @@ func mergeAutoHostEndpoints(envVars map[string]string, status *v3.KubeController
 	} else {
 		sc.Node.HostEndpoint = &v3.AutoHostEndpointConfig{AutoCreate: v3.Disabled}
 	}
+
+	// There are no env vars for the host endpoints to create, so always merge them from the API config.
+	rc.Node.CreateDefaultHostEndpoint = true
+	if ac.Node != nil && ac.Node.HostEndpoint != nil {
+		if ac.Node.HostEndpoint.CreateDefaultHostEndpoint == v3.Disabled {
+			rc.Node.CreateDefaultHostEndpoint = false
+		}
+		rc.Node.HostEndpointTemplates = ac.Node.HostEndpoint.Templates
+		sc.Node.HostEndpoint.CreateDefaultHostEndpoint = ac.Node.HostEndpoint.CreateDefaultHostEndpoint
+		sc.Node.HostEndpoint.Templates = ac.Node.HostEndpoint.Templates
+	}
 }
 
 func mergeSyncNodeLabels(envVars map[string]string, status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec, cfg Config) {
@@ -512,6 +565,14 @@ func mergeReconcilerPeriod(envVars map[string]string, status *v3.KubeControllers
 			rc.Namespace.ReconcilerPeriod = d
 			sc.Namespace.ReconcilerPeriod = &v1.Duration{Duration: d}
 		}
+		if rc.PolicyStatus != nil {
+			rc.PolicyStatus.ReconcilerPeriod = d
+			sc.PolicyStatus.ReconcilerPeriod = &v1.Duration{Duration: d}
+		}
+		if rc.PolicyRecommendation != nil {
+			rc.PolicyRecommendation.ReconcilerPeriod = d
+			sc.PolicyRecommendation.ReconcilerPeriod = &v1.Duration{Duration: d}
+		}
 	}
 }
 
@@ -526,6 +587,8 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 	s := ac.ServiceAccount
 	ns := ac.Namespace
 	lb := ac.LoadBalancer
+	ps := ac.PolicyStatus
+	pr := ac.PolicyRecommendation
 
 	v, p := envVars[EnvEnabledControllers]
 	if p {
@@ -550,6 +613,12 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 			case "loadbalancer":
 				rc.LoadBalancer = &LoadBalancerControllerConfig{}
 				sc.LoadBalancer = &v3.LoadBalancerControllerConfig{}
+			case "policystatus":
+				rc.PolicyStatus = &GenericControllerConfig{}
+				sc.PolicyStatus = &v3.PolicyStatusControllerConfig{}
+			case "policyrecommendation":
+				rc.PolicyRecommendation = &PolicyRecommendationControllerConfig{}
+				sc.PolicyRecommendation = &v3.PolicyRecommendationControllerConfig{}
 			case "flannelmigration":
 				log.WithField(EnvEnabledControllers, v).Fatal("cannot run flannelmigration with other controllers")
 			default:
@@ -593,6 +662,16 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 			rc.LoadBalancer = &LoadBalancerControllerConfig{}
 			sc.LoadBalancer = &v3.LoadBalancerControllerConfig{}
 		}
+
+		if ps != nil {
+			rc.PolicyStatus = &GenericControllerConfig{}
+			sc.PolicyStatus = &v3.PolicyStatusControllerConfig{}
+		}
+
+		if pr != nil {
+			rc.PolicyRecommendation = &PolicyRecommendationControllerConfig{}
+			sc.PolicyRecommendation = &v3.PolicyRecommendationControllerConfig{}
+		}
 	}
 
 	// Set reconciler periods, if enabled
@@ -628,6 +707,22 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 		}
 		sc.ServiceAccount.ReconcilerPeriod = s.ReconcilerPeriod
 	}
+	if rc.PolicyStatus != nil && ps != nil {
+		if ps.ReconcilerPeriod == nil {
+			rc.PolicyStatus.ReconcilerPeriod = time.Minute * 5
+		} else {
+			rc.PolicyStatus.ReconcilerPeriod = ps.ReconcilerPeriod.Duration
+		}
+		sc.PolicyStatus.ReconcilerPeriod = ps.ReconcilerPeriod
+	}
+	if rc.PolicyRecommendation != nil && pr != nil {
+		if pr.ReconcilerPeriod == nil {
+			rc.PolicyRecommendation.ReconcilerPeriod = time.Minute * 5
+		} else {
+			rc.PolicyRecommendation.ReconcilerPeriod = pr.ReconcilerPeriod.Duration
+		}
+		sc.PolicyRecommendation.ReconcilerPeriod = pr.ReconcilerPeriod
+	}
 }
 
 func mergeLogLevel(envVars map[string]string, status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec) {
@@ -74,6 +74,16 @@ func (f *FakeCalicoClient) NetworkPolicies() clientv3.NetworkPolicyInterface {
 	panic("not implemented")
 }
 
+// StagedGlobalNetworkPolicies returns an interface for managing staged global network policy resources.
+func (f *FakeCalicoClient) StagedGlobalNetworkPolicies() clientv3.StagedGlobalNetworkPolicyInterface {
+	panic("not implemented")
+}
+
+// StagedNetworkPolicies returns an interface for managing staged namespaced network policy resources.
+func (f *FakeCalicoClient) StagedNetworkPolicies() clientv3.StagedNetworkPolicyInterface {
+	panic("not implemented")
+}
+
 // IPPools returns an interface for managing IP pool resources.
 func (f *FakeCalicoClient) IPPools() clientv3.IPPoolInterface {
 	panic("not implemented")
@@ -35,14 +35,25 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/net"
 	"github.com/projectcalico/calico/libcalico-go/lib/options"
 	"github.com/projectcalico/calico/libcalico-go/lib/resources"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
 func NewAutoHEPController(c config.NodeControllerConfig, client client.Interface) *autoHostEndpointController {
 	ctrl := &autoHostEndpointController{
-		rl:        workqueue.DefaultTypedControllerRateLimiter[any](),
-		config:    c,
-		client:    client,
-		nodeCache: make(map[string]*libapi.Node),
+		rl:                workqueue.DefaultTypedControllerRateLimiter[any](),
+		config:            c,
+		client:            client,
+		nodeCache:         make(map[string]*libapi.Node),
+		nodeHostendpoints: make(map[string]set.Set[string]),
+	}
+	for _, t := range c.HostEndpointTemplates {
+		tmpl, err := parseHostendpointTemplate(t)
+		if err != nil {
+			logrus.WithError(err).Errorf("ignoring invalid hostendpoint template %q", t.GenerateName)
+			continue
+		}
+		ctrl.templates = append(ctrl.templates, tmpl)
 	}
 	return ctrl
 }
@@ -53,6 +64,40 @@ type autoHostEndpointController struct {
 	client     client.Interface
 	nodeCache  map[string]*libapi.Node
 	syncStatus bapi.SyncStatus
+	templates  []hostendpointTemplate
+
+	// nodeHostendpoints holds the names of the auto hostendpoints last synced
+	// for each node, so that the ones no longer expected can be deleted.
+	nodeHostendpoints map[string]set.Set[string]
+}
+
+// hostendpointTemplate is an AutoHostEndpointTemplate with its node selector
+// and CIDRs parsed.
+type hostendpointTemplate struct {
+	api.AutoHostEndpointTemplate
+	selector selector.Selector
+	cidrs    []*net.IPNet
+}
+
+func parseHostendpointTemplate(t api.AutoHostEndpointTemplate) (hostendpointTemplate, error) {
+	tmpl := hostendpointTemplate{AutoHostEndpointTemplate: t}
+	nodeSelector := t.NodeSelector
+	if nodeSelector == "" {
+		nodeSelector = "all()"
+	}
+	sel, err := selector.Parse(nodeSelector)
+	if err != nil {
+		return tmpl, fmt.Errorf("invalid node selector %q: %w", t.NodeSelector, err)
+	}
+	tmpl.selector = sel
+	for _, c := range t.InterfaceCIDRs {
+		_, cidr, err := net.ParseCIDR(c)
+		if err != nil {
+			return tmpl, fmt.Errorf("invalid interface CIDR %q: %w", c, err)
+		}
+		tmpl.cidrs = append(tmpl.cidrs, cidr)
+	}
+	return tmpl, nil
 }
 
 func (c *autoHostEndpointController) RegisterWith(f *utils.DataFeed) {
@@ -101,25 +146,49 @@ func (c *autoHostEndpointController) onUpdate(update bapi.Update) {
 				// Try to perform unmapping based on resource name (calico node name).
 				nodeName := update.KVPair.Key.(model.ResourceKey).Name
 				if c.config.AutoHostEndpoints && c.syncStatus == bapi.InSync {
-					hepName := c.generateAutoHostendpointName(nodeName)
-					err := c.deleteHostendpointWithRetries(context.Background(), hepName)
-					if err != nil {
-						logrus.WithError(err).Fatal()
+					for _, hepName := range c.nodeHostendpointNames(nodeName) {
+						err := c.deleteHostendpointWithRetries(context.Background(), hepName)
+						if err != nil {
+							logrus.WithError(err).Fatal()
+						}
 					}
+					delete(c.nodeHostendpoints, nodeName)
 				}
 			}
 		}
 	}
 }
 
-// deleteAutoHostendpointsWithoutNodes deletes auto hostendpoints that either
-// reference a Calico node that doesn't exist, or, that remain after
-// autoHostEndpoints has been disabled.
-func (c *autoHostEndpointController) deleteAutoHostendpointsWithoutNodes(ctx context.Context, heps map[string]api.HostEndpoint) error {
+// nodeHostendpointNames returns the names of the auto hostendpoints that may
+// exist for the given node.
+func (c *autoHostEndpointController) nodeHostendpointNames(nodeName string) []string {
+	names := set.From(c.generateAutoHostendpointName(nodeName))
+	if synced, ok := c.nodeHostendpoints[nodeName]; ok {
+		names.AddSet(synced)
+	}
+	for _, t := range c.templates {
+		names.Add(c.generateTemplateHostendpointName(nodeName, t.GenerateName))
+	}
+	return names.Slice()
+}
+
+// deleteStaleAutoHostendpoints deletes auto hostendpoints that either
+// reference a Calico node that doesn't exist, that are no longer expected for
+// their node, or, that remain after autoHostEndpoints has been disabled.
+func (c *autoHostEndpointController) deleteStaleAutoHostendpoints(ctx context.Context, heps map[string]api.HostEndpoint) error {
 	for _, hep := range heps {
-		_, hepNodeExists := c.nodeCache[hep.Spec.Node]
+		node, hepNodeExists := c.nodeCache[hep.Spec.Node]
+		hepExpected := false
+		if hepNodeExists {
+			for _, expected := range c.generateAutoHostendpointsFromNode(node) {
+				if expected.Name == hep.Name {
+					hepExpected = true
+					break
+				}
+			}
+		}
 
-		if !hepNodeExists || !c.config.AutoHostEndpoints {
+		if !hepExpected || !c.config.AutoHostEndpoints {
 			err := c.deleteHostendpoint(ctx, hep.Name)
 			if err != nil {
 				logrus.WithError(err).Warnf("failed to delete hostendpoint %q", hep.Name)
@@ -154,7 +223,7 @@ func (c *autoHostEndpointController) syncAllAutoHostendpoints(ctx context.Contex
 		}
 
 		// Delete any dangling auto hostendpoints
-		if err := c.deleteAutoHostendpointsWithoutNodes(ctx, autoHeps); err != nil {
+		if err := c.deleteStaleAutoHostendpoints(ctx, autoHeps); err != nil {
 			logrus.WithError(err).Warn("failed to delete dangling hostendpoints")
 			time.Sleep(retrySleepTime)
 			continue
@@ -176,28 +245,45 @@ func (c *autoHostEndpointController) syncAllAutoHostendpoints(ctx context.Contex
 	return fmt.Errorf("too many retries when syncing all hostendpoints")
 }
 
-// syncAutoHostendpoint syncs the auto hostendpoint for the given node.
+// syncAutoHostendpoint syncs the auto hostendpoints for the given node, and
+// deletes the ones synced previously that are no longer expected.
 func (c *autoHostEndpointController) syncAutoHostendpoint(ctx context.Context, node *libapi.Node) error {
-	hepName := c.generateAutoHostendpointName(node.Name)
-	logrus.Debugf("syncing hostendpoint %q from node %+v", hepName, node)
+	synced := set.New[string]()
+	for _, expectedHep := range c.generateAutoHostendpointsFromNode(node) {
+		logrus.Debugf("syncing hostendpoint %q from node %+v", expectedHep.Name, node)
 
-	// Try getting the host endpoint.
-	expectedHep := c.generateAutoHostendpointFromNode(node)
-	currentHep, err := c.client.HostEndpoints().Get(ctx, hepName, options.GetOptions{})
-	if err != nil {
-		switch err.(type) {
-		case errors.ErrorResourceDoesNotExist:
-			if _, err := c.createAutoHostendpoint(ctx, node); err != nil {
+		// Try getting the host endpoint.
+		currentHep, err := c.client.HostEndpoints().Get(ctx, expectedHep.Name, options.GetOptions{})
+		if err != nil {
+			switch err.(type) {
+			case errors.ErrorResourceDoesNotExist:
+				if _, err := c.createAutoHostendpoint(ctx, expectedHep); err != nil {
+					return err
+				}
+			default:
 				return err
 			}
-		default:
+		} else if err := c.updateHostendpoint(currentHep, expectedHep); err != nil {
 			return err
 		}
-	} else if err := c.updateHostendpoint(currentHep, expectedHep); err != nil {
-		return err
+		synced.Add(expectedHep.Name)
+
+		logrus.WithField("hep.Name", expectedHep.Name).Debug("successfully synced hostendpoint")
 	}
 
-	logrus.WithField("hep.Name", expectedHep.Name).Debug("successfully synced hostendpoint")
+	if previous, ok := c.nodeHostendpoints[node.Name]; ok {
+		for _, hepName := range previous.Slice() {
+			if synced.Contains(hepName) {
+				continue
+			}
+			if err := c.deleteHostendpoint(ctx, hepName); err != nil {
+				if _, ok := err.(errors.ErrorResourceDoesNotExist); !ok {
+					return err
+				}
+			}
+		}
+	}
+	c.nodeHostendpoints[node.Name] = synced
 	return nil
 }
 
@@ -277,9 +363,8 @@ func isAutoHostendpoint(h *api.HostEndpoint) bool {
 	return ok && v == hepCreatedLabelValue
 }
 
-// createAutoHostendpoint creates an auto hostendpoint for the specified node.
-func (c *autoHostEndpointController) createAutoHostendpoint(ctx context.Context, n *libapi.Node) (*api.HostEndpoint, error) {
-	hep := c.generateAutoHostendpointFromNode(n)
+// createAutoHostendpoint creates the given auto hostendpoint.
+func (c *autoHostEndpointController) createAutoHostendpoint(ctx context.Context, hep *api.HostEndpoint) (*api.HostEndpoint, error) {
 	rlKey := rateLimiterItemKey{Type: RateLimitCalicoCreate, Name: hep.Name}
 
 	time.Sleep(c.rl.When(rlKey))
@@ -297,6 +382,12 @@ func (c *autoHostEndpointController) generateAutoHostendpointName(nodeName strin
 	return fmt.Sprintf("%s-auto-hep", nodeName)
 }
 
+// generateTemplateHostendpointName returns the name of the hostendpoint
+// created from a template.
+func (c *autoHostEndpointController) generateTemplateHostendpointName(nodeName, generateName string) string {
+	return fmt.Sprintf("%s-%s", nodeName, generateName)
+}
+
 // getAutoHostendpointExpectedIPs returns all of the known IPs on the node resource
 // that should set on the auto hostendpoint.
 func (c *autoHostEndpointController) getAutoHostendpointExpectedIPs(node *libapi.Node) []string {
@@ -369,6 +460,75 @@ func (c *autoHostEndpointController) generateAutoHostendpointFromNode(node *liba
 	}
 }
 
+// generateAutoHostendpointsFromNode returns all of the auto hostendpoints
+// expected for the given node: the default all-interfaces hostendpoint, if
+// enabled, and one for each template that applies to the node.
+func (c *autoHostEndpointController) generateAutoHostendpointsFromNode(node *libapi.Node) []*api.HostEndpoint {
+	var heps []*api.HostEndpoint
+	if c.config.CreateDefaultHostEndpoint {
+		heps = append(heps, c.generateAutoHostendpointFromNode(node))
+	}
+	for _, t := range c.templates {
+		if hep := c.generateTemplateHostendpointFromNode(node, t); hep != nil {
+			heps = append(heps, hep)
+		}
+	}
+	return heps
+}
+
+// generateTemplateHostendpointFromNode returns the hostendpoint to be created
+// from the given template for the given node, or nil if the template does not
+// select the node or none of the node's IPs are within the template's CIDRs.
+func (c *autoHostEndpointController) generateTemplateHostendpointFromNode(node *libapi.Node, t hostendpointTemplate) *api.HostEndpoint {
+	if !t.selector.Evaluate(node.Labels) {
+		return nil
+	}
+
+	expectedIPs := c.getAutoHostendpointExpectedIPs(node)
+	if len(t.cidrs) > 0 {
+		var matchingIPs []string
+		for _, addr := range expectedIPs {
+			ip, _, err := net.ParseCIDROrIP(addr)
+			if err != nil {
+				continue
+			}
+			for _, cidr := range t.cidrs {
+				if cidr.Contains(ip.IP) {
+					matchingIPs = append(matchingIPs, addr)
+					break
+				}
+			}
+		}
+		if len(matchingIPs) == 0 {
+			logrus.Debugf("no IPs of node %q match hostendpoint template %q", node.Name, t.GenerateName)
+			return nil
+		}
+		expectedIPs = matchingIPs
+	}
+
+	hepLabels := make(map[string]string, len(node.Labels)+len(t.Labels)+1)
+	for k, v := range node.Labels {
+		hepLabels[k] = v
+	}
+	for k, v := range t.Labels {
+		hepLabels[k] = v
+	}
+	hepLabels[hepCreatedLabelKey] = hepCreatedLabelValue
+
+	return &api.HostEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   c.generateTemplateHostendpointName(node.Name, t.GenerateName),
+			Labels: hepLabels,
+		},
+		Spec: api.HostEndpointSpec{
+			Node:          node.Name,
+			InterfaceName: t.InterfaceName,
+			ExpectedIPs:   expectedIPs,
+			Profiles:      []string{resources.DefaultAllowProfileName},
+		},
+	}
+}
+
 // hostendpointNeedsUpdate returns true if the current automatic hostendpoint
 // needs to be updated.
 func (c *autoHostEndpointController) hostendpointNeedsUpdate(current *api.HostEndpoint, expected *api.HostEndpoint) bool {
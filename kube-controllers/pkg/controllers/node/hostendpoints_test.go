@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package node
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+)
+
+var _ = Describe("Auto hostendpoint templates", func() {
+	var node *libapiv3.Node
+
+	BeforeEach(func() {
+		node = libapiv3.NewNode()
+		node.Name = "node1"
+		node.Labels = map[string]string{"role": "edge", "zone": "a"}
+		node.Spec = libapiv3.NodeSpec{
+			BGP: &libapiv3.NodeBGPSpec{
+				IPv4Address:        "172.16.1.1/24",
+				IPv4IPIPTunnelAddr: "192.168.100.1",
+			},
+			Addresses: []libapiv3.NodeAddress{
+				{Address: "172.16.1.1", Type: libapiv3.InternalIP},
+				{Address: "10.10.0.5", Type: libapiv3.InternalIP},
+			},
+		}
+	})
+
+	hepNames := func(c *autoHostEndpointController) []string {
+		var names []string
+		for _, hep := range c.generateAutoHostendpointsFromNode(node) {
+			names = append(names, hep.Name)
+		}
+		return names
+	}
+
+	It("should only create the default hostendpoint without templates", func() {
+		c := NewAutoHEPController(config.NodeControllerConfig{AutoHostEndpoints: true, CreateDefaultHostEndpoint: true}, nil)
+		Expect(hepNames(c)).To(Equal([]string{"node1-auto-hep"}))
+	})
+
+	It("should create hostendpoints from the templates that select the node", func() {
+		c := NewAutoHEPController(config.NodeControllerConfig{
+			AutoHostEndpoints:         true,
+			CreateDefaultHostEndpoint: true,
+			HostEndpointTemplates: []apiv3.AutoHostEndpointTemplate{
+				{GenerateName: "eth0", NodeSelector: "role == 'edge'", InterfaceName: "eth0", Labels: map[string]string{"zone": "b", "interface": "eth0"}},
+				{GenerateName: "storage", InterfaceCIDRs: []string{"10.10.0.0/16"}},
+				{GenerateName: "core", NodeSelector: "role == 'core'", InterfaceName: "*"},
+				{GenerateName: "mgmt", InterfaceCIDRs: []string{"10.20.0.0/16"}},
+			},
+		}, nil)
+		heps := c.generateAutoHostendpointsFromNode(node)
+		Expect(hepNames(c)).To(Equal([]string{"node1-auto-hep", "node1-eth0", "node1-storage"}))
+
+		eth0 := heps[1]
+		Expect(eth0.Labels).To(Equal(map[string]string{
+			"role":                         "edge",
+			"zone":                         "b",
+			"interface":                    "eth0",
+			"projectcalico.org/created-by": "calico-kube-controllers",
+		}))
+		Expect(eth0.Spec.Node).To(Equal("node1"))
+		Expect(eth0.Spec.InterfaceName).To(Equal("eth0"))
+		Expect(eth0.Spec.ExpectedIPs).To(Equal([]string{"172.16.1.1", "192.168.100.1", "10.10.0.5"}))
+		Expect(eth0.Spec.Profiles).To(Equal([]string{"projectcalico-default-allow"}))
+
+		storage := heps[2]
+		Expect(storage.Spec.InterfaceName).To(Equal(""))
+		Expect(storage.Spec.ExpectedIPs).To(Equal([]string{"10.10.0.5"}))
+	})
+
+	It("should not create the default hostendpoint if it is disabled", func() {
+		c := NewAutoHEPController(config.NodeControllerConfig{
+			AutoHostEndpoints: true,
+			HostEndpointTemplates: []apiv3.AutoHostEndpointTemplate{
+				{GenerateName: "eth0", InterfaceName: "eth0"},
+			},
+		}, nil)
+		Expect(hepNames(c)).To(Equal([]string{"node1-eth0"}))
+	})
+
+	It("should ignore invalid templates", func() {
+		c := NewAutoHEPController(config.NodeControllerConfig{
+			AutoHostEndpoints: true,
+			HostEndpointTemplates: []apiv3.AutoHostEndpointTemplate{
+				{GenerateName: "bad", NodeSelector: "has(", InterfaceName: "eth0"},
+				{GenerateName: "eth0", InterfaceName: "eth0"},
+			},
+		}, nil)
+		Expect(hepNames(c)).To(Equal([]string{"node1-eth0"}))
+	})
+})
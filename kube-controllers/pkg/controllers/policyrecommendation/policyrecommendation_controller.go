@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"time"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	uruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+const (
+	// PolicyName is the name of the StagedNetworkPolicy that holds the recommendation for a namespace.
+	PolicyName = "recommended-policy"
+
+	// RecommendationLabel marks the staged policies that are maintained by the controller.  A
+	// staged policy with the same name but without the label is left alone.
+	RecommendationLabel = "projectcalico.org/policy-recommendation"
+
+	// flowQueryTimeout bounds how long an update waits for flows.
+	flowQueryTimeout = 30 * time.Second
+)
+
+// flowLister returns the aggregated flows matching a request.
+type flowLister interface {
+	List(ctx context.Context, req *proto.FlowRequest) ([]*proto.Flow, error)
+}
+
+// goldmaneFlowLister queries flows from goldmane's flow API.
+type goldmaneFlowLister struct {
+	client proto.FlowAPIClient
+}
+
+func (g *goldmaneFlowLister) List(ctx context.Context, req *proto.FlowRequest) ([]*proto.Flow, error) {
+	ctx, cancel := context.WithTimeout(ctx, flowQueryTimeout)
+	defer cancel()
+
+	stream, err := g.client.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var flows []*proto.Flow
+	for {
+		f, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return flows, nil
+		} else if err != nil {
+			return nil, err
+		}
+		flows = append(flows, f)
+	}
+}
+
+// policyRecommendationController periodically reads the flows aggregated by goldmane and maintains
+// a StagedNetworkPolicy in each namespace that allows the observed traffic.
+type policyRecommendationController struct {
+	ctx    context.Context
+	cfg    config.PolicyRecommendationControllerConfig
+	client client.Interface
+	flows  flowLister
+}
+
+// NewPolicyRecommendationController returns a controller which recommends policies from observed flows.
+func NewPolicyRecommendationController(ctx context.Context, calicoClient client.Interface, cfg config.PolicyRecommendationControllerConfig) (*policyRecommendationController, error) {
+	// Goldmane serves its flow API without TLS.
+	conn, err := grpc.NewClient(cfg.GoldmaneAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &policyRecommendationController{
+		ctx:    ctx,
+		cfg:    cfg,
+		client: calicoClient,
+		flows:  &goldmaneFlowLister{client: proto.NewFlowAPIClient(conn)},
+	}, nil
+}
+
+// Run starts the controller.
+func (c *policyRecommendationController) Run(stopCh chan struct{}) {
+	defer uruntime.HandleCrash()
+
+	period := c.cfg.ReconcilerPeriod
+	if period <= 0 {
+		period = 5 * time.Minute
+	}
+	log.WithFields(log.Fields{
+		"period":   period,
+		"goldmane": c.cfg.GoldmaneAddress,
+	}).Info("Starting policy recommendation controller")
+	t := time.NewTicker(period)
+	defer t.Stop()
+
+	for {
+		c.reconcile()
+		select {
+		case <-t.C:
+		case <-stopCh:
+			log.Info("Stopping policy recommendation controller")
+			return
+		}
+	}
+}
+
+// reconcile updates the recommended policies from the flows in the learning period.
+func (c *policyRecommendationController) reconcile() {
+	learningPeriod := c.cfg.LearningPeriod
+	if learningPeriod <= 0 {
+		learningPeriod = time.Hour
+	}
+	flows, err := c.flows.List(c.ctx, &proto.FlowRequest{StartTimeGt: time.Now().Add(-learningPeriod).Unix()})
+	if err != nil {
+		log.WithError(err).Warn("Failed to query flows, will retry")
+		return
+	}
+
+	recs := recommend(flows, c.cfg.Namespaces)
+	namespaces := make([]string, 0, len(recs))
+	for ns := range recs {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	for _, ns := range namespaces {
+		if err := c.updateRecommendation(recs[ns]); err != nil {
+			log.WithError(err).WithField("namespace", ns).Warn("Failed to update recommended policy, will retry")
+		}
+	}
+}
+
+// updateRecommendation creates or updates the staged policy for a namespace.
+func (c *policyRecommendationController) updateRecommendation(rec *recommendation) error {
+	ingress, egress := rec.policyRules()
+
+	existing, err := c.client.StagedNetworkPolicies().Get(c.ctx, rec.namespace, PolicyName, options.GetOptions{})
+	if err != nil {
+		if _, ok := err.(cerrors.ErrorResourceDoesNotExist); !ok {
+			return err
+		}
+		policy := api.NewStagedNetworkPolicy()
+		policy.Name = PolicyName
+		policy.Namespace = rec.namespace
+		policy.Labels = map[string]string{RecommendationLabel: "true"}
+		policy.Spec = api.StagedNetworkPolicySpec{
+			StagedAction: api.StagedActionSet,
+			Selector:     "all()",
+			Types:        []api.PolicyType{api.PolicyTypeIngress, api.PolicyTypeEgress},
+			Ingress:      ingress,
+			Egress:       egress,
+		}
+		log.WithField("namespace", rec.namespace).Info("Creating recommended policy")
+		_, err = c.client.StagedNetworkPolicies().Create(c.ctx, policy, options.SetOptions{})
+		return err
+	}
+
+	if existing.Labels[RecommendationLabel] != "true" {
+		log.WithField("namespace", rec.namespace).Debug("Staged policy is not a recommendation, skipping")
+		return nil
+	}
+	updated := existing.DeepCopy()
+	updated.Spec.Ingress = mergeRules(existing.Spec.Ingress, ingress)
+	updated.Spec.Egress = mergeRules(existing.Spec.Egress, egress)
+	if reflect.DeepEqual(updated.Spec, existing.Spec) {
+		return nil
+	}
+	log.WithField("namespace", rec.namespace).Info("Updating recommended policy")
+	_, err = c.client.StagedNetworkPolicies().Update(c.ctx, updated, options.SetOptions{})
+	return err
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+)
+
+var _ = Describe("Policy recommendation", func() {
+	tcp := numorstring.ProtocolFromString("TCP")
+	udp := numorstring.ProtocolFromString("UDP")
+
+	flow := func(reporter, srcNamespace, srcName, dstNamespace, dstName string, port int64, srcLabels, dstLabels []string) *proto.Flow {
+		return &proto.Flow{
+			Key: &proto.FlowKey{
+				SourceName:      srcName,
+				SourceNamespace: srcNamespace,
+				SourceType:      "wep",
+				DestName:        dstName,
+				DestNamespace:   dstNamespace,
+				DestType:        "wep",
+				DestPort:        port,
+				Proto:           "tcp",
+				Reporter:        reporter,
+				Action:          "Allow",
+			},
+			SourceLabels: srcLabels,
+			DestLabels:   dstLabels,
+		}
+	}
+
+	It("should recommend ingress and egress rules from allowed flows", func() {
+		external := flow("src", "prod", "api-*", "", "pub", 443, []string{"app=api"}, nil)
+		external.Key.DestType = "pub"
+		dns := flow("src", "prod", "api-*", "kube-system", "coredns-*", 53, []string{"app=api"}, []string{"k8s-app=kube-dns"})
+		dns.Key.Proto = "udp"
+		denied := flow("dst", "prod", "web-*", "prod", "db-*", 22, []string{"app=web"}, []string{"app=db"})
+		denied.Key.Action = "Deny"
+
+		recs := recommend([]*proto.Flow{
+			flow("dst", "prod", "api-*", "prod", "db-*", 5432,
+				[]string{"app=api", "pod-template-hash=abc", "version=v1"}, []string{"app=db", "projectcalico.org/namespace=prod"}),
+			flow("dst", "prod", "api-*", "prod", "db-*", 5433,
+				[]string{"app=api", "pod-template-hash=def", "version=v2"}, []string{"app=db"}),
+			flow("dst", "dev", "tools-*", "prod", "db-*", 5432, []string{}, []string{"app=db"}),
+			external,
+			dns,
+			denied,
+			// Reported by the source in dev, which is not in scope.
+			flow("src", "dev", "tools-*", "prod", "db-*", 5432, nil, []string{"app=db"}),
+		}, []string{"prod"})
+		Expect(recs).To(HaveLen(1))
+		Expect(recs).To(HaveKey("prod"))
+
+		ingress, egress := recs["prod"].policyRules()
+		Expect(ingress).To(ConsistOf(
+			api.Rule{
+				Action:   api.Allow,
+				Protocol: &tcp,
+				Source:   api.EntityRule{Selector: "app == 'api'"},
+				Destination: api.EntityRule{
+					Selector: "app == 'db'",
+					Ports:    []numorstring.Port{numorstring.SinglePort(5432), numorstring.SinglePort(5433)},
+				},
+			},
+			api.Rule{
+				Action:   api.Allow,
+				Protocol: &tcp,
+				Source:   api.EntityRule{Selector: "all()", NamespaceSelector: "projectcalico.org/name == 'dev'"},
+				Destination: api.EntityRule{
+					Selector: "app == 'db'",
+					Ports:    []numorstring.Port{numorstring.SinglePort(5432)},
+				},
+			},
+		))
+		Expect(egress).To(ConsistOf(
+			api.Rule{
+				Action:   api.Allow,
+				Protocol: &tcp,
+				Source:   api.EntityRule{Selector: "app == 'api'"},
+				Destination: api.EntityRule{
+					Ports: []numorstring.Port{numorstring.SinglePort(443)},
+				},
+			},
+			api.Rule{
+				Action:   api.Allow,
+				Protocol: &udp,
+				Source:   api.EntityRule{Selector: "app == 'api'"},
+				Destination: api.EntityRule{
+					Selector:          "k8s-app == 'kube-dns'",
+					NamespaceSelector: "projectcalico.org/name == 'kube-system'",
+					Ports:             []numorstring.Port{numorstring.SinglePort(53)},
+				},
+			},
+		))
+	})
+
+	It("should recommend for all namespaces if none are configured", func() {
+		recs := recommend([]*proto.Flow{
+			flow("dst", "prod", "api-*", "prod", "db-*", 5432, nil, nil),
+			flow("src", "dev", "tools-*", "prod", "db-*", 5432, nil, nil),
+		}, nil)
+		Expect(recs).To(HaveLen(2))
+		Expect(recs).To(HaveKey("prod"))
+		Expect(recs).To(HaveKey("dev"))
+	})
+
+	It("should add to the existing rules without removing any", func() {
+		existing := []api.Rule{
+			{
+				Action:      api.Allow,
+				Protocol:    &tcp,
+				Source:      api.EntityRule{Selector: "app == 'api'"},
+				Destination: api.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(80)}},
+			},
+			{
+				Action:   api.Allow,
+				Protocol: &udp,
+				Source:   api.EntityRule{Selector: "app == 'old'"},
+			},
+		}
+		recommended := []api.Rule{
+			{
+				Action:      api.Allow,
+				Protocol:    &tcp,
+				Source:      api.EntityRule{Selector: "app == 'api'"},
+				Destination: api.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(443), numorstring.SinglePort(80)}},
+			},
+			{
+				Action:   api.Allow,
+				Protocol: &tcp,
+				Source:   api.EntityRule{Selector: "app == 'new'"},
+			},
+		}
+		merged := mergeRules(existing, recommended)
+		Expect(merged).To(Equal([]api.Rule{
+			{
+				Action:      api.Allow,
+				Protocol:    &tcp,
+				Source:      api.EntityRule{Selector: "app == 'api'"},
+				Destination: api.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(80), numorstring.SinglePort(443)}},
+			},
+			existing[1],
+			recommended[1],
+		}))
+
+		// Merging again makes no change.
+		Expect(mergeRules(merged, recommended)).To(Equal(merged))
+	})
+})
@@ -0,0 +1,329 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyrecommendation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	"github.com/projectcalico/calico/goldmane/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+)
+
+const (
+	// flowAllow is the action of flows that were allowed.
+	flowAllow = "Allow"
+
+	// Endpoint types reported in flows.
+	typeWorkload   = "wep"
+	typeHost       = "hep"
+	typeNetworkSet = "ns"
+)
+
+// ignoredLabels are labels that differ between the replicas of a workload, or that Calico adds
+// itself, so they are never used in recommended selectors.
+var ignoredLabels = map[string]bool{
+	"pod-template-hash":                  true,
+	"pod-template-generation":            true,
+	"controller-revision-hash":           true,
+	"controller-uid":                     true,
+	"batch.kubernetes.io/controller-uid": true,
+	"statefulset.kubernetes.io/pod-name": true,
+	"apps.kubernetes.io/pod-index":       true,
+}
+
+// endpoint identifies one end of an aggregated flow.
+type endpoint struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// ruleID identifies the flows that are allowed by the same recommended rule: flows in the same
+// direction between the same aggregated endpoints over the same protocol.
+type ruleID struct {
+	direction api.PolicyType
+	local     endpoint
+	peer      endpoint
+	protocol  string
+}
+
+// observedRule accumulates the flows for a recommended rule.
+type observedRule struct {
+	localLabels map[string]string
+	peerLabels  map[string]string
+	ports       map[int64]bool
+}
+
+// recommendation accumulates the flows observed for a single namespace.
+type recommendation struct {
+	namespace string
+	rules     map[ruleID]*observedRule
+}
+
+// recommend groups the allowed flows by the namespace of the workload that reported them.  If
+// namespaces is not empty, flows for other namespaces are ignored.
+func recommend(flows []*proto.Flow, namespaces []string) map[string]*recommendation {
+	inScope := func(ns string) bool {
+		if len(namespaces) == 0 {
+			return ns != ""
+		}
+		for _, n := range namespaces {
+			if n == ns {
+				return true
+			}
+		}
+		return false
+	}
+
+	recs := map[string]*recommendation{}
+	for _, f := range flows {
+		k := f.Key
+		if k == nil || k.Action != flowAllow {
+			continue
+		}
+		src := endpoint{kind: k.SourceType, namespace: k.SourceNamespace, name: k.SourceName}
+		dst := endpoint{kind: k.DestType, namespace: k.DestNamespace, name: k.DestName}
+
+		// Each end of the flow only reports the traffic that its own policy allowed: the
+		// destination its ingress and the source its egress.
+		var id ruleID
+		var localLabels, peerLabels []string
+		switch k.Reporter {
+		case "dst":
+			id = ruleID{direction: api.PolicyTypeIngress, local: dst, peer: src}
+			localLabels, peerLabels = f.DestLabels, f.SourceLabels
+		case "src":
+			id = ruleID{direction: api.PolicyTypeEgress, local: src, peer: dst}
+			localLabels, peerLabels = f.SourceLabels, f.DestLabels
+		default:
+			continue
+		}
+		if id.local.kind != typeWorkload || !inScope(id.local.namespace) {
+			continue
+		}
+		id.protocol = strings.ToLower(k.Proto)
+
+		rec := recs[id.local.namespace]
+		if rec == nil {
+			rec = &recommendation{namespace: id.local.namespace, rules: map[ruleID]*observedRule{}}
+			recs[id.local.namespace] = rec
+		}
+		r := rec.rules[id]
+		if r == nil {
+			r = &observedRule{
+				localLabels: parseLabels(localLabels),
+				peerLabels:  parseLabels(peerLabels),
+				ports:       map[int64]bool{},
+			}
+			rec.rules[id] = r
+		} else {
+			// Only keep the labels that all the aggregated workloads have in common.
+			r.localLabels = intersect(r.localLabels, parseLabels(localLabels))
+			r.peerLabels = intersect(r.peerLabels, parseLabels(peerLabels))
+		}
+		if k.DestPort > 0 {
+			r.ports[k.DestPort] = true
+		}
+	}
+	return recs
+}
+
+// policyRules returns the recommended ingress and egress rules, in a stable order.
+func (rec *recommendation) policyRules() (ingress, egress []api.Rule) {
+	ids := make([]ruleID, 0, len(rec.rules))
+	for id := range rec.rules {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return fmt.Sprint(ids[i]) < fmt.Sprint(ids[j])
+	})
+
+	for _, id := range ids {
+		r := rec.rules[id]
+		local := api.EntityRule{Selector: selectorFor(r.localLabels)}
+		peer := rec.peerEntity(id.peer, r.peerLabels)
+		rule := api.Rule{Action: api.Allow}
+
+		protocol, hasPorts := protocolFor(id.protocol)
+		rule.Protocol = protocol
+		var ports []numorstring.Port
+		if hasPorts {
+			ports = sortedPorts(r.ports)
+		}
+
+		if id.direction == api.PolicyTypeIngress {
+			rule.Source = peer
+			rule.Destination = local
+			rule.Destination.Ports = ports
+			ingress = append(ingress, rule)
+		} else {
+			rule.Source = local
+			rule.Destination = peer
+			rule.Destination.Ports = ports
+			egress = append(egress, rule)
+		}
+	}
+	return ingress, egress
+}
+
+// peerEntity returns the entity rule that matches the peer of a flow.  External networks are not
+// identified in flows, so a rule for traffic to or from them does not restrict the peer.
+func (rec *recommendation) peerEntity(peer endpoint, labels map[string]string) api.EntityRule {
+	switch peer.kind {
+	case typeWorkload:
+		e := api.EntityRule{Selector: selectorFor(labels)}
+		if peer.namespace != rec.namespace {
+			e.NamespaceSelector = fmt.Sprintf("%s == '%s'", conversion.NameLabel, peer.namespace)
+		}
+		return e
+	case typeHost:
+		return api.EntityRule{Selector: selectorFor(labels), NamespaceSelector: "global()"}
+	case typeNetworkSet:
+		e := api.EntityRule{Selector: selectorFor(labels)}
+		if peer.namespace == "" {
+			e.NamespaceSelector = "global()"
+		} else if peer.namespace != rec.namespace {
+			e.NamespaceSelector = fmt.Sprintf("%s == '%s'", conversion.NameLabel, peer.namespace)
+		}
+		return e
+	default:
+		return api.EntityRule{}
+	}
+}
+
+// mergeRules adds the recommended rules to the existing rules.  Rules that only differ in their
+// destination ports are combined, and existing rules are never removed so that a recommendation
+// keeps allowing traffic that is no longer within the learning period.
+func mergeRules(existing, recommended []api.Rule) []api.Rule {
+	merged := make([]api.Rule, 0, len(existing)+len(recommended))
+	index := map[string]int{}
+	for _, r := range append(append([]api.Rule{}, existing...), recommended...) {
+		key := ruleKey(r)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, *r.DeepCopy())
+			continue
+		}
+		merged[i].Destination.Ports = mergePorts(merged[i].Destination.Ports, r.Destination.Ports)
+	}
+	return merged
+}
+
+// ruleKey returns a key that is the same for rules that only differ in their destination ports.
+func ruleKey(r api.Rule) string {
+	r = *r.DeepCopy()
+	r.Destination.Ports = nil
+	b, _ := json.Marshal(r)
+	return string(b)
+}
+
+func mergePorts(a, b []numorstring.Port) []numorstring.Port {
+	if len(a) == 0 || len(b) == 0 {
+		// A rule without ports matches any port.
+		return nil
+	}
+	merged := append([]numorstring.Port{}, a...)
+	for _, p := range b {
+		found := false
+		for _, q := range merged {
+			if p == q {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, p)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].MinPort < merged[j].MinPort
+	})
+	return merged
+}
+
+// protocolFor returns the rule protocol for a flow protocol, and whether the protocol has ports.
+func protocolFor(proto string) (*numorstring.Protocol, bool) {
+	if proto == "" {
+		return nil, false
+	}
+	p := numorstring.ProtocolFromString(proto)
+	if n, err := strconv.ParseUint(proto, 10, 8); err == nil {
+		p = numorstring.ProtocolFromInt(uint8(n))
+	}
+	return &p, p.SupportsPorts()
+}
+
+func sortedPorts(ports map[int64]bool) []numorstring.Port {
+	var sorted []numorstring.Port
+	for p := range ports {
+		if p > 0 && p <= 65535 {
+			sorted = append(sorted, numorstring.SinglePort(uint16(p)))
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinPort < sorted[j].MinPort
+	})
+	return sorted
+}
+
+// parseLabels parses the key=value labels reported in flows, dropping the ones that are not
+// useful in a selector.
+func parseLabels(labels []string) map[string]string {
+	parsed := map[string]string{}
+	for _, l := range labels {
+		k, v, ok := strings.Cut(l, "=")
+		if !ok || ignoredLabels[k] || strings.HasPrefix(k, "projectcalico.org/") || strings.Contains(v, "'") {
+			continue
+		}
+		parsed[k] = v
+	}
+	return parsed
+}
+
+func intersect(a, b map[string]string) map[string]string {
+	common := map[string]string{}
+	for k, v := range a {
+		if b[k] == v {
+			common[k] = v
+		}
+	}
+	return common
+}
+
+// selectorFor returns a selector that matches all the labels.  If there are none, it matches all
+// endpoints.
+func selectorFor(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "all()"
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	terms := make([]string, len(keys))
+	for i, k := range keys {
+		terms[i] = fmt.Sprintf("%s == '%s'", k, labels[k])
+	}
+	return strings.Join(terms, " && ")
+}
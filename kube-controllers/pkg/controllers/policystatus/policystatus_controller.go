@@ -0,0 +1,319 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policystatus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	uruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	validator "github.com/projectcalico/calico/libcalico-go/lib/validator/v3"
+)
+
+// rateLimit is the minimum time between two recalculations triggered by datastore updates.
+const rateLimit = time.Second
+
+type policyID struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// nodeCounts holds the number of nodes that have programmed the current generation of a policy,
+// and the number that are still running an older generation.
+type nodeCounts struct {
+	programmed int32
+	pending    int32
+}
+
+// policyStatusController aggregates the NodePolicyReports written by Felix into the status of
+// each NetworkPolicy and GlobalNetworkPolicy.  It also cleans up the reports of nodes that no
+// longer exist.
+type policyStatusController struct {
+	ctx     context.Context
+	cfg     config.GenericControllerConfig
+	backend bapi.Client
+	syncer  bapi.Syncer
+
+	updatesC chan []bapi.Update
+	statusC  chan bapi.SyncStatus
+	inSync   bool
+
+	policies map[policyID]*model.KVPair
+	reports  map[string]*libapiv3.NodePolicyReport
+	nodes    map[string]bool
+}
+
+// NewPolicyStatusController returns a controller which maintains the status of Calico policies.
+func NewPolicyStatusController(ctx context.Context, calicoClient client.Interface, cfg config.GenericControllerConfig) *policyStatusController {
+	type accessor interface {
+		Backend() bapi.Client
+	}
+	c := &policyStatusController{
+		ctx:      ctx,
+		cfg:      cfg,
+		backend:  calicoClient.(accessor).Backend(),
+		updatesC: make(chan []bapi.Update),
+		statusC:  make(chan bapi.SyncStatus),
+		policies: map[policyID]*model.KVPair{},
+		reports:  map[string]*libapiv3.NodePolicyReport{},
+		nodes:    map[string]bool{},
+	}
+	c.syncer = watchersyncer.New(c.backend, []watchersyncer.ResourceType{
+		{ListInterface: model.ResourceListOptions{Kind: api.KindNetworkPolicy}},
+		{ListInterface: model.ResourceListOptions{Kind: api.KindGlobalNetworkPolicy}},
+		{ListInterface: model.ResourceListOptions{Kind: libapiv3.KindNodePolicyReport}},
+		{ListInterface: model.ResourceListOptions{Kind: libapiv3.KindNode}},
+	}, c)
+	return c
+}
+
+// Run starts the controller.
+func (c *policyStatusController) Run(stopCh chan struct{}) {
+	defer uruntime.HandleCrash()
+
+	c.syncer.Start()
+	defer c.syncer.Stop()
+
+	period := c.cfg.ReconcilerPeriod
+	if period <= 0 {
+		period = 5 * time.Minute
+	}
+	log.WithField("period", period).Info("Starting policy status controller")
+	t := time.NewTicker(period)
+	defer t.Stop()
+
+	var rateLimitC <-chan time.Time
+	dirty := false
+	for {
+		select {
+		case updates := <-c.updatesC:
+			for _, upd := range updates {
+				c.handleUpdate(upd)
+			}
+			dirty = true
+		case status := <-c.statusC:
+			c.inSync = status == bapi.InSync
+			dirty = true
+		case <-t.C:
+			dirty = true
+		case <-rateLimitC:
+			rateLimitC = nil
+		case <-stopCh:
+			log.Info("Stopping policy status controller")
+			return
+		}
+		if !c.inSync || !dirty || rateLimitC != nil {
+			continue
+		}
+		c.reconcile()
+		dirty = false
+		rateLimitC = time.After(rateLimit)
+	}
+}
+
+func (c *policyStatusController) OnStatusUpdated(status bapi.SyncStatus) {
+	c.statusC <- status
+}
+
+func (c *policyStatusController) OnUpdates(updates []bapi.Update) {
+	c.updatesC <- updates
+}
+
+func (c *policyStatusController) handleUpdate(upd bapi.Update) {
+	key, ok := upd.Key.(model.ResourceKey)
+	if !ok {
+		log.WithField("key", upd.Key).Warn("Unexpected key received over syncer")
+		return
+	}
+	deleted := upd.Value == nil
+	switch key.Kind {
+	case api.KindNetworkPolicy, api.KindGlobalNetworkPolicy:
+		id := policyID{kind: key.Kind, namespace: key.Namespace, name: key.Name}
+		if deleted {
+			delete(c.policies, id)
+		} else {
+			c.policies[id] = &upd.KVPair
+		}
+	case libapiv3.KindNodePolicyReport:
+		if deleted {
+			delete(c.reports, key.Name)
+		} else {
+			c.reports[key.Name] = upd.Value.(*libapiv3.NodePolicyReport)
+		}
+	case libapiv3.KindNode:
+		if deleted {
+			delete(c.nodes, key.Name)
+		} else {
+			c.nodes[key.Name] = true
+		}
+	}
+}
+
+// reconcile deletes the reports of nodes that no longer exist, then recalculates the status of
+// every policy and writes back those that have changed.
+func (c *policyStatusController) reconcile() {
+	for name := range c.reports {
+		if c.nodes[name] {
+			continue
+		}
+		log.WithField("node", name).Info("Deleting policy report for node that no longer exists")
+		_, err := c.backend.Delete(c.ctx, model.ResourceKey{Kind: libapiv3.KindNodePolicyReport, Name: name}, "")
+		if err != nil {
+			if _, ok := err.(cerrors.ErrorResourceDoesNotExist); !ok {
+				log.WithError(err).WithField("node", name).Warn("Failed to delete policy report")
+				continue
+			}
+		}
+		delete(c.reports, name)
+	}
+
+	generations := map[policyID]int64{}
+	for id, kvp := range c.policies {
+		generations[id] = kvp.Value.(metav1.ObjectMetaAccessor).GetObjectMeta().GetGeneration()
+	}
+	counts := countNodes(c.reports, generations)
+
+	for id, kvp := range c.policies {
+		if err := c.updatePolicyStatus(kvp, counts[id]); err != nil {
+			// The syncer will send us the latest copy of the policy if this was a conflict, and
+			// the periodic reconcile picks up anything else.
+			log.WithError(err).WithField("policy", id).Warn("Failed to update policy status")
+		}
+	}
+}
+
+func (c *policyStatusController) updatePolicyStatus(kvp *model.KVPair, counts nodeCounts) error {
+	var status *api.PolicyStatus
+	var obj interface{}
+	switch p := kvp.Value.(type) {
+	case *api.NetworkPolicy:
+		p = p.DeepCopy()
+		status, obj = &p.Status, p
+	case *api.GlobalNetworkPolicy:
+		p = p.DeepCopy()
+		status, obj = &p.Status, p
+	default:
+		return fmt.Errorf("unexpected policy type %T", kvp.Value)
+	}
+	generation := obj.(metav1.ObjectMetaAccessor).GetObjectMeta().GetGeneration()
+
+	newStatus := calculateStatus(*status, generation, validator.Validate(obj), counts)
+	if reflect.DeepEqual(*status, newStatus) {
+		return nil
+	}
+	*status = newStatus
+
+	log.WithFields(log.Fields{
+		"key":    kvp.Key,
+		"status": newStatus,
+	}).Debug("Updating policy status")
+	updated := &model.KVPair{Key: kvp.Key, Value: obj, Revision: kvp.Revision}
+	var err error
+	if su, ok := c.backend.(interface {
+		UpdateStatus(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error)
+	}); ok {
+		updated, err = su.UpdateStatus(c.ctx, updated)
+	} else {
+		// The etcd backend stores the status with the rest of the resource.
+		updated, err = c.backend.Update(c.ctx, updated)
+	}
+	if err != nil {
+		return err
+	}
+	*kvp = *updated
+	return nil
+}
+
+// countNodes counts, for each policy, the nodes that have reported the given generation and those
+// that have reported an older one.
+func countNodes(reports map[string]*libapiv3.NodePolicyReport, generations map[policyID]int64) map[policyID]nodeCounts {
+	counts := map[policyID]nodeCounts{}
+	for _, report := range reports {
+		for _, p := range report.Spec.Policies {
+			id := policyID{kind: p.Kind, namespace: p.Namespace, name: p.Name}
+			gen, ok := generations[id]
+			if !ok {
+				continue
+			}
+			nc := counts[id]
+			if p.Generation >= gen {
+				nc.programmed++
+			} else {
+				nc.pending++
+			}
+			counts[id] = nc
+		}
+	}
+	return counts
+}
+
+// calculateStatus returns the status for a policy, given its current status.  Conditions keep
+// their last transition time unless their status changes.
+func calculateStatus(current api.PolicyStatus, generation int64, validationErr error, counts nodeCounts) api.PolicyStatus {
+	status := *current.DeepCopy()
+	status.ObservedGeneration = generation
+	status.ProgrammedNodes = counts.programmed
+	status.PendingNodes = counts.pending
+
+	valid := metav1.Condition{
+		Type:               api.PolicyConditionValid,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             api.PolicyReasonValid,
+		Message:            "Policy is valid",
+	}
+	programmed := metav1.Condition{
+		Type:               api.PolicyConditionProgrammed,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+	}
+	switch {
+	case validationErr != nil:
+		valid.Status = metav1.ConditionFalse
+		valid.Reason = api.PolicyReasonInvalid
+		valid.Message = validationErr.Error()
+		programmed.Reason = api.PolicyReasonInvalid
+		programmed.Message = "Policy is invalid"
+	case counts.pending > 0:
+		programmed.Reason = api.PolicyReasonPending
+		programmed.Message = fmt.Sprintf("%d of %d nodes have programmed the latest generation",
+			counts.programmed, counts.programmed+counts.pending)
+	case counts.programmed > 0:
+		programmed.Status = metav1.ConditionTrue
+		programmed.Reason = api.PolicyReasonProgrammed
+		programmed.Message = fmt.Sprintf("Programmed on %d nodes", counts.programmed)
+	default:
+		programmed.Reason = api.PolicyReasonNotApplied
+		programmed.Message = "Policy does not apply to any endpoints"
+	}
+	meta.SetStatusCondition(&status.Conditions, valid)
+	meta.SetStatusCondition(&status.Conditions, programmed)
+	return status
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policystatus
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+)
+
+var _ = Describe("Policy status calculation", func() {
+	gnp := policyID{kind: api.KindGlobalNetworkPolicy, name: "gnp"}
+	np := policyID{kind: api.KindNetworkPolicy, namespace: "ns", name: "np"}
+
+	report := func(node string, pgs ...libapiv3.PolicyGeneration) *libapiv3.NodePolicyReport {
+		r := libapiv3.NewNodePolicyReport()
+		r.Name = node
+		r.Spec.Node = node
+		r.Spec.Policies = pgs
+		return r
+	}
+
+	It("should count programmed and pending nodes", func() {
+		reports := map[string]*libapiv3.NodePolicyReport{
+			"node1": report("node1",
+				libapiv3.PolicyGeneration{Kind: api.KindGlobalNetworkPolicy, Name: "gnp", Generation: 2},
+				libapiv3.PolicyGeneration{Kind: api.KindNetworkPolicy, Namespace: "ns", Name: "np", Generation: 1},
+			),
+			"node2": report("node2",
+				libapiv3.PolicyGeneration{Kind: api.KindGlobalNetworkPolicy, Name: "gnp", Generation: 1},
+				libapiv3.PolicyGeneration{Kind: api.KindGlobalNetworkPolicy, Name: "deleted", Generation: 1},
+			),
+		}
+		counts := countNodes(reports, map[policyID]int64{gnp: 2, np: 1})
+		Expect(counts).To(Equal(map[policyID]nodeCounts{
+			gnp: {programmed: 1, pending: 1},
+			np:  {programmed: 1},
+		}))
+	})
+
+	expectProgrammed := func(counts nodeCounts, validationErr error, expStatus metav1.ConditionStatus, expReason string) {
+		status := calculateStatus(api.PolicyStatus{}, 3, validationErr, counts)
+		Expect(status.ObservedGeneration).To(Equal(int64(3)))
+		Expect(status.ProgrammedNodes).To(Equal(counts.programmed))
+		Expect(status.PendingNodes).To(Equal(counts.pending))
+		c := meta.FindStatusCondition(status.Conditions, api.PolicyConditionProgrammed)
+		Expect(c).NotTo(BeNil())
+		Expect(c.Status).To(Equal(expStatus))
+		Expect(c.Reason).To(Equal(expReason))
+		Expect(c.ObservedGeneration).To(Equal(int64(3)))
+		v := meta.FindStatusCondition(status.Conditions, api.PolicyConditionValid)
+		Expect(v).NotTo(BeNil())
+		Expect(v.Status == metav1.ConditionTrue).To(Equal(validationErr == nil))
+	}
+
+	It("should report programmed policies", func() {
+		expectProgrammed(nodeCounts{programmed: 2}, nil, metav1.ConditionTrue, api.PolicyReasonProgrammed)
+	})
+	It("should report pending policies", func() {
+		expectProgrammed(nodeCounts{programmed: 2, pending: 1}, nil, metav1.ConditionFalse, api.PolicyReasonPending)
+	})
+	It("should report policies that apply to no nodes", func() {
+		expectProgrammed(nodeCounts{}, nil, metav1.ConditionFalse, api.PolicyReasonNotApplied)
+	})
+	It("should report invalid policies", func() {
+		expectProgrammed(nodeCounts{programmed: 1}, errors.New("bad selector"), metav1.ConditionFalse, api.PolicyReasonInvalid)
+	})
+
+	It("should keep the transition time of unchanged conditions", func() {
+		first := calculateStatus(api.PolicyStatus{}, 1, nil, nodeCounts{programmed: 1})
+		old := metav1.NewTime(metav1.Now().Add(-3600e9))
+		for i := range first.Conditions {
+			first.Conditions[i].LastTransitionTime = old
+		}
+		second := calculateStatus(first, 1, nil, nodeCounts{programmed: 1})
+		Expect(second).To(Equal(first))
+	})
+})
@@ -24,8 +24,10 @@ import (
 
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
 type GlobalNetworkPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	Spec              v3.GlobalNetworkPolicySpec `json:"spec,omitempty"`
+	Status            v3.PolicyStatus            `json:"status,omitempty"`
 }
@@ -23,8 +23,10 @@ import (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
 type NetworkPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	Spec              v3.NetworkPolicySpec `json:"spec,omitempty"`
+	Status            v3.PolicyStatus      `json:"status,omitempty"`
 }
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindNodePolicyReport     = "NodePolicyReport"
+	KindNodePolicyReportList = "NodePolicyReportList"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodePolicyReport is written by Felix to report which generation of each policy it has
+// programmed.  There is one report per node, named after the node.
+type NodePolicyReport struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the NodePolicyReport.
+	Spec NodePolicyReportSpec `json:"spec,omitempty"`
+}
+
+// NodePolicyReportSpec contains the specification for a NodePolicyReport resource.
+type NodePolicyReportSpec struct {
+	// Node is the name of the node that wrote the report.
+	Node string `json:"node"`
+
+	// Policies are the NetworkPolicies and GlobalNetworkPolicies that apply to endpoints on the
+	// node, with the generation of each that the node has programmed.
+	// +optional
+	Policies []PolicyGeneration `json:"policies,omitempty"`
+}
+
+// PolicyGeneration identifies a generation of a policy.
+type PolicyGeneration struct {
+	// Kind is either NetworkPolicy or GlobalNetworkPolicy.
+	Kind string `json:"kind"`
+	// +optional
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	Generation int64  `json:"generation"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodePolicyReportList contains a list of NodePolicyReport resources.
+type NodePolicyReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []NodePolicyReport `json:"items"`
+}
+
+// NewNodePolicyReport creates a new (zeroed) NodePolicyReport struct with the TypeMetadata initialised to the current
+// version.
+func NewNodePolicyReport() *NodePolicyReport {
+	return &NodePolicyReport{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindNodePolicyReport,
+			APIVersion: apiv3.GroupVersionCurrent,
+		},
+	}
+}
+
+// NewNodePolicyReportList creates a new (zeroed) NodePolicyReportList struct with the TypeMetadata initialised to the current
+// version.
+func NewNodePolicyReportList() *NodePolicyReportList {
+	return &NodePolicyReportList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindNodePolicyReportList,
+			APIVersion: apiv3.GroupVersionCurrent,
+		},
+	}
+}
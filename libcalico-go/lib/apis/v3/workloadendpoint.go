@@ -81,6 +81,15 @@ type WorkloadEndpointSpec struct {
 	// AllowSpoofedSourcePrefixes is a list of CIDRs that the endpoint should be able to send traffic from,
 	// bypassing the RPF check.
 	AllowSpoofedSourcePrefixes []string `json:"allowSpoofedSourcePrefixes,omitempty" validate:"omitempty,dive,cidr"`
+	// QoSControls contains the traffic shaping settings of the endpoint.
+	QoSControls *QoSControls `json:"qosControls,omitempty"`
+}
+
+// QoSControls contains the traffic shaping settings of a WorkloadEndpoint.
+type QoSControls struct {
+	// EgressBandwidth is the maximum rate, in bits per second, at which the endpoint may send
+	// traffic.  Zero means no limit.
+	EgressBandwidth int64 `json:"egressBandwidth,omitempty" validate:"gte=0"`
 }
 
 // WorkloadEndpointPort represents one endpoint's named or mapped port
@@ -40,6 +40,10 @@ const (
 	// on older Pods.
 	AnnotationContainerID = "cni.projectcalico.org/containerID"
 
+	// AnnotationEgressBandwidth is the standard Kubernetes annotation limiting the egress bandwidth
+	// of a pod, as used by the CNI bandwidth plugin.
+	AnnotationEgressBandwidth = "kubernetes.io/egress-bandwidth"
+
 	// NameLabel is a label that can be used to match a serviceaccount or namespace
 	// name exactly.
 	NameLabel = "projectcalico.org/name"
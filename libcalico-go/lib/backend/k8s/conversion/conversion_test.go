@@ -593,6 +593,35 @@ var _ = Describe("Test Pod conversion", func() {
 		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.AllowSpoofedSourcePrefixes).To(ConsistOf([]string{"1.1.1.0/24", "8.8.8.8/32"}))
 	})
 
+	It("should parse the egress bandwidth annotation", func() {
+		pod := kapiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podA",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"cni.projectcalico.org/podIP":    "192.168.0.1",
+					"kubernetes.io/egress-bandwidth": "10M",
+				},
+				ResourceVersion: "1234",
+			},
+			Spec: kapiv1.PodSpec{
+				NodeName:   "nodeA",
+				Containers: []kapiv1.Container{},
+			},
+		}
+
+		wep, err := podToWorkloadEndpoint(c, &pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.QoSControls).To(Equal(&libapiv3.QoSControls{
+			EgressBandwidth: 10000000,
+		}))
+
+		pod.Annotations["kubernetes.io/egress-bandwidth"] = "lots"
+		wep, err = podToWorkloadEndpoint(c, &pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.QoSControls).To(BeNil())
+	})
+
 	It("should error on empty string in the source spoofing disabling annotation", func() {
 		pod := kapiv1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
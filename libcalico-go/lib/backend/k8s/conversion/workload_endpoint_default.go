@@ -25,6 +25,7 @@ import (
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 	log "github.com/sirupsen/logrus"
 	kapiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
@@ -185,6 +186,8 @@ func (wc defaultWorkloadEndpointConverter) podToDefaultWorkloadEndpoint(pod *kap
 		return nil, err
 	}
 
+	qosControls := handleQoSControlsAnnotations(pod.Annotations)
+
 	// Map any named ports through.
 	var endpointPorts []libapiv3.WorkloadEndpointPort
 	endpointPorts = appendEndpointPorts(endpointPorts, pod, pod.Spec.Containers)
@@ -217,6 +220,7 @@ func (wc defaultWorkloadEndpointConverter) podToDefaultWorkloadEndpoint(pod *kap
 		IPNATs:                     floatingIPs,
 		ServiceAccountName:         pod.Spec.ServiceAccountName,
 		AllowSpoofedSourcePrefixes: sourcePrefixes,
+		QoSControls:                qosControls,
 	}
 
 	if v, ok := pod.Annotations["k8s.v1.cni.cncf.io/network-status"]; ok {
@@ -296,3 +300,19 @@ func HandleSourceIPSpoofingAnnotation(annot map[string]string) ([]string, error)
 	}
 	return sourcePrefixes, nil
 }
+
+// handleQoSControlsAnnotations extracts the traffic shaping settings from the pod annotations.
+// Invalid values are logged and ignored, rather than failing the conversion, so that a typo in
+// an annotation does not cut the pod off from the network.
+func handleQoSControlsAnnotations(annot map[string]string) *libapiv3.QoSControls {
+	annotation, ok := annot[AnnotationEgressBandwidth]
+	if !ok || annotation == "" {
+		return nil
+	}
+	q, err := resource.ParseQuantity(annotation)
+	if err != nil || q.Sign() <= 0 {
+		log.WithError(err).WithField("value", annotation).Warnf("Ignoring invalid %s annotation", AnnotationEgressBandwidth)
+		return nil
+	}
+	return &libapiv3.QoSControls{EgressBandwidth: q.Value()}
+}
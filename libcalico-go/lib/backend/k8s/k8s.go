@@ -147,6 +147,18 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		apiv3.KindNetworkPolicy,
 		resources.NewNetworkPolicyClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindStagedGlobalNetworkPolicy,
+		resources.NewStagedGlobalNetworkPolicyClient(cs, crdClientV1),
+	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindStagedNetworkPolicy,
+		resources.NewStagedNetworkPolicyClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -231,6 +243,12 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		apiv3.KindCalicoNodeStatus,
 		resources.NewCalicoNodeStatusClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		libapiv3.KindNodePolicyReport,
+		resources.NewNodePolicyReportClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -448,6 +466,8 @@ func (c *KubeClient) Clean() error {
 		apiv3.KindFelixConfiguration,
 		apiv3.KindGlobalNetworkPolicy,
 		apiv3.KindNetworkPolicy,
+		apiv3.KindStagedGlobalNetworkPolicy,
+		apiv3.KindStagedNetworkPolicy,
 		apiv3.KindTier,
 		apiv3.KindGlobalNetworkSet,
 		apiv3.KindNetworkSet,
@@ -457,6 +477,7 @@ func (c *KubeClient) Clean() error {
 		apiv3.KindKubeControllersConfiguration,
 		libapiv3.KindIPAMConfig,
 		libapiv3.KindBlockAffinity,
+		libapiv3.KindNodePolicyReport,
 		apiv3.KindBGPFilter,
 	}
 	ctx := context.Background()
@@ -572,6 +593,23 @@ func (c *KubeClient) Update(ctx context.Context, d *model.KVPair) (*model.KVPair
 	return client.Update(ctx, d)
 }
 
+// UpdateStatus updates the status of an existing entry in the datastore, for resources whose CRD
+// has a status subresource.  This errors if the entry does not exist.
+func (c *KubeClient) UpdateStatus(ctx context.Context, d *model.KVPair) (*model.KVPair, error) {
+	log.Debugf("Performing 'UpdateStatus' for %+v", d)
+	client, ok := c.getResourceClientFromKey(d.Key).(interface {
+		UpdateStatus(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error)
+	})
+	if !ok {
+		log.Debug("Attempt to 'UpdateStatus' using kubernetes backend is not supported.")
+		return nil, cerrors.ErrorOperationNotSupported{
+			Identifier: d.Key,
+			Operation:  "UpdateStatus",
+		}
+	}
+	return client.UpdateStatus(ctx, d)
+}
+
 // Set an existing entry in the datastore.  This ignores whether an entry already
 // exists.  This is not exposed in the main client - but we keep here for the backend
 // API.
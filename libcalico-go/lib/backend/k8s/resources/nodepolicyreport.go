@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"reflect"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+)
+
+const (
+	NodePolicyReportResourceName = "NodePolicyReports"
+	NodePolicyReportCRDName      = "nodepolicyreports.crd.projectcalico.org"
+)
+
+func NewNodePolicyReportClient(c kubernetes.Interface, r rest.Interface) K8sResourceClient {
+	return &customK8sResourceClient{
+		clientSet:       c,
+		restClient:      r,
+		name:            NodePolicyReportCRDName,
+		resource:        NodePolicyReportResourceName,
+		description:     "Calico Node Policy Reports",
+		k8sResourceType: reflect.TypeOf(libapiv3.NodePolicyReport{}),
+		k8sResourceTypeMeta: metav1.TypeMeta{
+			Kind:       libapiv3.KindNodePolicyReport,
+			APIVersion: apiv3.GroupVersionCurrent,
+		},
+		k8sListType:  reflect.TypeOf(libapiv3.NodePolicyReportList{}),
+		resourceKind: libapiv3.KindNodePolicyReport,
+	}
+}
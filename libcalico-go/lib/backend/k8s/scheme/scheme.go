@@ -71,6 +71,8 @@ func AddCalicoResourcesToScheme() {
 					&libapiv3.IPAMHandleList{},
 					&libapiv3.IPAMConfig{},
 					&libapiv3.IPAMConfigList{},
+					&libapiv3.NodePolicyReport{},
+					&libapiv3.NodePolicyReportList{},
 					&apiv3.KubeControllersConfiguration{},
 					&apiv3.KubeControllersConfigurationList{},
 					&apiv3.CalicoNodeStatus{},
@@ -26,6 +26,16 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/errors"
 )
 
+// PolicyNamePrefixStaged is prepended to the names of staged policies when they are
+// converted to the v1 model so that they don't clash with enforced policies of the same name.
+const PolicyNamePrefixStaged = "staged:"
+
+// PolicyIsStaged returns true if the given v1 policy name belongs to a staged policy.
+// Namespaced policies have the namespace in front of the prefix.
+func PolicyIsStaged(name string) bool {
+	return strings.HasPrefix(name, PolicyNamePrefixStaged) || strings.Contains(name, "/"+PolicyNamePrefixStaged)
+}
+
 var (
 	matchPolicy = regexp.MustCompile("^/?calico/v1/policy/tier/([^/]+)/policy/([^/]+)$")
 	typePolicy  = reflect.TypeOf(Policy{})
@@ -114,6 +124,14 @@ type Policy struct {
 	ApplyOnForward   bool                          `json:"apply_on_forward,omitempty"`
 	Types            []string                      `json:"types,omitempty"`
 	PerformanceHints []apiv3.PolicyPerformanceHint `json:"performance_hints,omitempty" validate:"omitempty,unique,dive,oneof=AssumeNeededOnEveryNode"`
+	// Staged is set for policies that come from a staged policy resource.  They are
+	// evaluated but never affect traffic.
+	Staged bool `json:"staged,omitempty"`
+	// Schedule, if set, limits the policy to the time windows that it describes.
+	Schedule *apiv3.PolicySchedule `json:"schedule,omitempty"`
+	// Generation is the metadata.generation of the policy resource.  Felix reports it back
+	// once it has programmed the policy.
+	Generation int64 `json:"generation,omitempty"`
 }
 
 func (p Policy) String() string {
@@ -139,5 +157,11 @@ func (p Policy) String() string {
 	if len(p.PerformanceHints) > 0 {
 		parts = append(parts, fmt.Sprintf("performance_hints:%v", p.PerformanceHints))
 	}
+	if p.Staged {
+		parts = append(parts, "staged:true")
+	}
+	if p.Schedule != nil {
+		parts = append(parts, fmt.Sprintf("schedule:%v", *p.Schedule))
+	}
 	return strings.Join(parts, ",")
 }
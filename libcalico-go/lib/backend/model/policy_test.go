@@ -38,4 +38,11 @@ var _ = Describe("Policy functions", func() {
 		}
 		Expect(p.String()).To(Equal(`order:10.5,selector:"apples=='oranges'",inbound:Deny,outbound:Allow,untracked:false,pre_dnat:true,apply_on_forward:true,types:Ingress;Egress,performance_hints:[AssumeNeededOnEveryNode]`))
 	})
+
+	It("should recognise staged policy names", func() {
+		Expect(model.PolicyIsStaged("staged:pol")).To(BeTrue())
+		Expect(model.PolicyIsStaged("ns/staged:pol")).To(BeTrue())
+		Expect(model.PolicyIsStaged("default.pol")).To(BeFalse())
+		Expect(model.PolicyIsStaged("ns/pol-staged:x")).To(BeFalse())
+	})
 })
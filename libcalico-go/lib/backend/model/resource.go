@@ -126,6 +126,16 @@ func init() {
 		"networkpolicies",
 		reflect.TypeOf(apiv3.NetworkPolicy{}),
 	)
+	registerResourceInfo(
+		apiv3.KindStagedGlobalNetworkPolicy,
+		"stagedglobalnetworkpolicies",
+		reflect.TypeOf(apiv3.StagedGlobalNetworkPolicy{}),
+	)
+	registerResourceInfo(
+		apiv3.KindStagedNetworkPolicy,
+		"stagednetworkpolicies",
+		reflect.TypeOf(apiv3.StagedNetworkPolicy{}),
+	)
 	registerResourceInfo(
 		KindKubernetesNetworkPolicy,
 		"kubernetesnetworkpolicies",
@@ -185,6 +195,11 @@ func init() {
 		"blockaffinities",
 		reflect.TypeOf(libapiv3.BlockAffinity{}),
 	)
+	registerResourceInfo(
+		libapiv3.KindNodePolicyReport,
+		"nodepolicyreports",
+		reflect.TypeOf(libapiv3.NodePolicyReport{}),
+	)
 	registerResourceInfo(
 		apiv3.KindBGPFilter,
 		"BGPFilters",
@@ -41,6 +41,11 @@ type Rule struct {
 	NotICMPType *int `json:"!icmp_type,omitempty" validate:"omitempty,gte=0,lt=255"`
 	NotICMPCode *int `json:"!icmp_code,omitempty" validate:"omitempty,gte=0,lte=255"`
 
+	// ICMPTypes and NotICMPTypes hold lists of types (with optional codes) to match, with any
+	// named groups from the API already expanded for the rule's protocol.
+	ICMPTypes    []apiv3.ICMPTypeCode `json:"icmp_types,omitempty" validate:"omitempty,dive"`
+	NotICMPTypes []apiv3.ICMPTypeCode `json:"!icmp_types,omitempty" validate:"omitempty,dive"`
+
 	SrcTag              string             `json:"src_tag,omitempty" validate:"omitempty,tag"`
 	SrcNet              *net.IPNet         `json:"src_net,omitempty" validate:"omitempty"`
 	SrcNets             []*net.IPNet       `json:"src_nets,omitempty" validate:"omitempty"`
@@ -55,6 +60,7 @@ type Rule struct {
 	DstPorts            []numorstring.Port `json:"dst_ports,omitempty" validate:"omitempty,dive"`
 	DstService          string             `json:"dst_service,omitempty" validate:"omitempty"`
 	DstServiceNamespace string             `json:"dst_service_ns,omitempty" validate:"omitempty"`
+	DstDomains          []string           `json:"dst_domains,omitempty" validate:"omitempty"`
 
 	NotSrcTag      string             `json:"!src_tag,omitempty" validate:"omitempty,tag"`
 	NotSrcNet      *net.IPNet         `json:"!src_net,omitempty" validate:"omitempty"`
@@ -86,12 +92,18 @@ type Rule struct {
 
 	LogPrefix string `json:"log_prefix,omitempty" validate:"omitempty"`
 
+	// Audit requests a structured audit record for each new connection that matches the rule.
+	Audit bool `json:"audit,omitempty"`
+
 	Metadata *RuleMetadata `json:"metadata,omitempty" validate:"omitempty"`
 }
 
 type HTTPMatch struct {
-	Methods []string         `json:"methods,omitempty" validate:"omitempty"`
-	Paths   []apiv3.HTTPPath `json:"paths,omitempty" validate:"omitempty"`
+	Methods []string                `json:"methods,omitempty" validate:"omitempty"`
+	Paths   []apiv3.HTTPPath        `json:"paths,omitempty" validate:"omitempty"`
+	Headers []apiv3.HTTPHeaderMatch `json:"headers,omitempty" validate:"omitempty"`
+	Hosts   []string                `json:"hosts,omitempty" validate:"omitempty"`
+	GRPC    *apiv3.GRPCMatch        `json:"grpc,omitempty" validate:"omitempty"`
 }
 
 type RuleMetadata struct {
@@ -127,6 +139,17 @@ func (r Rule) AllNotDstNets() []*net.IPNet {
 	return combineNets(r.NotDstNet, r.NotDstNets)
 }
 
+func formatICMPTypes(tcs []apiv3.ICMPTypeCode) string {
+	parts := make([]string, len(tcs))
+	for i, tc := range tcs {
+		parts[i] = strconv.Itoa(tc.Type)
+		if tc.Code != nil {
+			parts[i] += "/" + strconv.Itoa(*tc.Code)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
 func joinNets(nets []*net.IPNet) string {
 	parts := make([]string, len(nets))
 	for i, n := range nets {
@@ -164,6 +187,12 @@ func (r Rule) String() string {
 	if r.NotICMPCode != nil {
 		parts = append(parts, "!code", strconv.Itoa(*r.NotICMPCode))
 	}
+	if len(r.ICMPTypes) > 0 {
+		parts = append(parts, "types", formatICMPTypes(r.ICMPTypes))
+	}
+	if len(r.NotICMPTypes) > 0 {
+		parts = append(parts, "!types", formatICMPTypes(r.NotICMPTypes))
+	}
 
 	{
 		// Source attributes.  New block ensures that fromParts goes out-of-scope before
@@ -230,6 +259,9 @@ func (r Rule) String() string {
 		if len(dstNets) != 0 {
 			toParts = append(toParts, "cidr", joinNets(dstNets))
 		}
+		if len(r.DstDomains) != 0 {
+			toParts = append(toParts, "domains", strings.Join(r.DstDomains, ","))
+		}
 		if len(r.NotDstPorts) > 0 {
 			notDstPorts := make([]string, len(r.NotDstPorts))
 			for ii, port := range r.NotDstPorts {
@@ -256,6 +288,15 @@ func (r Rule) String() string {
 			if len(r.HTTPMatch.Paths) > 0 {
 				toParts = append(toParts, "httpPaths", fmt.Sprintf("%+v", r.HTTPMatch.Paths))
 			}
+			if len(r.HTTPMatch.Headers) > 0 {
+				toParts = append(toParts, "httpHeaders", fmt.Sprintf("%+v", r.HTTPMatch.Headers))
+			}
+			if len(r.HTTPMatch.Hosts) > 0 {
+				toParts = append(toParts, "httpHosts", fmt.Sprintf("%+v", r.HTTPMatch.Hosts))
+			}
+			if r.HTTPMatch.GRPC != nil {
+				toParts = append(toParts, "grpc", fmt.Sprintf("%+v", *r.HTTPMatch.GRPC))
+			}
 		}
 
 		if len(toParts) > 0 {
@@ -49,6 +49,9 @@ var ports2 = []numorstring.Port{
 var _, cidr, _ = net.ParseCIDR("10.0.0.0/16")
 var httpMethod = &model.HTTPMatch{Methods: []string{"GET", "PUT"}}
 var httpPath = &model.HTTPMatch{Paths: []apiv3.HTTPPath{{Exact: "/foo"}, {Prefix: "/bar"}}}
+var httpHeader = &model.HTTPMatch{Headers: []apiv3.HTTPHeaderMatch{{Name: "x-tenant", Exact: "a"}}}
+var httpHost = &model.HTTPMatch{Hosts: []string{"example.com"}}
+var grpcMatch = &model.HTTPMatch{GRPC: &apiv3.GRPCMatch{Service: "helloworld.Greeter", Methods: []string{"SayHello"}}}
 
 var ruleStringTests = []ruleTest{
 	// Empty
@@ -74,6 +77,10 @@ var ruleStringTests = []ruleTest{
 		"Deny ICMP !type 10"},
 	{model.Rule{Protocol: &icmpProto, NotICMPType: &icmpType, NotICMPCode: &icmpCode},
 		"Allow ICMP !type 10 !code 6"},
+	{model.Rule{Protocol: &icmpProto, ICMPTypes: []apiv3.ICMPTypeCode{{Type: 8}, {Type: 3, Code: &icmpCode}}},
+		"Allow ICMP types 8,3/6"},
+	{model.Rule{Protocol: &icmpProto, NotICMPTypes: []apiv3.ICMPTypeCode{{Type: 0}, {Type: 8}}},
+		"Allow ICMP !types 0,8"},
 
 	// From rules.
 	{model.Rule{SrcPorts: ports}, "Allow from ports 1234,10:20"},
@@ -98,6 +105,9 @@ var ruleStringTests = []ruleTest{
 	// Application layer rules.
 	{model.Rule{HTTPMatch: httpMethod}, "Allow to httpMethods [GET PUT]"},
 	{model.Rule{HTTPMatch: httpPath}, "Allow to httpPaths [{Exact:/foo Prefix:} {Exact: Prefix:/bar}]"},
+	{model.Rule{HTTPMatch: httpHeader}, "Allow to httpHeaders [{Name:x-tenant Exact:a Prefix: Regex: Present:false}]"},
+	{model.Rule{HTTPMatch: httpHost}, "Allow to httpHosts [example.com]"},
+	{model.Rule{HTTPMatch: grpcMatch}, "Allow to grpc {Service:helloworld.Greeter Methods:[SayHello]}"},
 
 	// Complex rule.
 	{model.Rule{Protocol: &tcpProto,
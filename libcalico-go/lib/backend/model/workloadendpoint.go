@@ -163,6 +163,12 @@ type WorkloadEndpoint struct {
 	GenerateName               string            `json:"generate_name,omitempty"`
 	AllowSpoofedSourcePrefixes []net.IPNet       `json:"allow_spoofed_source_ips,omitempty"`
 	Annotations                map[string]string `json:"annotations,omitempty"`
+	QoSControls                *QoSControls      `json:"qos_controls,omitempty"`
+}
+
+type QoSControls struct {
+	// Egress bandwidth limit in bits per second, 0 means no limit.
+	EgressBandwidth int64 `json:"egress_bandwidth,omitempty"`
 }
 
 type EndpointPort struct {
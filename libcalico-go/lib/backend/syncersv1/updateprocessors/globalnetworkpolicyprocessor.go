@@ -79,6 +79,8 @@ func convertGlobalNetworkPolicyV3ToV1Value(val interface{}) (interface{}, error)
 		PreDNAT:          spec.PreDNAT,
 		ApplyOnForward:   spec.ApplyOnForward,
 		PerformanceHints: v3res.Spec.PerformanceHints,
+		Schedule:         v3res.Spec.Schedule,
+		Generation:       v3res.Generation,
 	}
 
 	return v1value, nil
@@ -63,8 +63,9 @@ func convertGlobalNetworkSetV3ToV1(kvp *model.KVPair) (*model.KVPair, error) {
 	}
 
 	v1value := &model.NetworkSet{
-		Labels: v3res.GetLabels(),
-		Nets:   addrs,
+		Labels:  v3res.GetLabels(),
+		Nets:    addrs,
+		Domains: v3res.Spec.Domains,
 	}
 
 	return &model.KVPair{
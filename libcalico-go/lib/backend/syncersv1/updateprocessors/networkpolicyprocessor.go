@@ -76,6 +76,8 @@ func convertNetworkPolicyV3ToV1Value(val interface{}) (interface{}, error) {
 		Types:            policyTypesAPIV3ToBackend(spec.Types),
 		ApplyOnForward:   false,
 		PerformanceHints: v3res.Spec.PerformanceHints,
+		Schedule:         v3res.Spec.Schedule,
+		Generation:       v3res.Generation,
 	}
 
 	return v1value, nil
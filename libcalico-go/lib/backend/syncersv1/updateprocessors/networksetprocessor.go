@@ -69,8 +69,9 @@ func convertNetworkSetV2ToV1Value(val interface{}) (interface{}, error) {
 	// This is a wonky compared to Pods where the profile is included in the pod->WEP conversion and is therefore
 	// conceptually limited to k8s, but then namespaces are themselves a k8s only concept.
 	v1value := &model.NetworkSet{
-		Nets:   addrs,
-		Labels: labelsWithCalicoNamespace,
+		Nets:    addrs,
+		Domains: v3res.Spec.Domains,
+		Labels:  labelsWithCalicoNamespace,
 		ProfileIDs: []string{
 			conversion.NamespaceProfileNamePrefix + v3res.Namespace,
 		},
@@ -97,6 +97,31 @@ var _ = Describe("Test the NetworkSet update processor", func() {
 			Revision: "abcde",
 		}))
 
+		By("adding domains to the existing NetworkSet")
+		res.Spec.Domains = []string{"example.com", "*.example.org"}
+
+		kvps, err = up.Process(&model.KVPair{
+			Key:      v3NetworkSetKey1,
+			Value:    res,
+			Revision: "abcde",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(HaveLen(1))
+		Expect(kvps[0]).To(Equal(&model.KVPair{
+			Key: v1NetworkSetKey1,
+			Value: &model.NetworkSet{
+				Nets:    []net.IPNet{*cidr1IPNet, *cidr2IPNet},
+				Domains: []string{"example.com", "*.example.org"},
+				Labels: map[string]string{
+					apiv3.LabelNamespace: ns1,
+				},
+				ProfileIDs: []string{
+					"kns." + ns1,
+				},
+			},
+			Revision: "abcde",
+		}))
+
 		By("deleting the NetworkSet")
 		kvps, err = up.Process(&model.KVPair{
 			Key: v3NetworkSetKey1,
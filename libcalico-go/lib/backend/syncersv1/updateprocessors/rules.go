@@ -16,6 +16,8 @@ package updateprocessors
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
@@ -128,14 +130,17 @@ func getEndpointSelector(namespaceSelector, endpointSelector, serviceAccountSele
 // RuleAPIToBackend converts an API Rule structure to a Backend Rule structure.
 func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 	var icmpCode, icmpType, notICMPCode, notICMPType *int
+	var icmpTypes, notICMPTypes []apiv3.ICMPTypeCode
 	if ar.ICMP != nil {
 		icmpCode = ar.ICMP.Code
 		icmpType = ar.ICMP.Type
+		icmpTypes = expandICMPTypes(ar.ICMP, ar.Protocol)
 	}
 
 	if ar.NotICMP != nil {
 		notICMPCode = ar.NotICMP.Code
 		notICMPType = ar.NotICMP.Type
+		notICMPTypes = expandICMPTypes(ar.NotICMP, ar.Protocol)
 	}
 
 	sourceSelector := GetEntityRuleSelector(&ar.Source, ns, "source")
@@ -171,6 +176,11 @@ func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 		NotICMPCode: notICMPCode,
 		NotICMPType: notICMPType,
 
+		ICMPTypes:    icmpTypes,
+		NotICMPTypes: notICMPTypes,
+
+		Audit: ar.Audit,
+
 		SrcNets:             ConvertStringsToNets(ar.Source.Nets),
 		SrcSelector:         sourceSelector,
 		SrcPorts:            ar.Source.Ports,
@@ -181,6 +191,7 @@ func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 		DstPorts:            ar.Destination.Ports,
 		DstService:          dstService,
 		DstServiceNamespace: dstServiceNS,
+		DstDomains:          ar.Destination.Domains,
 
 		NotSrcNets:     ConvertStringsToNets(ar.Source.NotNets),
 		NotSrcSelector: ar.Source.NotSelector,
@@ -202,7 +213,13 @@ func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 		OriginalDstServiceAccountSelector: dstServiceAcctMatch.Selector,
 	}
 	if ar.HTTP != nil {
-		r.HTTPMatch = &model.HTTPMatch{Methods: ar.HTTP.Methods, Paths: ar.HTTP.Paths}
+		r.HTTPMatch = &model.HTTPMatch{
+			Methods: ar.HTTP.Methods,
+			Paths:   ar.HTTP.Paths,
+			Headers: ar.HTTP.Headers,
+			Hosts:   ar.HTTP.Hosts,
+			GRPC:    ar.HTTP.GRPC,
+		}
 	}
 	if ar.Metadata != nil {
 		if ar.Metadata.Annotations != nil {
@@ -215,6 +232,73 @@ func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 	return r
 }
 
+// expandICMPTypes returns the explicit types and codes from the given ICMP fields, along with those
+// covered by its named groups.  Groups map to different types for ICMP and ICMPv6, so they are
+// expanded according to the rule's protocol.  The result is sorted and free of duplicates.
+func expandICMPTypes(f *apiv3.ICMPFields, protocol *numorstring.Protocol) []apiv3.ICMPTypeCode {
+	if len(f.Types) == 0 && len(f.Groups) == 0 {
+		return nil
+	}
+	v6 := isICMPv6(protocol)
+	tcs := append([]apiv3.ICMPTypeCode(nil), f.Types...)
+	for _, g := range f.Groups {
+		tcs = append(tcs, icmpGroupTypes(g, v6)...)
+	}
+
+	sort.Slice(tcs, func(i, j int) bool {
+		if tcs[i].Type != tcs[j].Type {
+			return tcs[i].Type < tcs[j].Type
+		}
+		if tcs[i].Code == nil || tcs[j].Code == nil {
+			return tcs[i].Code == nil && tcs[j].Code != nil
+		}
+		return *tcs[i].Code < *tcs[j].Code
+	})
+	out := tcs[:0]
+	for _, tc := range tcs {
+		if len(out) > 0 && reflect.DeepEqual(out[len(out)-1], tc) {
+			continue
+		}
+		out = append(out, tc)
+	}
+	return out
+}
+
+// icmpGroupTypes returns the ICMP (or ICMPv6) types and codes covered by the named group.
+func icmpGroupTypes(g apiv3.ICMPGroup, v6 bool) []apiv3.ICMPTypeCode {
+	fragNeeded := 4
+	switch g {
+	case apiv3.ICMPGroupEchoFamily:
+		if v6 {
+			return []apiv3.ICMPTypeCode{{Type: 128}, {Type: 129}}
+		}
+		return []apiv3.ICMPTypeCode{{Type: 0}, {Type: 8}}
+	case apiv3.ICMPGroupNeighborDiscovery:
+		if v6 {
+			return []apiv3.ICMPTypeCode{{Type: 133}, {Type: 134}, {Type: 135}, {Type: 136}, {Type: 137}}
+		}
+	case apiv3.ICMPGroupPMTU:
+		if v6 {
+			// Packet too big.
+			return []apiv3.ICMPTypeCode{{Type: 2}}
+		}
+		// Destination unreachable, fragmentation needed.
+		return []apiv3.ICMPTypeCode{{Type: 3, Code: &fragNeeded}}
+	}
+	log.WithField("group", g).Warn("ICMP group doesn't apply to protocol, ignoring")
+	return nil
+}
+
+func isICMPv6(p *numorstring.Protocol) bool {
+	if p == nil {
+		return false
+	}
+	if p.Type == numorstring.NumOrStringNum {
+		return p.NumVal == 58
+	}
+	return strings.EqualFold(p.StrVal, numorstring.ProtocolICMPv6)
+}
+
 // parseServiceAccounts takes a v3 service account match and returns the appropriate v1 representation
 // by converting the list of service account names into a set of service account with
 // key: "projectcalico.org/serviceaccount" in { 'sa-1', 'sa-2' } AND
@@ -71,6 +71,9 @@ var _ = Describe("Test the Rules Conversion Functions", func() {
 			HTTP: &apiv3.HTTPMatch{
 				Methods: []string{"GET", "PUT"},
 				Paths:   []apiv3.HTTPPath{{Exact: "/bar"}, {Prefix: "/foo1"}},
+				Headers: []apiv3.HTTPHeaderMatch{{Name: "x-tenant", Exact: "a"}},
+				Hosts:   []string{"*.example.com"},
+				GRPC:    &apiv3.GRPCMatch{Service: "helloworld.Greeter"},
 			},
 			Metadata: &apiv3.RuleMetadata{
 				Annotations: map[string]string{"fizz": "buzz"}},
@@ -120,6 +123,9 @@ var _ = Describe("Test the Rules Conversion Functions", func() {
 
 		Expect(rulev1.HTTPMatch.Methods).To(Equal([]string{"GET", "PUT"}))
 		Expect(rulev1.HTTPMatch.Paths).To(Equal([]apiv3.HTTPPath{{Exact: "/bar"}, {Prefix: "/foo1"}}))
+		Expect(rulev1.HTTPMatch.Headers).To(Equal([]apiv3.HTTPHeaderMatch{{Name: "x-tenant", Exact: "a"}}))
+		Expect(rulev1.HTTPMatch.Hosts).To(Equal([]string{"*.example.com"}))
+		Expect(rulev1.HTTPMatch.GRPC).To(Equal(&apiv3.GRPCMatch{Service: "helloworld.Greeter"}))
 
 		Expect(rulev1.Metadata.Annotations).To(Equal(map[string]string{"fizz": "buzz"}))
 
@@ -265,6 +271,39 @@ var _ = Describe("Test the Rules Conversion Functions", func() {
 		Expect(rulev1.OriginalNotDstSelector).To(Equal("has(label1)"))
 	})
 
+	It("should expand ICMP groups according to the protocol", func() {
+		code := 1
+		icmp := numorstring.ProtocolFromString("ICMP")
+		r := apiv3.Rule{
+			Action:   apiv3.Allow,
+			Protocol: &icmp,
+			ICMP: &apiv3.ICMPFields{
+				Types:  []apiv3.ICMPTypeCode{{Type: 8}, {Type: 3, Code: &code}},
+				Groups: []apiv3.ICMPGroup{apiv3.ICMPGroupEchoFamily, apiv3.ICMPGroupPMTU},
+			},
+		}
+		rulev1 := updateprocessors.RuleAPIV3ToBackend(r, "")
+		fragNeeded := 4
+		Expect(rulev1.ICMPTypes).To(Equal([]apiv3.ICMPTypeCode{
+			{Type: 0}, {Type: 3, Code: &code}, {Type: 3, Code: &fragNeeded}, {Type: 8},
+		}))
+		Expect(rulev1.NotICMPTypes).To(BeNil())
+
+		icmpv6 := numorstring.ProtocolFromString("ICMPv6")
+		r = apiv3.Rule{
+			Action:   apiv3.Allow,
+			Protocol: &icmpv6,
+			NotICMP: &apiv3.ICMPFields{
+				Groups: []apiv3.ICMPGroup{apiv3.ICMPGroupNeighborDiscovery, apiv3.ICMPGroupEchoFamily, apiv3.ICMPGroupPMTU},
+			},
+		}
+		rulev1 = updateprocessors.RuleAPIV3ToBackend(r, "")
+		Expect(rulev1.ICMPTypes).To(BeNil())
+		Expect(rulev1.NotICMPTypes).To(Equal([]apiv3.ICMPTypeCode{
+			{Type: 2}, {Type: 128}, {Type: 129}, {Type: 133}, {Type: 134}, {Type: 135}, {Type: 136}, {Type: 137},
+		}))
+	})
+
 	It("should parse a profile rule with no namespace", func() {
 		r := apiv3.Rule{
 			Action: apiv3.Allow,
@@ -2,9 +2,11 @@ package updateprocessors_test
 
 import (
 	"fmt"
+	"time"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	up "github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
@@ -154,6 +156,13 @@ var v3TestEgressRule = apiv3.Rule{
 }
 
 var testPolicyOrder101 = float64(101)
+
+var testSchedule = &apiv3.PolicySchedule{
+	TimeZone: "Europe/London",
+	Windows: []apiv3.ScheduleWindow{
+		{Start: "0 2 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+	},
+}
 var testDefaultPolicyOrder = float64(1000)
 
 // v3 model.KVPair revision
@@ -174,6 +183,8 @@ func fullGNPv1() (p model.Policy) {
 		PreDNAT:        false,
 		ApplyOnForward: true,
 		Types:          []string{"ingress", "egress"},
+		Schedule:       testSchedule,
+		Generation:     3,
 	}
 }
 
@@ -189,6 +200,8 @@ func fullGNPv3(namespace, selector string) *apiv3.GlobalNetworkPolicy {
 	fullGNP.Spec.PreDNAT = false
 	fullGNP.Spec.ApplyOnForward = true
 	fullGNP.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress}
+	fullGNP.Spec.Schedule = testSchedule
+	fullGNP.Generation = 3
 	return fullGNP
 }
 
@@ -210,6 +223,7 @@ func fullNPv1(namespace string) (p model.Policy) {
 		OutboundRules:  []model.Rule{or},
 		ApplyOnForward: false,
 		Types:          []string{"ingress", "egress"},
+		Generation:     2,
 	}
 }
 
@@ -223,6 +237,7 @@ func fullNPv3(name, namespace, selector string) *apiv3.NetworkPolicy {
 	fullNP.Spec.Egress = []apiv3.Rule{v3TestEgressRule}
 	fullNP.Spec.Selector = selector
 	fullNP.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress}
+	fullNP.Generation = 2
 
 	return fullNP
 }
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package updateprocessors
+
+import (
+	"errors"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+)
+
+// Create a new SyncerUpdateProcessor to sync StagedGlobalNetworkPolicy data in v1 format for
+// consumption by Felix.  Staged policies are sent as policies with the staged flag set and
+// with a name that can't clash with an enforced policy.  Staged policies that would delete
+// the enforced policy have nothing to evaluate so they are filtered out.
+func NewStagedGlobalNetworkPolicyUpdateProcessor() watchersyncer.SyncerUpdateProcessor {
+	return NewSimpleUpdateProcessor(apiv3.KindStagedGlobalNetworkPolicy, convertStagedGlobalNetworkPolicyV3ToV1Key, convertStagedGlobalNetworkPolicyV3ToV1Value)
+}
+
+// Create a new SyncerUpdateProcessor to sync StagedNetworkPolicy data in v1 format for
+// consumption by Felix.
+func NewStagedNetworkPolicyUpdateProcessor() watchersyncer.SyncerUpdateProcessor {
+	return NewSimpleUpdateProcessor(apiv3.KindStagedNetworkPolicy, convertStagedNetworkPolicyV3ToV1Key, convertStagedNetworkPolicyV3ToV1Value)
+}
+
+func convertStagedGlobalNetworkPolicyV3ToV1Key(v3key model.ResourceKey) (model.Key, error) {
+	if v3key.Name == "" {
+		return model.PolicyKey{}, errors.New("Missing Name field to create a v1 StagedGlobalNetworkPolicy Key")
+	}
+	tier, err := names.TierFromPolicyName(v3key.Name)
+	if err != nil {
+		return model.PolicyKey{}, err
+	}
+	return model.PolicyKey{
+		Name: model.PolicyNamePrefixStaged + v3key.Name,
+		Tier: tier,
+	}, nil
+}
+
+func convertStagedGlobalNetworkPolicyV3ToV1Value(val interface{}) (interface{}, error) {
+	staged, ok := val.(*apiv3.StagedGlobalNetworkPolicy)
+	if !ok {
+		return nil, errors.New("Value is not a valid StagedGlobalNetworkPolicy resource value")
+	}
+
+	action, enforced := apiv3.ConvertStagedGlobalPolicyToEnforced(staged)
+	if action == apiv3.StagedActionDelete {
+		return nil, nil
+	}
+
+	v1value, err := convertGlobalNetworkPolicyV3ToV1Value(enforced)
+	if err != nil {
+		return nil, err
+	}
+	v1value.(*model.Policy).Staged = true
+	return v1value, nil
+}
+
+func convertStagedNetworkPolicyV3ToV1Key(v3key model.ResourceKey) (model.Key, error) {
+	if v3key.Name == "" || v3key.Namespace == "" {
+		return model.PolicyKey{}, errors.New("Missing Name or Namespace field to create a v1 StagedNetworkPolicy Key")
+	}
+	tier, err := names.TierFromPolicyName(v3key.Name)
+	if err != nil {
+		return model.PolicyKey{}, err
+	}
+	return model.PolicyKey{
+		Name: v3key.Namespace + "/" + model.PolicyNamePrefixStaged + v3key.Name,
+		Tier: tier,
+	}, nil
+}
+
+func convertStagedNetworkPolicyV3ToV1Value(val interface{}) (interface{}, error) {
+	staged, ok := val.(*apiv3.StagedNetworkPolicy)
+	if !ok {
+		return nil, errors.New("Value is not a valid StagedNetworkPolicy resource value")
+	}
+
+	action, enforced := apiv3.ConvertStagedPolicyToEnforced(staged)
+	if action == apiv3.StagedActionDelete {
+		return nil, nil
+	}
+
+	v1value, err := convertNetworkPolicyV3ToV1Value(enforced)
+	if err != nil {
+		return nil, err
+	}
+	v1value.(*model.Policy).Staged = true
+	return v1value, nil
+}
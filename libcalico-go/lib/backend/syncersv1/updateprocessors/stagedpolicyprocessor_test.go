@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package updateprocessors_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+)
+
+var _ = Describe("Test the staged policy update processors", func() {
+	order := 101.5
+
+	Context("StagedGlobalNetworkPolicy", func() {
+		up := updateprocessors.NewStagedGlobalNetworkPolicyUpdateProcessor()
+		key := model.ResourceKey{Kind: apiv3.KindStagedGlobalNetworkPolicy, Name: "tier1.deny-all"}
+		v1Key := model.PolicyKey{Tier: "tier1", Name: "staged:tier1.deny-all"}
+
+		It("should convert a staged policy to a staged v1 policy", func() {
+			sgnp := apiv3.NewStagedGlobalNetworkPolicy()
+			sgnp.Name = "tier1.deny-all"
+			sgnp.Spec.Tier = "tier1"
+			sgnp.Spec.Order = &order
+			sgnp.Spec.Selector = "all()"
+			sgnp.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeIngress}
+			sgnp.Spec.Ingress = []apiv3.Rule{{Action: apiv3.Deny}}
+
+			kvps, err := up.Process(&model.KVPair{Key: key, Value: sgnp, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kvps).To(Equal([]*model.KVPair{{
+				Key: v1Key,
+				Value: &model.Policy{
+					Order:        &order,
+					Selector:     "all()",
+					Types:        []string{"ingress"},
+					InboundRules: []model.Rule{{Action: "deny"}},
+					Staged:       true,
+				},
+				Revision: testRev,
+			}}))
+		})
+
+		It("should treat a staged delete as a deletion", func() {
+			sgnp := apiv3.NewStagedGlobalNetworkPolicy()
+			sgnp.Name = "tier1.deny-all"
+			sgnp.Spec.StagedAction = apiv3.StagedActionDelete
+
+			kvps, err := up.Process(&model.KVPair{Key: key, Value: sgnp, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key}}))
+		})
+	})
+
+	Context("StagedNetworkPolicy", func() {
+		up := updateprocessors.NewStagedNetworkPolicyUpdateProcessor()
+		key := model.ResourceKey{Kind: apiv3.KindStagedNetworkPolicy, Name: "default.deny-all", Namespace: "ns1"}
+		v1Key := model.PolicyKey{Tier: "default", Name: "ns1/staged:default.deny-all"}
+
+		It("should convert a staged policy to a staged v1 policy", func() {
+			snp := apiv3.NewStagedNetworkPolicy()
+			snp.Name = "default.deny-all"
+			snp.Namespace = "ns1"
+			snp.Spec.Egress = []apiv3.Rule{{Action: apiv3.Deny}}
+			snp.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeEgress}
+
+			kvps, err := up.Process(&model.KVPair{Key: key, Value: snp, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kvps).To(Equal([]*model.KVPair{{
+				Key: v1Key,
+				Value: &model.Policy{
+					Namespace:     "ns1",
+					Selector:      "projectcalico.org/namespace == 'ns1'",
+					Types:         []string{"egress"},
+					OutboundRules: []model.Rule{{Action: "deny"}},
+					Staged:        true,
+				},
+				Revision: testRev,
+			}}))
+		})
+
+		It("should treat a staged delete as a deletion", func() {
+			snp := apiv3.NewStagedNetworkPolicy()
+			snp.Name = "default.deny-all"
+			snp.Namespace = "ns1"
+			snp.Spec.StagedAction = apiv3.StagedActionDelete
+
+			kvps, err := up.Process(&model.KVPair{Key: key, Value: snp, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key}}))
+		})
+	})
+})
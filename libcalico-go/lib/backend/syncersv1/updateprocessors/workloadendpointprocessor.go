@@ -163,6 +163,13 @@ func convertWorkloadEndpointV2ToV1Value(val interface{}) (interface{}, error) {
 		}
 	}
 
+	var qosControls *model.QoSControls
+	if v3res.Spec.QoSControls != nil {
+		qosControls = &model.QoSControls{
+			EgressBandwidth: v3res.Spec.QoSControls.EgressBandwidth,
+		}
+	}
+
 	v1value := &model.WorkloadEndpoint{
 		State:                      "active",
 		Name:                       v3res.Spec.InterfaceName,
@@ -179,6 +186,7 @@ func convertWorkloadEndpointV2ToV1Value(val interface{}) (interface{}, error) {
 		GenerateName:               v3res.GenerateName,
 		AllowSpoofedSourcePrefixes: allowedSources,
 		Annotations:                v3res.GetObjectMeta().GetAnnotations(),
+		QoSControls:                qosControls,
 	}
 
 	return v1value, nil
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+)
+
+// maxChainedWindows bounds how many back-to-back windows we follow when working out when an
+// active schedule closes.  A schedule whose windows never close is re-evaluated at that point.
+const maxChainedWindows = 1000
+
+type window struct {
+	start    *Cron
+	duration time.Duration
+}
+
+// Schedule is a parsed PolicySchedule.
+type Schedule struct {
+	windows  []window
+	location *time.Location
+}
+
+// Parse parses and validates a PolicySchedule.
+func Parse(s *apiv3.PolicySchedule) (*Schedule, error) {
+	loc := time.UTC
+	if s.TimeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(s.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %w", s.TimeZone, err)
+		}
+	}
+	if len(s.Windows) == 0 {
+		return nil, fmt.Errorf("schedule has no windows")
+	}
+	sched := &Schedule{location: loc}
+	for _, w := range s.Windows {
+		c, err := ParseCron(w.Start)
+		if err != nil {
+			return nil, err
+		}
+		if w.Duration.Duration <= 0 {
+			return nil, fmt.Errorf("window starting at %q should have a positive duration", w.Start)
+		}
+		sched.windows = append(sched.windows, window{start: c, duration: w.Duration.Duration})
+	}
+	return sched, nil
+}
+
+// Evaluate returns whether the schedule is active at the given time, and the time at which that
+// next changes.  The returned time is zero if the schedule never changes again.
+func (s *Schedule) Evaluate(now time.Time) (active bool, next time.Time) {
+	now = now.In(s.location)
+	for _, w := range s.windows {
+		if s := w.start.Next(now.Add(-w.duration)); !s.IsZero() && !s.After(now) {
+			active = true
+			break
+		}
+	}
+	if !active {
+		// Inactive until the next window opens.
+		for _, w := range s.windows {
+			if s := w.start.Next(now); !s.IsZero() && (next.IsZero() || s.Before(next)) {
+				next = s
+			}
+		}
+		return
+	}
+
+	// Active until the last of any overlapping or back-to-back windows closes.
+	end := now
+	for i := 0; i < maxChainedWindows; i++ {
+		newEnd := end
+		for _, w := range s.windows {
+			s := w.start.Next(end.Add(-w.duration))
+			if s.IsZero() || s.After(end) {
+				continue
+			}
+			if e := s.Add(w.duration); e.After(newEnd) {
+				newEnd = e
+			}
+		}
+		if newEnd.Equal(end) {
+			break
+		}
+		end = newEnd
+	}
+	return true, end
+}
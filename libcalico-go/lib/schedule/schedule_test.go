@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/schedule"
+)
+
+func utc(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	Expect(err).NotTo(HaveOccurred())
+	return t
+}
+
+var _ = Describe("Cron expressions", func() {
+	DescribeTable("Next",
+		func(expr, from, expected string) {
+			c, err := schedule.ParseCron(expr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Next(utc(from))).To(Equal(utc(expected)))
+		},
+		Entry("every minute", "* * * * *", "2026-03-01T10:00:30Z", "2026-03-01T10:01:00Z"),
+		Entry("daily, later today", "0 2 * * *", "2026-03-01T01:00:00Z", "2026-03-01T02:00:00Z"),
+		Entry("daily, strictly after", "0 2 * * *", "2026-03-01T02:00:00Z", "2026-03-02T02:00:00Z"),
+		Entry("step", "*/15 * * * *", "2026-03-01T10:16:00Z", "2026-03-01T10:30:00Z"),
+		Entry("list and range", "0 9-17/4,22 * * *", "2026-03-01T14:00:00Z", "2026-03-01T17:00:00Z"),
+		Entry("weekdays", "30 8 * * 1-5", "2026-03-07T09:00:00Z", "2026-03-09T08:30:00Z"),
+		Entry("Sunday as 7", "0 0 * * 7", "2026-03-02T00:00:00Z", "2026-03-08T00:00:00Z"),
+		Entry("day of month or day of week", "0 0 15 * 1", "2026-03-10T00:00:00Z", "2026-03-15T00:00:00Z"),
+		Entry("month rollover", "0 0 1 1 *", "2026-03-01T00:00:00Z", "2027-01-01T00:00:00Z"),
+	)
+
+	It("should return zero for an impossible date", func() {
+		c, err := schedule.ParseCron("0 0 31 2 *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Next(utc("2026-03-01T00:00:00Z")).IsZero()).To(BeTrue())
+	})
+
+	DescribeTable("invalid expressions",
+		func(expr string) {
+			_, err := schedule.ParseCron(expr)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("too few fields", "0 2 * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("backwards range", "0 5-2 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("name", "0 0 * * MON"),
+	)
+})
+
+var _ = Describe("Schedules", func() {
+	parse := func(tz string, windows ...apiv3.ScheduleWindow) *schedule.Schedule {
+		s, err := schedule.Parse(&apiv3.PolicySchedule{TimeZone: tz, Windows: windows})
+		Expect(err).NotTo(HaveOccurred())
+		return s
+	}
+	win := func(start string, d time.Duration) apiv3.ScheduleWindow {
+		return apiv3.ScheduleWindow{Start: start, Duration: metav1.Duration{Duration: d}}
+	}
+
+	It("should evaluate a daily window", func() {
+		s := parse("", win("0 2 * * *", 2*time.Hour))
+
+		active, next := s.Evaluate(utc("2026-03-01T01:00:00Z"))
+		Expect(active).To(BeFalse())
+		Expect(next).To(BeTemporally("==", utc("2026-03-01T02:00:00Z")))
+
+		active, next = s.Evaluate(utc("2026-03-01T02:00:00Z"))
+		Expect(active).To(BeTrue())
+		Expect(next).To(BeTemporally("==", utc("2026-03-01T04:00:00Z")))
+
+		active, next = s.Evaluate(utc("2026-03-01T04:00:00Z"))
+		Expect(active).To(BeFalse())
+		Expect(next).To(BeTemporally("==", utc("2026-03-02T02:00:00Z")))
+	})
+
+	It("should honour the time zone", func() {
+		s := parse("America/New_York", win("0 2 * * *", time.Hour))
+
+		active, _ := s.Evaluate(utc("2026-03-01T02:30:00Z"))
+		Expect(active).To(BeFalse())
+		active, next := s.Evaluate(utc("2026-03-01T07:30:00Z"))
+		Expect(active).To(BeTrue())
+		Expect(next).To(BeTemporally("==", utc("2026-03-01T08:00:00Z")))
+	})
+
+	It("should merge overlapping windows", func() {
+		s := parse("",
+			win("0 2 * * *", 2*time.Hour),
+			win("0 3 * * *", 2*time.Hour),
+		)
+		active, next := s.Evaluate(utc("2026-03-01T02:30:00Z"))
+		Expect(active).To(BeTrue())
+		Expect(next).To(BeTemporally("==", utc("2026-03-01T05:00:00Z")))
+	})
+
+	It("should reject bad schedules", func() {
+		_, err := schedule.Parse(&apiv3.PolicySchedule{TimeZone: "Nowhere/Special", Windows: []apiv3.ScheduleWindow{win("0 2 * * *", time.Hour)}})
+		Expect(err).To(HaveOccurred())
+		_, err = schedule.Parse(&apiv3.PolicySchedule{Windows: []apiv3.ScheduleWindow{win("0 2 * * *", 0)}})
+		Expect(err).To(HaveOccurred())
+		_, err = schedule.Parse(&apiv3.PolicySchedule{})
+		Expect(err).To(HaveOccurred())
+	})
+})
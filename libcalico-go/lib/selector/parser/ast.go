@@ -17,6 +17,9 @@ package parser
 import (
 	_ "crypto/sha256" // register hash func
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -75,6 +78,39 @@ type LabelRestriction struct {
 	// Note: non-nil empty slice means "selector cannot match anything". For
 	// example an inconsistent selector such as: "a == 'B' && a == 'C'"
 	MustHaveOneOfValues []string
+	// MustMatchValueFilters if non-empty, lists predicates that the label's
+	// value must satisfy in order to match the selector.  For example
+	// "tier >= 3" or "app =~ 'web-.*'".  Unlike MustHaveOneOfValues, the
+	// matching values can't be listed up front.
+	MustMatchValueFilters []ValueFilter
+}
+
+// ValueFilter is a predicate on the value of a label.
+type ValueFilter interface {
+	Matches(value string) bool
+	String() string
+}
+
+// ValueAllowed returns true if the given value satisfies the value
+// restrictions.  It doesn't check MustBePresent/MustBeAbsent.
+func (r LabelRestriction) ValueAllowed(value string) bool {
+	if r.MustHaveOneOfValues != nil && !slices.Contains(r.MustHaveOneOfValues, value) {
+		return false
+	}
+	return r.matchesValueFilters(value)
+}
+
+func (r LabelRestriction) matchesValueFilters(value string) bool {
+	for _, f := range r.MustMatchValueFilters {
+		if !f.Matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r LabelRestriction) hasValueRestriction() bool {
+	return r.MustHaveOneOfValues != nil || len(r.MustMatchValueFilters) > 0
 }
 
 func (r LabelRestriction) PossibleToSatisfy() bool {
@@ -116,6 +152,10 @@ func (v PrefixVisitor) Visit(n interface{}) {
 		np.LabelName = fmt.Sprintf("%s%s", v.Prefix, np.LabelName)
 	case *LabelNotInSetNode:
 		np.LabelName = fmt.Sprintf("%s%s", v.Prefix, np.LabelName)
+	case *LabelCompareValueNode:
+		np.LabelName = fmt.Sprintf("%s%s", v.Prefix, np.LabelName)
+	case *LabelMatchesRegexNode:
+		np.LabelName = fmt.Sprintf("%s%s", v.Prefix, np.LabelName)
 	default:
 		log.Debug("Node is a no-op")
 	}
@@ -292,6 +332,156 @@ func (node *LabelEndsWithValueNode) collectFragments(fragments []string) []strin
 	return appendLabelOpAndQuotedString(fragments, node.LabelName, " ends with ", node.Value)
 }
 
+// ComparisonOp is a numeric comparison operator.
+type ComparisonOp string
+
+const (
+	OpLt ComparisonOp = "<"
+	OpLe ComparisonOp = "<="
+	OpGt ComparisonOp = ">"
+	OpGe ComparisonOp = ">="
+)
+
+// LabelCompareValueNode compares a label's value numerically with an integer.
+// Labels whose values are not integers never match.
+type LabelCompareValueNode struct {
+	LabelName string
+	Op        ComparisonOp
+	Value     int64
+}
+
+func (node *LabelCompareValueNode) Evaluate(labels Labels) bool {
+	val, ok := labels.Get(node.LabelName)
+	if ok {
+		return compareValue(val, node.Op, node.Value)
+	}
+	return false
+}
+
+func (node *LabelCompareValueNode) LabelRestrictions() map[string]LabelRestriction {
+	return map[string]LabelRestriction{
+		node.LabelName: {
+			MustBePresent:         true,
+			MustMatchValueFilters: []ValueFilter{compareFilter{node.Op, node.Value}},
+		},
+	}
+}
+
+func (node *LabelCompareValueNode) AcceptVisitor(v Visitor) {
+	v.Visit(node)
+}
+
+func (node *LabelCompareValueNode) collectFragments(fragments []string) []string {
+	return append(fragments, node.LabelName, " ", string(node.Op), " ", strconv.FormatInt(node.Value, 10))
+}
+
+func compareValue(val string, op ComparisonOp, value int64) bool {
+	n, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false
+	}
+	switch op {
+	case OpLt:
+		return n < value
+	case OpLe:
+		return n <= value
+	case OpGt:
+		return n > value
+	case OpGe:
+		return n >= value
+	}
+	return false
+}
+
+type compareFilter struct {
+	op    ComparisonOp
+	value int64
+}
+
+func (f compareFilter) Matches(value string) bool {
+	return compareValue(value, f.op, f.value)
+}
+
+func (f compareFilter) String() string {
+	return fmt.Sprintf("%s %d", f.op, f.value)
+}
+
+// LabelMatchesRegexNode matches if a label's whole value matches a regular
+// expression.
+type LabelMatchesRegexNode struct {
+	LabelName string
+	Value     string
+	regex     *regexp.Regexp
+}
+
+// NewLabelMatchesRegexNode compiles the given regular expression, which must
+// match the whole of the label's value.
+func NewLabelMatchesRegexNode(labelName, pattern string) (*LabelMatchesRegexNode, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+	}
+	return &LabelMatchesRegexNode{LabelName: labelName, Value: pattern, regex: re}, nil
+}
+
+func (node *LabelMatchesRegexNode) Evaluate(labels Labels) bool {
+	val, ok := labels.Get(node.LabelName)
+	if ok {
+		return node.regex.MatchString(val)
+	}
+	return false
+}
+
+func (node *LabelMatchesRegexNode) LabelRestrictions() map[string]LabelRestriction {
+	return map[string]LabelRestriction{
+		node.LabelName: {
+			MustBePresent:         true,
+			MustMatchValueFilters: []ValueFilter{regexFilter{node.regex}},
+		},
+	}
+}
+
+func (node *LabelMatchesRegexNode) AcceptVisitor(v Visitor) {
+	v.Visit(node)
+}
+
+func (node *LabelMatchesRegexNode) collectFragments(fragments []string) []string {
+	return appendLabelOpAndQuotedString(fragments, node.LabelName, " =~ ", node.Value)
+}
+
+type regexFilter struct {
+	regex *regexp.Regexp
+}
+
+func (f regexFilter) Matches(value string) bool {
+	return f.regex.MatchString(value)
+}
+
+func (f regexFilter) String() string {
+	return "=~ " + f.regex.String()
+}
+
+// anyOfFilter matches values that satisfy the value restrictions of at least
+// one of the alternatives.  It is used for the operands of an OrNode.
+type anyOfFilter []LabelRestriction
+
+func (f anyOfFilter) Matches(value string) bool {
+	for _, r := range f {
+		if r.ValueAllowed(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f anyOfFilter) String() string {
+	parts := make([]string, len(f))
+	for i, r := range f {
+		parts[i] = fmt.Sprintf("%v%v", r.MustHaveOneOfValues, r.MustMatchValueFilters)
+	}
+	return "any of " + strings.Join(parts, " | ")
+}
+
 type LabelInSetNode struct {
 	LabelName string
 	Value     StringSet
@@ -485,12 +675,28 @@ func (node *AndNode) LabelRestrictions() map[string]LabelRestriction {
 			} else if r.MustHaveOneOfValues != nil {
 				base.MustHaveOneOfValues = intersectStringSlicesInPlace(base.MustHaveOneOfValues, r.MustHaveOneOfValues)
 			}
+			base.MustMatchValueFilters = append(base.MustMatchValueFilters, r.MustMatchValueFilters...)
 			lr[ln] = base
 		}
 	}
 	if len(lr) == 0 {
 		return nil
 	}
+	for ln, r := range lr {
+		if r.MustHaveOneOfValues != nil && len(r.MustMatchValueFilters) > 0 {
+			// We have a list of values, apply the filters to it, so that
+			// "a in {'1', '5'} && a > 3" becomes "a == '5'".
+			values := r.MustHaveOneOfValues[:0]
+			for _, v := range r.MustHaveOneOfValues {
+				if r.matchesValueFilters(v) {
+					values = append(values, v)
+				}
+			}
+			r.MustHaveOneOfValues = values
+			r.MustMatchValueFilters = nil
+			lr[ln] = r
+		}
+	}
 	return lr
 }
 
@@ -545,13 +751,20 @@ func (node *OrNode) LabelRestrictions() map[string]LabelRestriction {
 			r.MustBePresent = r.MustBePresent && opr.MustBePresent
 			if !r.MustBePresent {
 				r.MustHaveOneOfValues = nil
+				r.MustMatchValueFilters = nil
 			} else {
-				if r.MustHaveOneOfValues == nil || opr.MustHaveOneOfValues == nil {
+				if !r.hasValueRestriction() || !opr.hasValueRestriction() {
 					// At least one side is has(label) so we can't limit on value.
 					r.MustHaveOneOfValues = nil
-				} else {
+					r.MustMatchValueFilters = nil
+				} else if len(r.MustMatchValueFilters) == 0 && len(opr.MustMatchValueFilters) == 0 {
 					// Both sides place limits on the value, add them together since either is good enough.
 					r.MustHaveOneOfValues = unionStringSlicesInPlace(r.MustHaveOneOfValues, opr.MustHaveOneOfValues)
+				} else {
+					// At least one side uses a filter, so we can't list the values; match
+					// values that satisfy either side.
+					r.MustMatchValueFilters = []ValueFilter{anyOfFilter{r, opr}}
+					r.MustHaveOneOfValues = nil
 				}
 			}
 			r.MustBeAbsent = r.MustBeAbsent && opr.MustBeAbsent
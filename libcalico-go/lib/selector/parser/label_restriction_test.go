@@ -139,3 +139,59 @@ func TestLabelRestrictions(t *testing.T) {
 		})
 	}
 }
+
+var labelRestrictionFilterTests = []struct {
+	Sel                 string
+	Label               string
+	MustHaveOneOfValues []string
+	Allowed             []string
+	NotAllowed          []string
+}{
+	{Sel: "a > 3", Label: "a", Allowed: []string{"4", "100"}, NotAllowed: []string{"3", "-5", "x"}},
+	{Sel: "a =~ 'b.*'", Label: "a", Allowed: []string{"b", "bcd"}, NotAllowed: []string{"ab", ""}},
+	{Sel: "a > 3 && a <= 5", Label: "a", Allowed: []string{"4", "5"}, NotAllowed: []string{"3", "6"}},
+	{Sel: "a > 3 && has(a)", Label: "a", Allowed: []string{"4"}, NotAllowed: []string{"3"}},
+	{Sel: "a > 3 || a == 'x'", Label: "a", Allowed: []string{"4", "x"}, NotAllowed: []string{"3", "y"}},
+	{Sel: "a < 0 || a > 10", Label: "a", Allowed: []string{"-1", "11"}, NotAllowed: []string{"0", "10"}},
+	// Filters get applied to value lists.
+	{Sel: "a in {'1', '5', 'x'} && a > 3", Label: "a", MustHaveOneOfValues: []string{"5"}},
+	{Sel: "a == '1' && a > 3", Label: "a", MustHaveOneOfValues: []string{}},
+}
+
+func TestLabelRestrictionFilters(t *testing.T) {
+	for _, test := range labelRestrictionFilterTests {
+		t.Run(strings.Replace(test.Sel, " ", "", -1), func(t *testing.T) {
+			RegisterTestingT(t)
+			sel, err := Parse(test.Sel)
+			Expect(err).NotTo(HaveOccurred())
+			lrs := sel.LabelRestrictions()
+			Expect(lrs).To(HaveLen(1))
+			lr := lrs[test.Label]
+			Expect(lr.MustBePresent).To(BeTrue())
+			Expect(lr.MustHaveOneOfValues).To(Equal(test.MustHaveOneOfValues))
+			if test.MustHaveOneOfValues != nil {
+				Expect(lr.MustMatchValueFilters).To(BeEmpty())
+			} else {
+				Expect(lr.MustMatchValueFilters).NotTo(BeEmpty())
+			}
+			for _, v := range test.Allowed {
+				Expect(lr.ValueAllowed(v)).To(BeTrue(), fmt.Sprintf("%s should allow %q", test.Sel, v))
+			}
+			for _, v := range test.NotAllowed {
+				Expect(lr.ValueAllowed(v)).To(BeFalse(), fmt.Sprintf("%s should not allow %q", test.Sel, v))
+			}
+		})
+	}
+}
+
+func TestLabelRestrictionFiltersDroppedByOr(t *testing.T) {
+	RegisterTestingT(t)
+	for _, s := range []string{"a > 3 || has(a)", "a > 3 || b > 3", "!a > 3"} {
+		sel, err := Parse(s)
+		Expect(err).NotTo(HaveOccurred())
+		for _, lr := range sel.LabelRestrictions() {
+			Expect(lr.MustMatchValueFilters).To(BeEmpty(), s)
+			Expect(lr.MustHaveOneOfValues).To(BeNil(), s)
+		}
+	}
+}
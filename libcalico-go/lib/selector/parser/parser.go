@@ -17,6 +17,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
@@ -207,8 +208,16 @@ var (
 	ErrExpectedRBrace = errors.New("expected }")
 	ErrExpectedString = errors.New("expected string")
 	ErrExpectedSetLit = errors.New("expected set literal")
+	ErrExpectedNumber = errors.New("expected integer")
 )
 
+var comparisonOps = map[tokenizer.Kind]ComparisonOp{
+	tokenizer.TokLt: OpLt,
+	tokenizer.TokLe: OpLe,
+	tokenizer.TokGt: OpGt,
+	tokenizer.TokGe: OpGe,
+}
+
 // parseOperations parses a single, possibly negated operation (i.e. ==, !=, has()).
 // It also handles calling parseOrExpression recursively for parenthesized expressions.
 func (p *Parser) parseOperation(tokens []tokenizer.Token, validateOnly bool) (sel node, remTokens []tokenizer.Token, err error) {
@@ -300,6 +309,36 @@ func (p *Parser) parseOperation(tokens []tokenizer.Token, validateOnly bool) (se
 			} else {
 				err = ErrExpectedString
 			}
+		case tokenizer.TokLt, tokenizer.TokLe, tokenizer.TokGt, tokenizer.TokGe:
+			// The value may be given as a number or as a quoted number.
+			if tokens[2].Kind == tokenizer.TokNumberLiteral || tokens[2].Kind == tokenizer.TokStringLiteral {
+				value, parseErr := strconv.ParseInt(tokens[2].Value, 10, 64)
+				if parseErr != nil {
+					err = ErrExpectedNumber
+					return
+				}
+				if !validateOnly {
+					sel = &LabelCompareValueNode{tokens[0].Value, comparisonOps[tokens[1].Kind], value}
+				}
+				remTokens = tokens[3:]
+			} else {
+				err = ErrExpectedNumber
+			}
+		case tokenizer.TokRegexMatch:
+			if tokens[2].Kind == tokenizer.TokStringLiteral {
+				// Compile even when only validating, to catch bad regexes.
+				var regexNode *LabelMatchesRegexNode
+				regexNode, err = NewLabelMatchesRegexNode(tokens[0].Value, tokens[2].Value)
+				if err != nil {
+					return
+				}
+				if !validateOnly {
+					sel = regexNode
+				}
+				remTokens = tokens[3:]
+			} else {
+				err = ErrExpectedString
+			}
 		case tokenizer.TokIn, tokenizer.TokNotIn:
 			if tokens[2].Kind == tokenizer.TokLBrace {
 				remTokens = tokens[3:]
@@ -120,6 +120,33 @@ var selectorTests = []selectorTest{
 	{`a in {"a", "b"}`, []map[string]string{{"a": "a"}}, []map[string]string{}},
 	{`a in {"a", "b"}`, []map[string]string{{"a": "b"}}, []map[string]string{}},
 	{`a not in {"d", "e"}`, []map[string]string{{"a": "a"}}, []map[string]string{}},
+	{`a > 3`, []map[string]string{
+		{"a": "4"},
+		{"a": "10"},
+	}, []map[string]string{
+		{},
+		{"a": "3"},
+		{"a": "-4"},
+		{"a": "four"},
+		{"a": ""},
+		{"b": "4"},
+	}},
+	{`a >= 3`, []map[string]string{{"a": "3"}, {"a": "+4"}}, []map[string]string{{"a": "2"}}},
+	{`a < -1`, []map[string]string{{"a": "-2"}}, []map[string]string{{"a": "-1"}, {"a": "0"}}},
+	{`a<="10"`, []map[string]string{{"a": "10"}, {"a": "9"}}, []map[string]string{{"a": "11"}, {}}},
+	{`!a > 3`, []map[string]string{{}, {"a": "3"}, {"a": "x"}}, []map[string]string{{"a": "4"}}},
+	{`a > 1 && a < 4`, []map[string]string{{"a": "2"}, {"a": "3"}}, []map[string]string{{"a": "1"}, {"a": "4"}}},
+	{`a =~ "web-[0-9]+"`, []map[string]string{
+		{"a": "web-1"},
+		{"a": "web-123"},
+	}, []map[string]string{
+		{},
+		{"a": "web-"},
+		{"a": "xweb-1"},
+		{"a": "web-1x"},
+		{"b": "web-1"},
+	}},
+	{`a =~ 'x|y'`, []map[string]string{{"a": "x"}, {"a": "y"}}, []map[string]string{{"a": "xy"}}},
 	{`has(a)`, []map[string]string{{"a": "b"}}, []map[string]string{}},
 	{`!has(a)`, []map[string]string{{"b": "b"}}, []map[string]string{}},
 	{``, []map[string]string{{}}, []map[string]string{}},
@@ -194,6 +221,14 @@ var badSelectors = []string{
 	`a == "b" || %`,   // Unexpected char
 	`a `,              // should be followed by operator
 	`has(foo) &&`,     // should be followed by operator
+	`a > b`,           // expect number
+	`a > "b"`,         // expect number
+	`a > 1.5`,         // only integers
+	`a > 3b`,          // garbage after number
+	`a >`,             // missing number
+	`a = 1`,           // expect == or =~
+	`a =~ b`,          // expect string
+	`a =~ "("`,        // bad regex
 }
 
 var canonicalisationTests = []struct {
@@ -219,6 +254,11 @@ var canonicalisationTests = []struct {
 	{`a in {"d", "a", "b"}`, `a in {"a", "b", "d"}`, ""},
 	{`a in {"z", "x", "y", "a"}`, `a in {"a", "x", "y", "z"}`, ""},
 	{`a in {"z", "z", "x", "y", "x", "a"}`, `a in {"a", "x", "y", "z"}`, ""},
+	{`a>3`, `a > 3`, ""},
+	{`a >= '3'`, `a >= 3`, ""},
+	{`a<+03`, `a < 3`, ""},
+	{`a <= -3`, `a <= -3`, ""},
+	{`a=~'b.*'`, `a =~ "b.*"`, ""},
 }
 
 var _ = Describe("Parser", func() {
@@ -344,6 +384,8 @@ var _ = Describe("Visitor", func() {
 		Entry("should visit a NotNode", "!(k == 'v')", "!visited/k == \"v\"", testVisitor),
 		Entry("should visit a LabelInSetNode", "k in {'v'}", "visited/k in {\"v\"}", testVisitor),
 		Entry("should visit a LabelNotInSetNode", "k not in {'v'}", "visited/k not in {\"v\"}", testVisitor),
+		Entry("should visit a LabelCompareValueNode", "k >= 3", "visited/k >= 3", testVisitor),
+		Entry("should visit a LabelMatchesRegexNode", "k =~ 'v.*'", "visited/k =~ \"v.*\"", testVisitor),
 		Entry("should visit a big complex selector",
 			"!(!(k == 'v' && has(t) || all()) && (a in {'b', 'c'}))",
 			"!(!((visited/k == \"v\" && has(visited/t)) || all()) && visited/a in {\"b\", \"c\"})",
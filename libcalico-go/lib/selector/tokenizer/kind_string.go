@@ -11,30 +11,36 @@ func _() {
 	_ = x[TokNone-0]
 	_ = x[TokLabel-1]
 	_ = x[TokStringLiteral-2]
-	_ = x[TokLBrace-3]
-	_ = x[TokRBrace-4]
-	_ = x[TokComma-5]
-	_ = x[TokEq-6]
-	_ = x[TokNe-7]
-	_ = x[TokIn-8]
-	_ = x[TokNot-9]
-	_ = x[TokNotIn-10]
-	_ = x[TokContains-11]
-	_ = x[TokStartsWith-12]
-	_ = x[TokEndsWith-13]
-	_ = x[TokAll-14]
-	_ = x[TokHas-15]
-	_ = x[TokLParen-16]
-	_ = x[TokRParen-17]
-	_ = x[TokAnd-18]
-	_ = x[TokOr-19]
-	_ = x[TokGlobal-20]
-	_ = x[TokEOF-21]
+	_ = x[TokNumberLiteral-3]
+	_ = x[TokLBrace-4]
+	_ = x[TokRBrace-5]
+	_ = x[TokComma-6]
+	_ = x[TokEq-7]
+	_ = x[TokNe-8]
+	_ = x[TokLt-9]
+	_ = x[TokLe-10]
+	_ = x[TokGt-11]
+	_ = x[TokGe-12]
+	_ = x[TokRegexMatch-13]
+	_ = x[TokIn-14]
+	_ = x[TokNot-15]
+	_ = x[TokNotIn-16]
+	_ = x[TokContains-17]
+	_ = x[TokStartsWith-18]
+	_ = x[TokEndsWith-19]
+	_ = x[TokAll-20]
+	_ = x[TokHas-21]
+	_ = x[TokLParen-22]
+	_ = x[TokRParen-23]
+	_ = x[TokAnd-24]
+	_ = x[TokOr-25]
+	_ = x[TokGlobal-26]
+	_ = x[TokEOF-27]
 }
 
-const _Kind_name = "TokNoneTokLabelTokStringLiteralTokLBraceTokRBraceTokCommaTokEqTokNeTokInTokNotTokNotInTokContainsTokStartsWithTokEndsWithTokAllTokHasTokLParenTokRParenTokAndTokOrTokGlobalTokEOF"
+const _Kind_name = "TokNoneTokLabelTokStringLiteralTokNumberLiteralTokLBraceTokRBraceTokCommaTokEqTokNeTokLtTokLeTokGtTokGeTokRegexMatchTokInTokNotTokNotInTokContainsTokStartsWithTokEndsWithTokAllTokHasTokLParenTokRParenTokAndTokOrTokGlobalTokEOF"
 
-var _Kind_index = [...]uint8{0, 7, 15, 31, 40, 49, 57, 62, 67, 72, 78, 86, 97, 110, 121, 127, 133, 142, 151, 157, 162, 171, 177}
+var _Kind_index = [...]uint8{0, 7, 15, 31, 47, 56, 65, 73, 78, 83, 88, 93, 98, 103, 116, 121, 127, 135, 146, 159, 170, 176, 182, 191, 200, 206, 211, 220, 226}
 
 func (i Kind) String() string {
 	if i < 0 || i >= Kind(len(_Kind_index)-1) {
@@ -30,11 +30,17 @@ const (
 	TokNone Kind = iota
 	TokLabel
 	TokStringLiteral
+	TokNumberLiteral
 	TokLBrace
 	TokRBrace
 	TokComma
 	TokEq
 	TokNe
+	TokLt
+	TokLe
+	TokGt
+	TokGe
+	TokRegexMatch
 	TokIn
 	TokNot
 	TokNotIn
@@ -124,8 +130,24 @@ func AppendTokens(tokens []Token, input string) ([]Token, error) {
 		case '=':
 			if input, found = strings.CutPrefix(input, "=="); found {
 				tokens = append(tokens, Token{Kind: TokEq})
+			} else if input, found = strings.CutPrefix(input, "=~"); found {
+				tokens = append(tokens, Token{Kind: TokRegexMatch})
 			} else {
-				return nil, errors.New("expected ==")
+				return nil, errors.New("expected == or =~")
+			}
+		case '<':
+			if input, found = strings.CutPrefix(input, "<="); found {
+				tokens = append(tokens, Token{Kind: TokLe})
+			} else {
+				tokens = append(tokens, Token{Kind: TokLt})
+				input = input[1:]
+			}
+		case '>':
+			if input, found = strings.CutPrefix(input, ">="); found {
+				tokens = append(tokens, Token{Kind: TokGe})
+			} else {
+				tokens = append(tokens, Token{Kind: TokGt})
+				input = input[1:]
 			}
 		case '!':
 			if input, found = strings.CutPrefix(input, "!="); found {
@@ -150,7 +172,15 @@ func AppendTokens(tokens []Token, input string) ([]Token, error) {
 			// Handle less-simple cases with custom logic.  We've already stripped any whitespace.
 			var ident string
 			var err error
-			if lastTokKind == TokLabel {
+			if isComparisonOp(lastTokKind) {
+				// Comparison operators take a number, which would otherwise
+				// look like a label.
+				var num string
+				if num, input, err = cutNumber(input); err != nil {
+					return nil, err
+				}
+				tokens = append(tokens, Token{TokNumberLiteral, num})
+			} else if lastTokKind == TokLabel {
 				// If we just saw a label, look for an operator next.
 				if input, found = cutPrefixCheckBreak(input, "contains"); found {
 					tokens = append(tokens, Token{Kind: TokContains})
@@ -206,6 +236,26 @@ func AppendTokens(tokens []Token, input string) ([]Token, error) {
 	}
 }
 
+func isComparisonOp(k Kind) bool {
+	return k == TokLt || k == TokLe || k == TokGt || k == TokGe
+}
+
+// cutNumber cuts a decimal integer, with optional sign, from the start of the input.
+func cutNumber(in string) (num string, remainder string, err error) {
+	i := 0
+	if i < len(in) && (in[i] == '-' || in[i] == '+') {
+		i++
+	}
+	start := i
+	for i < len(in) && in[i] >= '0' && in[i] <= '9' {
+		i++
+	}
+	if i == start || !isWordBoundary(in[i:]) {
+		return "", in, errors.New("expected number after comparison operator")
+	}
+	return in[:i], in[i:], nil
+}
+
 func trimWhitespace(input string) string {
 	end := 0
 	for ; end < len(input); end++ {
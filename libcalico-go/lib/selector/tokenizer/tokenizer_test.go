@@ -67,6 +67,38 @@ var tokenTests = []struct {
 		{Kind: tokenizer.TokStringLiteral, Value: "value"},
 		{Kind: tokenizer.TokEOF},
 	}},
+	{`a > 10`, []tokenizer.Token{
+		{Kind: tokenizer.TokLabel, Value: "a"},
+		{Kind: tokenizer.TokGt},
+		{Kind: tokenizer.TokNumberLiteral, Value: "10"},
+		{Kind: tokenizer.TokEOF},
+	}},
+	{`a>=-3&&b<2||c<=+4`, []tokenizer.Token{
+		{Kind: tokenizer.TokLabel, Value: "a"},
+		{Kind: tokenizer.TokGe},
+		{Kind: tokenizer.TokNumberLiteral, Value: "-3"},
+		{Kind: tokenizer.TokAnd},
+		{Kind: tokenizer.TokLabel, Value: "b"},
+		{Kind: tokenizer.TokLt},
+		{Kind: tokenizer.TokNumberLiteral, Value: "2"},
+		{Kind: tokenizer.TokOr},
+		{Kind: tokenizer.TokLabel, Value: "c"},
+		{Kind: tokenizer.TokLe},
+		{Kind: tokenizer.TokNumberLiteral, Value: "+4"},
+		{Kind: tokenizer.TokEOF},
+	}},
+	{`a > "10"`, []tokenizer.Token{
+		{Kind: tokenizer.TokLabel, Value: "a"},
+		{Kind: tokenizer.TokGt},
+		{Kind: tokenizer.TokStringLiteral, Value: "10"},
+		{Kind: tokenizer.TokEOF},
+	}},
+	{`label =~ "v.*"`, []tokenizer.Token{
+		{Kind: tokenizer.TokLabel, Value: "label"},
+		{Kind: tokenizer.TokRegexMatch},
+		{Kind: tokenizer.TokStringLiteral, Value: "v.*"},
+		{Kind: tokenizer.TokEOF},
+	}},
 	{`label contains "value"`, []tokenizer.Token{
 		{Kind: tokenizer.TokLabel, Value: "label"},
 		{Kind: tokenizer.TokContains},
@@ -149,6 +181,8 @@ var tokenTests = []struct {
 	{`label == "value`, nil},
 	{`label == 'value`, nil},
 	{`label = "value"`, nil},
+	{`label > 1x`, nil},
+	{`label > -`, nil},
 	{`all(`, nil},
 	{`global(`, nil},
 	{`has()`, nil},
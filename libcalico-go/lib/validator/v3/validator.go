@@ -21,6 +21,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
@@ -34,6 +35,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/errors"
 	"github.com/projectcalico/calico/libcalico-go/lib/names"
 	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/schedule"
 	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 	"github.com/projectcalico/calico/libcalico-go/lib/selector/tokenizer"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
@@ -86,7 +88,7 @@ var (
 	bgpFilterPrefixLengthV6 = regexp.MustCompile("^([0-9]|[1-9][0-9]|1[0-1][0-9]|12[0-8])$")
 	ignoredInterfaceRegex   = regexp.MustCompile("^[a-zA-Z0-9_.*-]{1,15}$")
 	ifaceFilterRegex        = regexp.MustCompile("^[a-zA-Z0-9:._+-]{1,15}$")
-	actionRegex             = regexp.MustCompile("^(Allow|Deny|Log|Pass)$")
+	actionRegex             = regexp.MustCompile("^(Allow|Deny|Log|Pass|Reject)$")
 	protocolRegex           = regexp.MustCompile("^(TCP|UDP|ICMP|ICMPv6|SCTP|UDPLite)$")
 	ipipModeRegex           = regexp.MustCompile("^(Always|CrossSubnet|Never)$")
 	vxlanModeRegex          = regexp.MustCompile("^(Always|CrossSubnet|Never)$")
@@ -108,6 +110,10 @@ var (
 	number                  = regexp.MustCompile(`(\d+)`)
 	IPv4PortFormat          = regexp.MustCompile(`^(\d+).(\d+).(\d+).(\d+):(\d+)$`)
 	IPv6PortFormat          = regexp.MustCompile(`^\[[0-9a-fA-F:.]+\]:(\d+)$`)
+	httpHeaderNameRegex     = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9a-zA-Z-]+$")
+	grpcServiceRegex        = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)*$`)
+	grpcMethodRegex         = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	domainRegex             = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
 	reasonString            = "Reason: "
 	poolUnstictCIDR         = "IP pool CIDR is not strictly masked"
 	overlapsV4LinkLocal     = "IP pool range overlaps with IPv4 Link Local range 169.254.0.0/16"
@@ -183,6 +189,7 @@ func init() {
 	registerFieldValidator("assignmentMode", validateAssignmentMode)
 	registerFieldValidator("assignIPs", validateAssignIPs)
 	registerFieldValidator("policyType", validatePolicyType)
+	registerFieldValidator("stagedAction", validateStagedAction)
 	registerFieldValidator("logLevel", validateLogLevel)
 	registerFieldValidator("bpfLogLevel", validateBPFLogLevel)
 	registerFieldValidator("bpfLogFilters", validateBPFLogFilters)
@@ -210,6 +217,9 @@ func init() {
 	registerFieldValidator("wireguardPublicKey", validateWireguardPublicKey)
 	registerFieldValidator("IP:port", validateIPPort)
 	registerFieldValidator("reachableBy", validateReachableByField)
+	registerFieldValidator("domain", validateDomain)
+	registerFieldValidator("cronSchedule", validateCronSchedule)
+	registerFieldValidator("timeZone", validateTimeZone)
 
 	// Register filter action and match operator validators (used in BGPFilter)
 	registerFieldValidator("filterAction", RegexValidator("FilterAction", filterActionRegex))
@@ -248,6 +258,7 @@ func init() {
 	registerStructValidator(validate, validateFelixConfigSpec, api.FelixConfigurationSpec{})
 	registerStructValidator(validate, validateWorkloadEndpointSpec, libapi.WorkloadEndpointSpec{})
 	registerStructValidator(validate, validateHostEndpointSpec, api.HostEndpointSpec{})
+	registerStructValidator(validate, validateAutoHostEndpointConfig, api.AutoHostEndpointConfig{})
 	registerStructValidator(validate, validateRule, api.Rule{})
 	registerStructValidator(validate, validateEntityRule, api.EntityRule{})
 	registerStructValidator(validate, validateBGPPeerSpec, api.BGPPeerSpec{})
@@ -255,6 +266,8 @@ func init() {
 	registerStructValidator(validate, validateBGPFilterRuleV6, api.BGPFilterRuleV6{})
 	registerStructValidator(validate, validateNetworkPolicy, api.NetworkPolicy{})
 	registerStructValidator(validate, validateGlobalNetworkPolicy, api.GlobalNetworkPolicy{})
+	registerStructValidator(validate, validateStagedNetworkPolicy, api.StagedNetworkPolicy{})
+	registerStructValidator(validate, validateStagedGlobalNetworkPolicy, api.StagedGlobalNetworkPolicy{})
 	registerStructValidator(validate, validateGlobalNetworkSet, api.GlobalNetworkSet{})
 	registerStructValidator(validate, validateNetworkSet, api.NetworkSet{})
 	registerStructValidator(validate, validateRuleMetadata, api.RuleMetadata{})
@@ -263,6 +276,7 @@ func init() {
 	registerStructValidator(validate, validateBGPConfigurationSpec, api.BGPConfigurationSpec{})
 	registerStructValidator(validate, validateBlockAffinitySpec, libapi.BlockAffinitySpec{})
 	registerStructValidator(validate, validateHealthTimeoutOverride, api.HealthTimeoutOverride{})
+	registerStructValidator(validate, validateScheduleWindow, api.ScheduleWindow{})
 }
 
 // reason returns the provided error reason prefixed with an identifier that
@@ -599,6 +613,12 @@ func validatePolicyType(fl validator.FieldLevel) bool {
 	return false
 }
 
+func validateStagedAction(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate staged action: %s", s)
+	return s == string(api.StagedActionSet) || s == string(api.StagedActionDelete)
+}
+
 func validateProtocol(structLevel validator.StructLevel) {
 	p := structLevel.Current().Interface().(numorstring.Protocol)
 	log.Debugf("Validate protocol: %v %s %d", p.Type, p.StrVal, p.NumVal)
@@ -773,6 +793,27 @@ func validateKeyValueList(fl validator.FieldLevel) bool {
 	return true
 }
 
+// validateDomain validates a domain name, optionally prefixed with "*." to match any subdomain.
+func validateDomain(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate domain: %s", s)
+	return len(s) <= 253 && domainRegex.MatchString(s)
+}
+
+func validateCronSchedule(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate cron schedule: %s", s)
+	_, err := schedule.ParseCron(s)
+	return err == nil
+}
+
+func validateTimeZone(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate time zone: %s", s)
+	_, err := time.LoadLocation(s)
+	return err == nil
+}
+
 // validateIPPort validates the IP and Port given in either <IPv4>:<port> or [<IPv6>]:<port> or <IP> format
 func validateIPPort(fl validator.FieldLevel) bool {
 	ipPort := fl.Field().String()
@@ -849,6 +890,49 @@ func validateHTTPPaths(paths []api.HTTPPath) error {
 	return nil
 }
 
+// validateHTTPHeaders checks if the HTTP header match clauses are valid.
+func validateHTTPHeaders(headers []api.HTTPHeaderMatch) error {
+	for _, h := range headers {
+		if !httpHeaderNameRegex.MatchString(h.Name) {
+			return fmt.Errorf("Invalid header name %q", h.Name)
+		}
+		numSet := 0
+		for _, set := range []bool{h.Exact != "", h.Prefix != "", h.Regex != "", h.Present} {
+			if set {
+				numSet++
+			}
+		}
+		if numSet != 1 {
+			return fmt.Errorf("Invalid match for header %s. Exactly one of 'exact', 'prefix', 'regex' or 'present' must be set", h.Name)
+		}
+		if h.Regex != "" {
+			if _, err := regexp.Compile(h.Regex); err != nil {
+				return fmt.Errorf("Invalid regex for header %s: %v", h.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateGRPCMatch checks if the gRPC service and method names are valid.
+func validateGRPCMatch(g *api.GRPCMatch) error {
+	if g == nil {
+		return nil
+	}
+	if g.Service != "" && !grpcServiceRegex.MatchString(g.Service) {
+		return fmt.Errorf("Invalid gRPC service %q", g.Service)
+	}
+	for _, m := range g.Methods {
+		if !grpcMethodRegex.MatchString(m) {
+			return fmt.Errorf("Invalid gRPC method %q", m)
+		}
+	}
+	if set.FromArray(g.Methods).Len() != len(g.Methods) {
+		return fmt.Errorf("Invalid gRPC methods (duplicates): %v", g.Methods)
+	}
+	return nil
+}
+
 func validateHTTPRule(structLevel validator.StructLevel) {
 	h := structLevel.Current().Interface().(api.HTTPMatch)
 	log.Debugf("Validate HTTP Rule: %v", h)
@@ -858,6 +942,12 @@ func validateHTTPRule(structLevel validator.StructLevel) {
 	if err := validateHTTPPaths(h.Paths); err != nil {
 		structLevel.ReportError(reflect.ValueOf(h.Paths), "Paths", "", reason(err.Error()), "")
 	}
+	if err := validateHTTPHeaders(h.Headers); err != nil {
+		structLevel.ReportError(reflect.ValueOf(h.Headers), "Headers", "", reason(err.Error()), "")
+	}
+	if err := validateGRPCMatch(h.GRPC); err != nil {
+		structLevel.ReportError(reflect.ValueOf(h.GRPC), "GRPC", "", reason(err.Error()), "")
+	}
 }
 
 func validatePort(structLevel validator.StructLevel) {
@@ -1086,6 +1176,29 @@ func validateHostEndpointSpec(structLevel validator.StructLevel) {
 	}
 }
 
+func validateAutoHostEndpointConfig(structLevel validator.StructLevel) {
+	c := structLevel.Current().Interface().(api.AutoHostEndpointConfig)
+
+	generateNames := map[string]bool{}
+	for _, t := range c.Templates {
+		// Template host endpoints are named after the node and template, so the names must
+		// not clash with each other or with the default host endpoint.
+		if t.GenerateName == "auto-hep" || generateNames[t.GenerateName] {
+			structLevel.ReportError(reflect.ValueOf(t.GenerateName),
+				"Templates.GenerateName", "", reason(fmt.Sprintf("generateName %q is not unique", t.GenerateName)), "")
+		}
+		generateNames[t.GenerateName] = true
+
+		// Like a host endpoint, a template must have an interface name and/or some CIDRs to
+		// select the expected IPs.
+		if t.InterfaceName == "" && len(t.InterfaceCIDRs) == 0 {
+			structLevel.ReportError(reflect.ValueOf(t.InterfaceName),
+				"Templates.InterfaceName", "", reason("no interface or interface CIDRs have been specified"), "")
+		}
+		validateObjectMetaLabels(structLevel, t.Labels)
+	}
+}
+
 func validateIPPoolSpec(structLevel validator.StructLevel) {
 	pool := structLevel.Current().Interface().(api.IPPoolSpec)
 
@@ -1224,6 +1337,12 @@ func validateICMPFields(structLevel validator.StructLevel) {
 		structLevel.ReportError(reflect.ValueOf(icmp.Code),
 			"Code", "", reason("ICMP code specified without an ICMP type"), "")
 	}
+
+	// Lists and groups of types are an alternative to a single type and code.
+	if (len(icmp.Types) > 0 || len(icmp.Groups) > 0) && (icmp.Type != nil || icmp.Code != nil) {
+		structLevel.ReportError(reflect.ValueOf(icmp.Types),
+			"Types", "", reason("ICMP types and groups cannot be combined with type or code"), "")
+	}
 }
 
 func validateRule(structLevel validator.StructLevel) {
@@ -1265,6 +1384,24 @@ func validateRule(structLevel validator.StructLevel) {
 		structLevel.ReportError(reflect.ValueOf(rule.ICMP), "ICMP", "", reason(protocolIcmpMsg), "")
 	}
 
+	// Lists and groups of types are only meaningful with an ICMP protocol, since the groups are
+	// expanded differently for ICMP and ICMPv6.
+	if rule.NotICMP != nil && (len(rule.NotICMP.Types) > 0 || len(rule.NotICMP.Groups) > 0) &&
+		(rule.Protocol == nil || (*rule.Protocol != icmp && *rule.Protocol != icmpv6)) {
+		structLevel.ReportError(reflect.ValueOf(rule.NotICMP), "NotICMP", "", reason(protocolIcmpMsg), "")
+	}
+	for _, f := range []*api.ICMPFields{rule.ICMP, rule.NotICMP} {
+		if f == nil || (rule.Protocol != nil && *rule.Protocol == icmpv6) {
+			continue
+		}
+		for _, g := range f.Groups {
+			if g == api.ICMPGroupNeighborDiscovery {
+				structLevel.ReportError(reflect.ValueOf(g), "Groups", "",
+					reason("ICMP group NeighborDiscovery requires protocol ICMPv6"), "")
+			}
+		}
+	}
+
 	// Check that the IPVersion of the protocol matches the IPVersion of the ICMP protocol.
 	if (rule.Protocol != nil && *rule.Protocol == icmp) || (rule.NotProtocol != nil && *rule.NotProtocol == icmp) {
 		if rule.IPVersion != nil && *rule.IPVersion != 4 {
@@ -1315,6 +1452,12 @@ func validateRule(structLevel validator.StructLevel) {
 			"", reason("only valid for Allow rules"), "")
 	}
 
+	// Domains are learned from DNS responses to workloads so they can only identify destinations.
+	if len(rule.Source.Domains) != 0 {
+		structLevel.ReportError(reflect.ValueOf(rule.Source.Domains),
+			"Source.Domains", "", reason("domains can only be used in the destination of a rule"), "")
+	}
+
 	// Check that destination service rules do not use ports.
 	// Destination service rules use ports specified on the endpoints.
 	if rule.Destination.Services != nil && len(rule.Destination.Ports) != 0 {
@@ -1373,6 +1516,21 @@ func validateEntityRule(structLevel validator.StructLevel) {
 				"Services field", "", reason("cannot specify Nets/NotNets and Services on the same rule"), "")
 		}
 	}
+
+	if len(rule.Domains) != 0 {
+		if rule.Selector != "" || rule.NotSelector != "" || rule.NamespaceSelector != "" {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify selectors and Domains on the same rule"), "")
+		}
+		if len(rule.Nets) != 0 || len(rule.NotNets) != 0 {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify Nets/NotNets and Domains on the same rule"), "")
+		}
+		if rule.ServiceAccounts != nil || rule.Services != nil {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify ServiceAccounts/Services and Domains on the same rule"), "")
+		}
+	}
 }
 
 func validateIPAMConfigSpec(structLevel validator.StructLevel) {
@@ -1637,12 +1795,34 @@ func validateTier(structLevel validator.StructLevel) {
 		}
 	}
 
+	for _, d := range tier.Spec.Delegations {
+		if len(d.Users) == 0 && len(d.Groups) == 0 {
+			structLevel.ReportError(
+				reflect.ValueOf(d),
+				"TierSpec.Delegations",
+				"",
+				reason("delegation must specify at least one user or group"),
+				"",
+			)
+		}
+	}
+
 	validateObjectMetaAnnotations(structLevel, tier.Annotations)
 	validateObjectMetaLabels(structLevel, tier.Labels)
 }
 
 func validateNetworkPolicy(structLevel validator.StructLevel) {
-	np := structLevel.Current().Interface().(api.NetworkPolicy)
+	validateNetworkPolicyObj(structLevel, structLevel.Current().Interface().(api.NetworkPolicy))
+}
+
+func validateStagedNetworkPolicy(structLevel validator.StructLevel) {
+	// A staged policy is subject to the same rules as the policy that it would be promoted to.
+	staged := structLevel.Current().Interface().(api.StagedNetworkPolicy)
+	_, np := api.ConvertStagedPolicyToEnforced(&staged)
+	validateNetworkPolicyObj(structLevel, *np)
+}
+
+func validateNetworkPolicyObj(structLevel validator.StructLevel, np api.NetworkPolicy) {
 	spec := np.Spec
 
 	// Check (and disallow) any repeats in Types field.
@@ -1706,6 +1886,12 @@ func validateNetworkPolicy(structLevel validator.StructLevel) {
 				reason("not allowed in ingress rule destination"), "",
 			)
 		}
+		if len(r.Destination.Domains) != 0 {
+			structLevel.ReportError(
+				reflect.ValueOf(r.Destination.Domains), "Domains", "",
+				reason("not allowed in ingress rules"), "",
+			)
+		}
 	}
 
 	// Check that the selector doesn't have the global() selector which is only
@@ -1763,8 +1949,27 @@ func validateGlobalNetworkSet(structLevel validator.StructLevel) {
 	}
 }
 
+func rulesUseReject(rules []api.Rule) bool {
+	for _, r := range rules {
+		if r.Action == api.ActionReject {
+			return true
+		}
+	}
+	return false
+}
+
 func validateGlobalNetworkPolicy(structLevel validator.StructLevel) {
-	gnp := structLevel.Current().Interface().(api.GlobalNetworkPolicy)
+	validateGlobalNetworkPolicyObj(structLevel, structLevel.Current().Interface().(api.GlobalNetworkPolicy))
+}
+
+func validateStagedGlobalNetworkPolicy(structLevel validator.StructLevel) {
+	// A staged policy is subject to the same rules as the policy that it would be promoted to.
+	staged := structLevel.Current().Interface().(api.StagedGlobalNetworkPolicy)
+	_, gnp := api.ConvertStagedGlobalPolicyToEnforced(&staged)
+	validateGlobalNetworkPolicyObj(structLevel, *gnp)
+}
+
+func validateGlobalNetworkPolicyObj(structLevel validator.StructLevel, gnp api.GlobalNetworkPolicy) {
 	spec := gnp.Spec
 
 	// Check the name is within the max length.
@@ -1817,6 +2022,12 @@ func validateGlobalNetworkPolicy(structLevel validator.StructLevel) {
 			"PolicySpec.ApplyOnForward", "", reason("ApplyOnForward must be true if either PreDNAT or DoNotTrack is true, for a given PolicySpec"), "")
 	}
 
+	// Replies to rejected packets can only be sent from the normal policy path.
+	if (spec.DoNotTrack || spec.PreDNAT) && (rulesUseReject(spec.Ingress) || rulesUseReject(spec.Egress)) {
+		structLevel.ReportError(reflect.ValueOf(spec.Ingress),
+			"PolicySpec.Rules", "", reason("Reject action cannot be used if either PreDNAT or DoNotTrack is true, for a given PolicySpec"), "")
+	}
+
 	// Check (and disallow) any repeats in Types field.
 	mp := map[api.PolicyType]bool{}
 	for _, t := range spec.Types {
@@ -1852,6 +2063,12 @@ func validateGlobalNetworkPolicy(structLevel validator.StructLevel) {
 				reason("not allowed in ingress rule destination"), "",
 			)
 		}
+		if len(r.Destination.Domains) != 0 {
+			structLevel.ReportError(
+				reflect.ValueOf(r.Destination.Domains), "Domains", "",
+				reason("not allowed in ingress rules"), "",
+			)
+		}
 	}
 
 	// If a ServiceSelector is specified by name, we also need a namespace. At a global scope,
@@ -2089,6 +2306,13 @@ func validateHealthTimeoutOverride(structLevel validator.StructLevel) {
 	}
 }
 
+func validateScheduleWindow(structLevel validator.StructLevel) {
+	w := structLevel.Current().Interface().(api.ScheduleWindow)
+	if w.Duration.Duration <= 0 {
+		structLevel.ReportError(reflect.ValueOf(w.Duration), "ScheduleWindow.Duration", "", reason("duration should be positive"), "")
+	}
+}
+
 func isCommunityDefined(community string, communityKVPairs []api.Community) bool {
 	for _, val := range communityKVPairs {
 		if val.Name == community {
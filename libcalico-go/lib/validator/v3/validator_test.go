@@ -169,6 +169,7 @@ func init() {
 		Entry("should accept allow action", api.Rule{Action: "Allow"}, true),
 		Entry("should accept deny action", api.Rule{Action: "Deny"}, true),
 		Entry("should accept log action", api.Rule{Action: "Log"}, true),
+		Entry("should accept reject action", api.Rule{Action: "Reject"}, true),
 		Entry("should reject unknown action", api.Rule{Action: "unknown"}, false),
 		Entry("should reject unknown action", api.Rule{Action: "allowfoo"}, false),
 		Entry("should reject rule with no action", api.Rule{}, false),
@@ -1208,6 +1209,16 @@ func init() {
 		Entry("should reject ICMP with code and no type", api.ICMPFields{Code: &V0}, false),
 		Entry("should reject ICMP with type too high", api.ICMPFields{Type: &V255}, false),
 		Entry("should reject ICMP with code too high", api.ICMPFields{Type: &V128, Code: &V256}, false),
+		Entry("should accept ICMP with a list of types",
+			api.ICMPFields{Types: []api.ICMPTypeCode{{Type: 0}, {Type: 3, Code: &V255}}}, true),
+		Entry("should accept ICMP with groups", api.ICMPFields{Groups: []api.ICMPGroup{api.ICMPGroupEchoFamily}}, true),
+		Entry("should reject ICMP with a listed type too high", api.ICMPFields{Types: []api.ICMPTypeCode{{Type: 255}}}, false),
+		Entry("should reject ICMP with a listed code too high",
+			api.ICMPFields{Types: []api.ICMPTypeCode{{Type: 3, Code: &V256}}}, false),
+		Entry("should reject ICMP with types and type",
+			api.ICMPFields{Type: &V0, Types: []api.ICMPTypeCode{{Type: 8}}}, false),
+		Entry("should reject ICMP with groups and type",
+			api.ICMPFields{Type: &V0, Groups: []api.ICMPGroup{api.ICMPGroupPMTU}}, false),
 
 		// (API) Rule
 		Entry("should accept Rule with protocol SCTP and no other config",
@@ -1355,6 +1366,45 @@ func init() {
 					Type: &V0,
 				},
 			}, true),
+		Entry("should accept Rule with NeighborDiscovery group for icmpv6",
+			api.Rule{
+				Action:   "Allow",
+				Protocol: protocolFromString("ICMPv6"),
+				ICMP: &api.ICMPFields{
+					Groups: []api.ICMPGroup{api.ICMPGroupNeighborDiscovery},
+				},
+			}, true),
+		Entry("should reject Rule with NeighborDiscovery group for icmp",
+			api.Rule{
+				Action:   "Allow",
+				Protocol: protocolFromString("ICMP"),
+				NotICMP: &api.ICMPFields{
+					Groups: []api.ICMPGroup{api.ICMPGroupEchoFamily, api.ICMPGroupNeighborDiscovery},
+				},
+			}, false),
+		Entry("should accept Rule with negated icmp types and protocol",
+			api.Rule{
+				Action:   "Allow",
+				Protocol: protocolFromString("ICMP"),
+				NotICMP: &api.ICMPFields{
+					Types: []api.ICMPTypeCode{{Type: 8}},
+				},
+			}, true),
+		Entry("should reject Rule with negated icmp types and no protocol",
+			api.Rule{
+				Action: "Allow",
+				NotICMP: &api.ICMPFields{
+					Types: []api.ICMPTypeCode{{Type: 8}},
+				},
+			}, false),
+		Entry("should reject Rule with an unknown ICMP group",
+			api.Rule{
+				Action:   "Allow",
+				Protocol: protocolFromString("ICMP"),
+				ICMP: &api.ICMPFields{
+					Groups: []api.ICMPGroup{"Bogus"},
+				},
+			}, false),
 		Entry("should reject Rule with mismatched ipversion for icmp",
 			api.Rule{
 				Action:    "Allow",
@@ -2113,12 +2163,93 @@ func init() {
 		Entry("should reject an invalid BPFHostNetworkedNATWithoutCTLB value 'BadVal'", api.FelixConfigurationSpec{BPFHostNetworkedNATWithoutCTLB: &bpfHostNetworkedNatBadVal}, false),
 		Entry("should reject an invalid BPFConnectTimeLoadBalancing value 'BadVal'", api.FelixConfigurationSpec{BPFConnectTimeLoadBalancing: &bpfConnectTimeLBBadVal}, false),
 
+		// Staged policy validation.
+		Entry("allow StagedGlobalNetworkPolicy with the Set action",
+			&api.StagedGlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec:       api.StagedGlobalNetworkPolicySpec{StagedAction: api.StagedActionSet},
+			}, true,
+		),
+		Entry("allow StagedNetworkPolicy with the Delete action",
+			&api.StagedNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns1"},
+				Spec:       api.StagedNetworkPolicySpec{StagedAction: api.StagedActionDelete},
+			}, true,
+		),
+		Entry("disallow StagedNetworkPolicy with an unknown action",
+			&api.StagedNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns1"},
+				Spec:       api.StagedNetworkPolicySpec{StagedAction: "Enforce"},
+			}, false,
+		),
+		Entry("disallow StagedGlobalNetworkPolicy with both PreDNAT and DoNotTrack",
+			&api.StagedGlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.StagedGlobalNetworkPolicySpec{
+					PreDNAT:        true,
+					DoNotTrack:     true,
+					ApplyOnForward: true,
+				},
+			}, false,
+		),
+		Entry("disallow StagedNetworkPolicy with an invalid name",
+			&api.StagedNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "tHiNg", Namespace: "ns1"}}, false,
+		),
+
 		// GlobalNetworkPolicy validation.
 		Entry("disallow name with invalid character", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "t~!s.h.i.ng"}}, false),
 		Entry("disallow name with mixed case characters", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "tHiNg"}}, false),
 		Entry("allow valid name", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing"}}, true),
 		Entry("disallow k8s policy name", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "knp.default.thing"}}, false),
 		Entry("disallow name with dot", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "t.h.i.ng"}}, false),
+		Entry("should accept GlobalNetworkPolicy with a schedule",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					Schedule: &api.PolicySchedule{
+						TimeZone: "Europe/London",
+						Windows:  []api.ScheduleWindow{{Start: "0 2 * * 1-5", Duration: v1.Duration{Duration: 2 * time.Hour}}},
+					},
+				},
+			}, true,
+		),
+		Entry("should reject a schedule without windows",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec:       api.GlobalNetworkPolicySpec{Schedule: &api.PolicySchedule{}},
+			}, false,
+		),
+		Entry("should reject a schedule with a bad cron expression",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "default"},
+				Spec: api.NetworkPolicySpec{
+					Schedule: &api.PolicySchedule{
+						Windows: []api.ScheduleWindow{{Start: "0 25 * * *", Duration: v1.Duration{Duration: time.Hour}}},
+					},
+				},
+			}, false,
+		),
+		Entry("should reject a schedule with an unknown time zone",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "default"},
+				Spec: api.NetworkPolicySpec{
+					Schedule: &api.PolicySchedule{
+						TimeZone: "Mars/Olympus_Mons",
+						Windows:  []api.ScheduleWindow{{Start: "0 2 * * *", Duration: v1.Duration{Duration: time.Hour}}},
+					},
+				},
+			}, false,
+		),
+		Entry("should reject a schedule window without a duration",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "default"},
+				Spec: api.NetworkPolicySpec{
+					Schedule: &api.PolicySchedule{
+						Windows: []api.ScheduleWindow{{Start: "0 2 * * *"}},
+					},
+				},
+			}, false,
+		),
 		Entry("should reject GlobalNetworkPolicy with both PreDNAT and DoNotTrack",
 			&api.GlobalNetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -2148,6 +2279,24 @@ func init() {
 				},
 			}, true,
 		),
+		Entry("should reject pre-DNAT GlobalNetworkPolicy with a Reject rule",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					PreDNAT:        true,
+					ApplyOnForward: true,
+					Ingress:        []api.Rule{{Action: "Reject"}},
+				},
+			}, false,
+		),
+		Entry("should accept GlobalNetworkPolicy with a Reject rule",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					Ingress: []api.Rule{{Action: "Reject"}},
+				},
+			}, true,
+		),
 		Entry("should reject pre-DNAT GlobalNetworkPolicy egress rules",
 			&api.GlobalNetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -2453,6 +2602,34 @@ func init() {
 			Spec: api.TierSpec{
 				Order: &tierOrder,
 			}}, true),
+		Entry("Tier: allow a delegation to a group", &api.Tier{
+			ObjectMeta: v1.ObjectMeta{Name: "foo"},
+			Spec: api.TierSpec{
+				Order: &tierOrder,
+				Delegations: []api.TierDelegation{{
+					Groups:         []string{"team-a"},
+					Namespaces:     []string{"team-a"},
+					PolicySelector: "owner == 'team-a'",
+				}},
+			}}, true),
+		Entry("Tier: disallow a delegation without users or groups", &api.Tier{
+			ObjectMeta: v1.ObjectMeta{Name: "foo"},
+			Spec: api.TierSpec{
+				Order:       &tierOrder,
+				Delegations: []api.TierDelegation{{Namespaces: []string{"team-a"}}},
+			}}, false),
+		Entry("Tier: disallow a delegation with an invalid namespace", &api.Tier{
+			ObjectMeta: v1.ObjectMeta{Name: "foo"},
+			Spec: api.TierSpec{
+				Order:       &tierOrder,
+				Delegations: []api.TierDelegation{{Users: []string{"alice"}, Namespaces: []string{"Team_A"}}},
+			}}, false),
+		Entry("Tier: disallow a delegation with an invalid selector", &api.Tier{
+			ObjectMeta: v1.ObjectMeta{Name: "foo"},
+			Spec: api.TierSpec{
+				Order:       &tierOrder,
+				Delegations: []api.TierDelegation{{Users: []string{"alice"}, PolicySelector: "owner =="}},
+			}}, false),
 		Entry("Tier: disallow dot in name", &api.Tier{
 			ObjectMeta: v1.ObjectMeta{Name: "fo.o"},
 			Spec: api.TierSpec{
@@ -2763,6 +2940,129 @@ func init() {
 				},
 			}, false,
 		),
+		Entry("allow Domains in an egress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com", "*.example.org"},
+							},
+						},
+					},
+				},
+			}, true,
+		),
+		Entry("disallow an invalid domain in an egress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"www.*.example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains in an egress rule source",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Source: api.EntityRule{
+								Domains: []string{"example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains in an ingress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Ingress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains combined with Nets",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com"},
+								Nets:    []string{"10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains combined with a Selector",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains:  []string{"example.com"},
+								Selector: "has(foo)",
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains in a GlobalNetworkPolicy ingress rule",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					Ingress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("allow Domains in a GlobalNetworkSet",
+			&api.GlobalNetworkSet{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkSetSpec{
+					Domains: []string{"*.example.com"},
+				},
+			}, true,
+		),
+		Entry("disallow an invalid domain in a NetworkSet",
+			&api.NetworkSet{
+				ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "default"},
+				Spec: api.NetworkSetSpec{
+					Domains: []string{"bad_domain!"},
+				},
+			}, false,
+		),
 		Entry("allow a Service match in an ingress rule source",
 			&api.NetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -3115,6 +3415,59 @@ func init() {
 			&api.HTTPMatch{Methods: []string{"GET", "GET", "Foo"}},
 			false,
 		),
+		Entry("allow HTTP Headers with permitted match clauses",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{
+				{Name: "x-tenant", Exact: "a"},
+				{Name: "X-Version", Prefix: "v1."},
+				{Name: "user-agent", Regex: "curl/.*"},
+				{Name: "authorization", Present: true},
+			}},
+			true,
+		),
+		Entry("disallow HTTP Header with no match clause",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "x-tenant"}}},
+			false,
+		),
+		Entry("disallow HTTP Header with several match clauses",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "x-tenant", Exact: "a", Present: true}}},
+			false,
+		),
+		Entry("disallow HTTP Header with invalid name",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "x tenant", Exact: "a"}}},
+			false,
+		),
+		Entry("disallow HTTP Header with invalid regex",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "x-tenant", Regex: "a("}}},
+			false,
+		),
+		Entry("allow HTTP Hosts",
+			&api.HTTPMatch{Hosts: []string{"example.com", "*.example.com", "localhost"}},
+			true,
+		),
+		Entry("disallow invalid HTTP Host",
+			&api.HTTPMatch{Hosts: []string{"example.com:80"}},
+			false,
+		),
+		Entry("allow gRPC match",
+			&api.HTTPMatch{GRPC: &api.GRPCMatch{Service: "helloworld.Greeter", Methods: []string{"SayHello"}}},
+			true,
+		),
+		Entry("allow gRPC match on any service",
+			&api.HTTPMatch{GRPC: &api.GRPCMatch{}},
+			true,
+		),
+		Entry("disallow gRPC match with invalid service",
+			&api.HTTPMatch{GRPC: &api.GRPCMatch{Service: "helloworld/Greeter"}},
+			false,
+		),
+		Entry("disallow gRPC match with invalid method",
+			&api.HTTPMatch{GRPC: &api.GRPCMatch{Methods: []string{"Say.Hello"}}},
+			false,
+		),
+		Entry("disallow gRPC match with duplicate methods",
+			&api.HTTPMatch{GRPC: &api.GRPCMatch{Methods: []string{"SayHello", "SayHello"}}},
+			false,
+		),
 		Entry("should not accept an invalid IP address",
 			api.FelixConfigurationSpec{NATOutgoingAddress: bad_ipv4_1}, false,
 		),
@@ -3230,6 +3583,40 @@ func init() {
 		Entry("should accept empty host endpoint auto create",
 			api.NodeControllerConfig{HostEndpoint: &api.AutoHostEndpointConfig{}}, true,
 		),
+		Entry("should accept host endpoint templates",
+			api.AutoHostEndpointConfig{
+				AutoCreate:                "Enabled",
+				CreateDefaultHostEndpoint: "Disabled",
+				Templates: []api.AutoHostEndpointTemplate{
+					{GenerateName: "eth0", NodeSelector: "has(edge)", InterfaceName: "eth0", Labels: map[string]string{"interface": "eth0"}},
+					{GenerateName: "storage", InterfaceCIDRs: []string{"10.10.0.0/16"}},
+				},
+			}, true,
+		),
+		Entry("should not accept invalid host endpoint default creation",
+			api.AutoHostEndpointConfig{CreateDefaultHostEndpoint: "Totally"}, false,
+		),
+		Entry("should not accept duplicate host endpoint template names",
+			api.AutoHostEndpointConfig{Templates: []api.AutoHostEndpointTemplate{
+				{GenerateName: "eth0", InterfaceName: "eth0"},
+				{GenerateName: "eth0", InterfaceName: "eth1"},
+			}}, false,
+		),
+		Entry("should not accept a host endpoint template named like the default host endpoint",
+			api.AutoHostEndpointConfig{Templates: []api.AutoHostEndpointTemplate{{GenerateName: "auto-hep", InterfaceName: "*"}}}, false,
+		),
+		Entry("should not accept a host endpoint template without an interface or CIDRs",
+			api.AutoHostEndpointConfig{Templates: []api.AutoHostEndpointTemplate{{GenerateName: "eth0"}}}, false,
+		),
+		Entry("should not accept a host endpoint template with an invalid node selector",
+			api.AutoHostEndpointConfig{Templates: []api.AutoHostEndpointTemplate{{GenerateName: "eth0", InterfaceName: "eth0", NodeSelector: "has("}}}, false,
+		),
+		Entry("should not accept a host endpoint template with an invalid CIDR",
+			api.AutoHostEndpointConfig{Templates: []api.AutoHostEndpointTemplate{{GenerateName: "eth0", InterfaceCIDRs: []string{"10.0.0.0/33"}}}}, false,
+		),
+		Entry("should not accept a host endpoint template with invalid labels",
+			api.AutoHostEndpointConfig{Templates: []api.AutoHostEndpointTemplate{{GenerateName: "eth0", InterfaceName: "eth0", Labels: map[string]string{"a b": "c"}}}}, false,
+		),
 		Entry("should accept valid reconciliation period on policy",
 			api.PolicyControllerConfig{ReconcilerPeriod: &v1.Duration{Duration: time.Second * 330}}, true,
 		),
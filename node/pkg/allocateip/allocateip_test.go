@@ -1515,6 +1515,16 @@ func (c shimClient) GlobalNetworkPolicies() client.GlobalNetworkPolicyInterface
 	return c.client.GlobalNetworkPolicies()
 }
 
+// StagedNetworkPolicies returns an interface for managing staged policy resources.
+func (c shimClient) StagedNetworkPolicies() client.StagedNetworkPolicyInterface {
+	return c.client.StagedNetworkPolicies()
+}
+
+// StagedGlobalNetworkPolicies returns an interface for managing staged policy resources.
+func (c shimClient) StagedGlobalNetworkPolicies() client.StagedGlobalNetworkPolicyInterface {
+	return c.client.StagedGlobalNetworkPolicies()
+}
+
 // IPPools returns an interface for managing IP pool resources.
 func (c shimClient) IPPools() client.IPPoolInterface {
 	return c.client.IPPools()
@@ -1769,3 +1769,674 @@ var _ = Describe("with server requiring TLS", func() {
 	})
 
 })
+
+// switchableCache is a ResumableBreadcrumbProvider that can be switched to a different snapcache.Cache, simulating
+// a client reconnecting to a different Typha instance.
+type switchableCache struct {
+	lock  sync.Mutex
+	cache *snapcache.Cache
+}
+
+func (s *switchableCache) current() *snapcache.Cache {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.cache
+}
+
+func (s *switchableCache) SwitchTo(cache *snapcache.Cache) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cache = cache
+}
+
+func (s *switchableCache) CurrentBreadcrumb() *snapcache.Breadcrumb {
+	return s.current().CurrentBreadcrumb()
+}
+
+func (s *switchableCache) ID() string {
+	return s.current().ID()
+}
+
+func (s *switchableCache) BreadcrumbBySequenceNumber(seqNo uint64) *snapcache.Breadcrumb {
+	return s.current().BreadcrumbBySequenceNumber(seqNo)
+}
+
+var _ = Describe("With an in-process Server and a client that resumes after reconnecting", func() {
+	var (
+		cacheCxt       context.Context
+		cacheCancel    context.CancelFunc
+		cacheA, cacheB *snapcache.Cache
+		provider       *switchableCache
+		server         *syncserver.Server
+		serverCancel   context.CancelFunc
+		options        *syncclient.Options
+		client         *syncclient.SyncerClient
+		clientCancel   context.CancelFunc
+		recorder       *StateRecorder
+		recorderCancel context.CancelFunc
+	)
+
+	newCache := func() *snapcache.Cache {
+		c := snapcache.New(snapcache.Config{
+			MaxBatchSize:   10,
+			WakeUpInterval: 50 * time.Millisecond,
+		})
+		c.Start(cacheCxt)
+		return c
+	}
+
+	numResumed := func() (float64, error) {
+		return getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_connections_resumed")
+	}
+
+	BeforeEach(func() {
+		cacheCxt, cacheCancel = context.WithCancel(context.Background())
+		cacheA = newCache()
+		cacheB = newCache()
+		provider = &switchableCache{cache: cacheA}
+
+		server = syncserver.New(
+			map[syncproto.SyncerType]syncserver.BreadcrumbProvider{syncproto.SyncerTypeFelix: provider},
+			syncserver.Config{
+				PingInterval: 10 * time.Second,
+				Port:         syncserver.PortRandom,
+				DropInterval: 50 * time.Millisecond,
+			})
+		var serverCxt context.Context
+		serverCxt, serverCancel = context.WithCancel(context.Background())
+		server.Start(serverCxt)
+		options = &syncclient.Options{ResumeOnReconnect: true}
+	})
+
+	JustBeforeEach(func() {
+		recorder = NewRecorder()
+		var recorderCxt context.Context
+		recorderCxt, recorderCancel = context.WithCancel(context.Background())
+		go recorder.Loop(recorderCxt)
+		client = syncclient.New(
+			discovery.New(discovery.WithAddrOverride(fmt.Sprintf("127.0.0.1:%d", server.Port()))),
+			"test-version",
+			"test-host",
+			"test-info",
+			recorder,
+			options,
+		)
+		var clientCxt context.Context
+		clientCxt, clientCancel = context.WithCancel(context.Background())
+		Expect(client.Start(clientCxt)).To(Succeed())
+
+		cacheA.OnUpdates([]api.Update{configFoobarBazzBiff, configFoobar2BazzBiff})
+		cacheA.OnStatusUpdated(api.InSync)
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+		Eventually(recorder.KVs).Should(Equal(map[string]api.Update{
+			"/calico/v1/config/foobar":  configFoobarBazzBiff,
+			"/calico/v1/config/foobar2": configFoobar2BazzBiff,
+		}))
+	})
+
+	AfterEach(func() {
+		clientCancel()
+		client.Finished.Wait()
+		recorderCancel()
+		serverCancel()
+		server.Finished.Wait()
+		cacheCancel()
+	})
+
+	It("should resume from its last position after the connection is dropped", func() {
+		resumedBefore, err := numResumed()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(server.TerminateRandomConnection(log.WithField("test", "resume"), "test")).To(BeTrue())
+		Eventually(numResumed).Should(Equal(resumedBefore + 1))
+		Eventually(server.NumActiveConnections).Should(Equal(1))
+
+		// Deltas should continue to flow on the new connection.
+		cacheA.OnUpdates([]api.Update{configFoobarDeleted})
+		Eventually(recorder.KVs).Should(Equal(map[string]api.Update{
+			"/calico/v1/config/foobar2": configFoobar2BazzBiff,
+		}))
+		Expect(recorder.Status()).To(Equal(api.InSync))
+	})
+
+	Describe("after switching to a different cache", func() {
+		BeforeEach(func() {
+			// The server's binary snapshot is tied to the cache that it was generated from, use a streamed
+			// snapshot so that we see the new cache straight away.
+			options.DisableDecoderRestart = true
+		})
+
+		It("should take a new snapshot and delete stale keys after reconnecting", func() {
+			cacheB.OnUpdates([]api.Update{configFoobar2BazzBiff})
+			cacheB.OnStatusUpdated(api.InSync)
+			Eventually(func() api.SyncStatus { return cacheB.CurrentBreadcrumb().SyncStatus }).Should(Equal(api.InSync))
+			provider.SwitchTo(cacheB)
+			resumedBefore, err := numResumed()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(server.TerminateRandomConnection(log.WithField("test", "resume"), "test")).To(BeTrue())
+			Eventually(recorder.KVs).Should(Equal(map[string]api.Update{
+				"/calico/v1/config/foobar2": configFoobar2BazzBiff,
+			}))
+			Eventually(server.NumActiveConnections).Should(Equal(1))
+			Expect(numResumed()).To(Equal(resumedBefore))
+
+			// Deltas from the new cache should be applied.
+			cacheB.OnUpdates([]api.Update{configFoobarBazzBiff})
+			Eventually(recorder.KVs).Should(Equal(map[string]api.Update{
+				"/calico/v1/config/foobar":  configFoobarBazzBiff,
+				"/calico/v1/config/foobar2": configFoobar2BazzBiff,
+			}))
+		})
+	})
+})
+
+var _ = Describe("With an in-process Server and clients that filter by node", func() {
+	var (
+		cacheCxt     context.Context
+		cacheCancel  context.CancelFunc
+		felixCache   *snapcache.Cache
+		tunnelCache  *snapcache.Cache
+		server       *syncserver.Server
+		serverCancel context.CancelFunc
+		cancelFuncs  []context.CancelFunc
+		clients      []*syncclient.SyncerClient
+	)
+
+	wepKey := func(host string) model.WorkloadEndpointKey {
+		return model.WorkloadEndpointKey{
+			Hostname:       host,
+			OrchestratorID: "k8s",
+			WorkloadID:     "default/pod-" + host,
+			EndpointID:     "eth0",
+		}
+	}
+	wep := func(host string) *model.WorkloadEndpoint {
+		mac, err := net.ParseMAC("01:02:03:04:05:06")
+		Expect(err).NotTo(HaveOccurred())
+		return &model.WorkloadEndpoint{
+			State:       "active",
+			Name:        "cali1234",
+			Mac:         &calinet.MAC{HardwareAddr: mac},
+			ProfileIDs:  []string{"kns.default"},
+			IPv4Nets:    []calinet.IPNet{calinet.MustParseCIDR("10.65.0.1/32")},
+			Labels:      map[string]string{"app": "test"},
+			Annotations: map[string]string{"some-big-annotation": "lots of data that remote nodes don't need"},
+		}
+	}
+	wepUpdate := func(host string, value *model.WorkloadEndpoint) api.Update {
+		return api.Update{
+			KVPair: model.KVPair{
+				Key:      wepKey(host),
+				Value:    value,
+				Revision: "1234",
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}
+	}
+	hostConfigUpdate := func(host, name, value string) api.Update {
+		return api.Update{
+			KVPair: model.KVPair{
+				Key:      model.HostConfigKey{Hostname: host, Name: name},
+				Value:    value,
+				Revision: "1235",
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}
+	}
+	nodeUpdate := func(name string) api.Update {
+		return api.Update{
+			KVPair: model.KVPair{
+				Key: model.ResourceKey{Name: name, Kind: libapiv3.KindNode},
+				Value: &libapiv3.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            name,
+						ResourceVersion: "1236",
+					},
+				},
+				Revision: "1236",
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}
+	}
+
+	var (
+		localWEP, remoteWEP, remoteWEPTrimmed                 api.Update
+		localConfig, remoteConfig, remoteVXLANConfig          api.Update
+		localNode, remoteNode                                 api.Update
+		allFelixKVs                                           map[string]api.Update
+		localWEPPath, remoteWEPPath, remoteConfigPath, vxPath string
+	)
+
+	BeforeEach(func() {
+		localWEP = wepUpdate("test-host", wep("test-host"))
+		remoteWEP = wepUpdate("other-host", wep("other-host"))
+		trimmed := wep("other-host")
+		trimmed.State = ""
+		trimmed.Mac = nil
+		trimmed.Annotations = nil
+		remoteWEPTrimmed = wepUpdate("other-host", trimmed)
+		localConfig = hostConfigUpdate("test-host", "LogSeverityScreen", "Debug")
+		remoteConfig = hostConfigUpdate("other-host", "LogSeverityScreen", "Debug")
+		remoteVXLANConfig = hostConfigUpdate("other-host", "IPv4VXLANTunnelAddr", "10.65.1.0")
+		localNode = nodeUpdate("test-host")
+		remoteNode = nodeUpdate("other-host")
+
+		localWEPPath = "/calico/v1/host/test-host/workload/k8s/default%2fpod-test-host/endpoint/eth0"
+		remoteWEPPath = "/calico/v1/host/other-host/workload/k8s/default%2fpod-other-host/endpoint/eth0"
+		remoteConfigPath = "/calico/v1/host/other-host/config/LogSeverityScreen"
+		vxPath = "/calico/v1/host/other-host/config/IPv4VXLANTunnelAddr"
+		allFelixKVs = map[string]api.Update{
+			"/calico/v1/config/foobar": configFoobarBazzBiff,
+			localWEPPath:               localWEP,
+			remoteWEPPath:              remoteWEP,
+			"/calico/v1/host/test-host/config/LogSeverityScreen": localConfig,
+			remoteConfigPath: remoteConfig,
+			vxPath:           remoteVXLANConfig,
+		}
+
+		cacheCxt, cacheCancel = context.WithCancel(context.Background())
+		felixCache = snapcache.New(snapcache.Config{
+			MaxBatchSize:   10,
+			WakeUpInterval: 50 * time.Millisecond,
+		})
+		felixCache.Start(cacheCxt)
+		tunnelCache = snapcache.New(snapcache.Config{
+			MaxBatchSize:   10,
+			WakeUpInterval: 50 * time.Millisecond,
+		})
+		tunnelCache.Start(cacheCxt)
+
+		felixCache.OnUpdates([]api.Update{
+			configFoobarBazzBiff, localWEP, remoteWEP, localConfig, remoteConfig, remoteVXLANConfig,
+		})
+		felixCache.OnStatusUpdated(api.InSync)
+		tunnelCache.OnUpdates([]api.Update{localNode, remoteNode})
+		tunnelCache.OnStatusUpdated(api.InSync)
+
+		server = syncserver.New(
+			map[syncproto.SyncerType]syncserver.BreadcrumbProvider{
+				syncproto.SyncerTypeFelix:              felixCache,
+				syncproto.SyncerTypeTunnelIPAllocation: tunnelCache,
+			},
+			syncserver.Config{
+				PingInterval: 10 * time.Second,
+				Port:         syncserver.PortRandom,
+				DropInterval: 50 * time.Millisecond,
+			})
+		var serverCxt context.Context
+		serverCxt, serverCancel = context.WithCancel(context.Background())
+		server.Start(serverCxt)
+	})
+
+	startClient := func(options *syncclient.Options) *StateRecorder {
+		recorder := NewRecorder()
+		recorderCxt, recorderCancel := context.WithCancel(context.Background())
+		go recorder.Loop(recorderCxt)
+		client := syncclient.New(
+			discovery.New(discovery.WithAddrOverride(fmt.Sprintf("127.0.0.1:%d", server.Port()))),
+			"test-version",
+			"test-host",
+			"test-info",
+			recorder,
+			options,
+		)
+		clientCxt, clientCancel := context.WithCancel(context.Background())
+		Expect(client.Start(clientCxt)).To(Succeed())
+		clients = append(clients, client)
+		cancelFuncs = append(cancelFuncs, clientCancel, recorderCancel)
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+		return recorder
+	}
+
+	AfterEach(func() {
+		for _, cancel := range cancelFuncs {
+			cancel()
+		}
+		for _, c := range clients {
+			c.Finished.Wait()
+		}
+		cancelFuncs = nil
+		clients = nil
+		serverCancel()
+		server.Finished.Wait()
+		cacheCancel()
+	})
+
+	It("should only send the felix KVs that the client's node needs", func() {
+		droppedBefore, err := getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_node_filter_kvs_dropped")
+		Expect(err).NotTo(HaveOccurred())
+		savedBefore, err := getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_node_filter_bytes_saved")
+		Expect(err).NotTo(HaveOccurred())
+
+		recorder := startClient(&syncclient.Options{FilterByNode: true})
+		expected := map[string]api.Update{}
+		for k, v := range allFelixKVs {
+			expected[k] = v
+		}
+		delete(expected, remoteConfigPath)
+		expected[remoteWEPPath] = remoteWEPTrimmed
+		Eventually(recorder.KVs).Should(Equal(expected))
+		Expect(getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_node_filter_kvs_dropped")).To(
+			Equal(droppedBefore + 1))
+		Expect(getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_node_filter_bytes_saved")).To(
+			BeNumerically(">", savedBefore))
+		expectPerSyncerGaugeValue(syncproto.SyncerTypeFelix, "typha_connections_node_filtered", 1)
+
+		// Deltas should be filtered in the same way.
+		remoteConfig2 := hostConfigUpdate("other-host", "LogSeverityScreen", "Info")
+		remoteConfig2.UpdateType = api.UpdateTypeKVUpdated
+		felixCache.OnUpdates([]api.Update{remoteConfig2, {
+			KVPair: model.KVPair{
+				Key:      wepKey("other-host"),
+				Revision: "1237",
+			},
+			UpdateType: api.UpdateTypeKVDeleted,
+		}})
+		delete(expected, remoteWEPPath)
+		Eventually(recorder.KVs).Should(Equal(expected))
+		Consistently(recorder.KVs, "200ms").Should(Equal(expected))
+		Expect(getPerSyncerCounter(syncproto.SyncerTypeFelix, "typha_node_filter_kvs_dropped")).To(
+			Equal(droppedBefore + 2))
+	})
+
+	It("should send all KVs to a client that doesn't ask for filtering", func() {
+		recorder := startClient(&syncclient.Options{})
+		Eventually(recorder.KVs).Should(Equal(allFelixKVs))
+		expectPerSyncerGaugeValue(syncproto.SyncerTypeFelix, "typha_connections_node_filtered", 0)
+	})
+
+	It("should only send the client's own Node to a filtered tunnel IP allocation client", func() {
+		recorder := startClient(&syncclient.Options{
+			SyncerType:   syncproto.SyncerTypeTunnelIPAllocation,
+			FilterByNode: true,
+		})
+		Eventually(recorder.KVs).Should(Equal(map[string]api.Update{
+			"/calico/resources/v3/projectcalico.org/nodes/test-host": localNode,
+		}))
+	})
+})
+
+var _ = Describe("With an in-process Server and clients that prefer zstd compression", func() {
+	var (
+		cacheCxt     context.Context
+		cacheCancel  context.CancelFunc
+		felixCache   *snapcache.Cache
+		server       *syncserver.Server
+		serverCancel context.CancelFunc
+		cancelFuncs  []context.CancelFunc
+		clients      []*syncclient.SyncerClient
+		expectedKVs  map[string]api.Update
+	)
+
+	wepUpdate := func(i int) (string, api.Update) {
+		key := model.WorkloadEndpointKey{
+			Hostname:       fmt.Sprintf("host-%d", i%10),
+			OrchestratorID: "k8s",
+			WorkloadID:     fmt.Sprintf("default/pod-%d", i),
+			EndpointID:     "eth0",
+		}
+		path, err := model.KeyToDefaultPath(key)
+		Expect(err).NotTo(HaveOccurred())
+		return path, api.Update{
+			KVPair: model.KVPair{
+				Key: key,
+				Value: &model.WorkloadEndpoint{
+					State:      "active",
+					Name:       fmt.Sprintf("cali%08d", i),
+					ProfileIDs: []string{"kns.default", "ksa.default.default"},
+					IPv4Nets:   []calinet.IPNet{calinet.MustParseCIDR(fmt.Sprintf("10.65.%d.%d/32", i/256, i%256))},
+					Labels: map[string]string{
+						"app":                              fmt.Sprintf("app-%d", i%7),
+						"projectcalico.org/namespace":      "default",
+						"projectcalico.org/orchestrator":   "k8s",
+						"projectcalico.org/serviceaccount": "default",
+					},
+				},
+				Revision: fmt.Sprint(1000 + i),
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}
+	}
+
+	startServer := func(config syncserver.Config) {
+		config.PingInterval = 10 * time.Second
+		config.Port = syncserver.PortRandom
+		config.DropInterval = 50 * time.Millisecond
+		server = syncserver.New(
+			map[syncproto.SyncerType]syncserver.BreadcrumbProvider{
+				syncproto.SyncerTypeFelix: felixCache,
+			},
+			config,
+		)
+		var serverCxt context.Context
+		serverCxt, serverCancel = context.WithCancel(context.Background())
+		server.Start(serverCxt)
+	}
+
+	BeforeEach(func() {
+		cacheCxt, cacheCancel = context.WithCancel(context.Background())
+		felixCache = snapcache.New(snapcache.Config{
+			MaxBatchSize:   100,
+			WakeUpInterval: 50 * time.Millisecond,
+		})
+		felixCache.Start(cacheCxt)
+
+		expectedKVs = map[string]api.Update{}
+		var updates []api.Update
+		for i := 0; i < 500; i++ {
+			path, upd := wepUpdate(i)
+			expectedKVs[path] = upd
+			updates = append(updates, upd)
+		}
+		felixCache.OnUpdates(updates)
+		felixCache.OnStatusUpdated(api.InSync)
+		Eventually(func() int {
+			return felixCache.CurrentBreadcrumb().KVs.Len()
+		}).Should(Equal(len(updates)))
+	})
+
+	startClient := func(options *syncclient.Options) *StateRecorder {
+		recorder := NewRecorder()
+		recorderCxt, recorderCancel := context.WithCancel(context.Background())
+		go recorder.Loop(recorderCxt)
+		client := syncclient.New(
+			discovery.New(discovery.WithAddrOverride(fmt.Sprintf("127.0.0.1:%d", server.Port()))),
+			"test-version",
+			"test-host",
+			"test-info",
+			recorder,
+			options,
+		)
+		clientCxt, clientCancel := context.WithCancel(context.Background())
+		Expect(client.Start(clientCxt)).To(Succeed())
+		clients = append(clients, client)
+		cancelFuncs = append(cancelFuncs, clientCancel, recorderCancel)
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+		return recorder
+	}
+
+	AfterEach(func() {
+		for _, cancel := range cancelFuncs {
+			cancel()
+		}
+		for _, c := range clients {
+			c.Finished.Wait()
+		}
+		cancelFuncs = nil
+		clients = nil
+		serverCancel()
+		server.Finished.Wait()
+		cacheCancel()
+	})
+
+	It("should send the snapshot and deltas using zstd with a dictionary", func() {
+		startServer(syncserver.Config{ZstdDictionarySize: 16384})
+		uncompressedBefore := getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_uncompressed")
+		compressedBefore := getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_compressed")
+
+		recorder := startClient(&syncclient.Options{PreferredCompression: syncproto.CompressionZstd})
+		Eventually(recorder.KVs).Should(Equal(expectedKVs))
+		Expect(getPerSyncerGauge(syncproto.SyncerTypeFelix, "typha_zstd_dictionary_bytes")).To(
+			BeNumerically(">", 0))
+
+		path, upd := wepUpdate(1000)
+		felixCache.OnUpdates([]api.Update{upd})
+		expectedKVs[path] = upd
+		Eventually(recorder.KVs).Should(Equal(expectedKVs))
+
+		uncompressed := getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_uncompressed") -
+			uncompressedBefore
+		compressed := getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_compressed") -
+			compressedBefore
+		Expect(compressed).To(BeNumerically(">", 0))
+		Expect(uncompressed / compressed).To(BeNumerically(">", 2))
+	})
+
+	It("should fall back to snappy if the server has zstd disabled", func() {
+		startServer(syncserver.Config{DisableZstd: true})
+		zstdBefore := getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_compressed")
+
+		recorder := startClient(&syncclient.Options{PreferredCompression: syncproto.CompressionZstd})
+		Eventually(recorder.KVs).Should(Equal(expectedKVs))
+		Expect(getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_compressed")).To(
+			Equal(zstdBefore))
+	})
+
+	It("should use snappy for a client that doesn't ask for zstd", func() {
+		startServer(syncserver.Config{ZstdDictionarySize: 16384})
+		zstdBefore := getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_compressed")
+
+		recorder := startClient(&syncclient.Options{})
+		Eventually(recorder.KVs).Should(Equal(expectedKVs))
+		Expect(getCompressionCounter(syncproto.CompressionZstd, "typha_client_bytes_compressed")).To(
+			Equal(zstdBefore))
+	})
+})
+
+// getCompressionCounter returns the value of one of the felix syncer's per-compression-algorithm counters.
+func getCompressionCounter(alg syncproto.CompressionAlgorithm, name string) float64 {
+	mfs, err := prometheus.DefaultGatherer.Gather()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+		for _, m := range mf.Metric {
+			labels := map[string]string{}
+			for _, l := range m.Label {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["syncer"] == string(syncproto.SyncerTypeFelix) && labels["compression"] == string(alg) {
+				return m.GetCounter().GetValue()
+			}
+		}
+	}
+	Fail("metric not found: " + name)
+	return 0
+}
+
+var _ = Describe("With an in-process Server, inspecting and draining connections", func() {
+	var (
+		cacheCxt     context.Context
+		cacheCancel  context.CancelFunc
+		felixCache   *snapcache.Cache
+		server       *syncserver.Server
+		serverCxt    context.Context
+		serverCancel context.CancelFunc
+		cancelFuncs  []context.CancelFunc
+		clients      []*syncclient.SyncerClient
+	)
+
+	BeforeEach(func() {
+		cacheCxt, cacheCancel = context.WithCancel(context.Background())
+		felixCache = snapcache.New(snapcache.Config{
+			MaxBatchSize:   10,
+			WakeUpInterval: 50 * time.Millisecond,
+		})
+		felixCache.Start(cacheCxt)
+		felixCache.OnUpdates([]api.Update{configFoobarBazzBiff})
+		felixCache.OnStatusUpdated(api.InSync)
+
+		server = syncserver.New(
+			map[syncproto.SyncerType]syncserver.BreadcrumbProvider{
+				syncproto.SyncerTypeFelix: felixCache,
+			},
+			syncserver.Config{
+				PingInterval: 10 * time.Second,
+				Port:         syncserver.PortRandom,
+				DropInterval: 50 * time.Millisecond,
+			})
+		serverCxt, serverCancel = context.WithCancel(context.Background())
+		server.Start(serverCxt)
+
+		for i := 0; i < 4; i++ {
+			recorder := NewRecorder()
+			recorderCxt, recorderCancel := context.WithCancel(context.Background())
+			go recorder.Loop(recorderCxt)
+			options := &syncclient.Options{}
+			if i == 0 {
+				options.PreferredCompression = syncproto.CompressionZstd
+			}
+			client := syncclient.New(
+				discovery.New(discovery.WithAddrOverride(fmt.Sprintf("127.0.0.1:%d", server.Port()))),
+				"test-version",
+				fmt.Sprintf("host-%d", i),
+				"test-info",
+				recorder,
+				options,
+			)
+			clientCxt, clientCancel := context.WithCancel(context.Background())
+			Expect(client.Start(clientCxt)).To(Succeed())
+			clients = append(clients, client)
+			cancelFuncs = append(cancelFuncs, clientCancel, recorderCancel)
+			Eventually(recorder.Status).Should(Equal(api.InSync))
+		}
+	})
+
+	AfterEach(func() {
+		for _, cancel := range cancelFuncs {
+			cancel()
+		}
+		for _, c := range clients {
+			c.Finished.Wait()
+		}
+		cancelFuncs = nil
+		clients = nil
+		serverCancel()
+		server.Finished.Wait()
+		cacheCancel()
+	})
+
+	It("should list the connected clients", func() {
+		conns := server.Connections()
+		Expect(conns).To(HaveLen(4))
+		for i, conn := range conns {
+			Expect(conn.Hostname).To(Equal(fmt.Sprintf("host-%d", i)))
+			Expect(conn.Version).To(Equal("test-version"))
+			Expect(conn.SyncerType).To(Equal(syncproto.SyncerTypeFelix))
+			Expect(conn.Streaming).To(BeTrue())
+			Expect(conn.BytesSent).To(BeNumerically(">", 0))
+			Expect(conn.FallBehindSecs).To(BeNumerically("<", 10))
+		}
+		Expect(conns[0].Compression).To(Equal(syncproto.CompressionZstd))
+		Expect(conns[1].Compression).To(Equal(syncproto.CompressionSnappy))
+	})
+
+	It("should drop a specific connection", func() {
+		id := server.Connections()[2].ID
+		Expect(server.TerminateConnection(log.WithField("test", true), id, "test")).To(BeTrue())
+		clients[2].Finished.Wait()
+		Eventually(server.NumActiveConnections).Should(Equal(3))
+		for _, conn := range server.Connections() {
+			Expect(conn.ID).NotTo(Equal(id))
+		}
+		Expect(server.TerminateConnection(log.WithField("test", true), id, "test")).To(BeFalse())
+	})
+
+	It("should drain a percentage of the connections", func() {
+		Expect(server.DrainConnections(serverCxt, 50, "test")).To(Equal(2))
+		Eventually(server.NumActiveConnections).Should(Equal(2))
+		Consistently(server.NumActiveConnections, "200ms").Should(Equal(2))
+	})
+})
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package admin implements Typha's admin API, an HTTP endpoint that lists the connected clients and allows
+// an operator to drain them.  Every request must carry the token from the configured token file as a bearer
+// token:
+//
+//	GET  /connections               lists the current connections.
+//	POST /connections/{id}/drain    drops the connection with the given ID.
+//	POST /drain?percent=N           gradually drops N% of the current connections.
+//
+// Dropped clients reconnect, usually to another Typha instance, in the same way as when Typha shuts down
+// gracefully.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/typha/pkg/syncserver"
+)
+
+// ConnectionManager is the part of the syncserver.Server that the admin API uses.
+type ConnectionManager interface {
+	Connections() []syncserver.ConnectionInfo
+	TerminateConnection(logCtx *log.Entry, connID uint64, reason string) bool
+	DrainConnections(cxt context.Context, percent float64, reason string) int
+}
+
+type Server struct {
+	connManager ConnectionManager
+	addr        string
+	tokenFile   string
+	mux         *http.ServeMux
+
+	// cxt is the context for background drains.  It outlives the request that started the drain.
+	cxt context.Context
+}
+
+func New(connManager ConnectionManager, host string, port int, tokenFile string) *Server {
+	s := &Server{
+		connManager: connManager,
+		addr:        net.JoinHostPort(host, strconv.Itoa(port)),
+		tokenFile:   tokenFile,
+		mux:         http.NewServeMux(),
+		cxt:         context.Background(),
+	}
+	s.mux.HandleFunc("GET /connections", s.handleListConnections)
+	s.mux.HandleFunc("POST /connections/{id}/drain", s.handleDrainConnection)
+	s.mux.HandleFunc("POST /drain", s.handleDrain)
+	return s
+}
+
+// Start starts serving the admin API in a background goroutine.  The server and any drains that are in
+// progress stop when the context is canceled.
+func (s *Server) Start(cxt context.Context) {
+	s.cxt = cxt
+	httpServer := &http.Server{
+		Addr:              s.addr,
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-cxt.Done()
+		_ = httpServer.Close()
+	}()
+	go func() {
+		log.WithField("addr", s.addr).Info("Starting admin API server.")
+		err := httpServer.ListenAndServe()
+		if cxt.Err() == nil {
+			log.WithError(err).Fatal("Admin API server failed.")
+		}
+	}()
+}
+
+// ServeHTTP checks the request's bearer token and then dispatches it.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if err := s.authenticate(req); err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"remoteAddr": req.RemoteAddr,
+			"path":       req.URL.Path,
+		}).Warn("Rejecting unauthenticated admin API request.")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	s.mux.ServeHTTP(w, req)
+}
+
+// authenticate checks the request's bearer token against the token file.  The file is re-read for each
+// request so that the token can be rotated without restarting Typha.
+func (s *Server) authenticate(req *http.Request) error {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return fmt.Errorf("missing bearer token")
+	}
+	expected, err := os.ReadFile(s.tokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read token file: %w", err)
+	}
+	expectedToken := strings.TrimSpace(string(expected))
+	if expectedToken == "" {
+		return fmt.Errorf("token file is empty")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(expectedToken)) != 1 {
+		return fmt.Errorf("incorrect bearer token")
+	}
+	return nil
+}
+
+func (s *Server) handleListConnections(w http.ResponseWriter, req *http.Request) {
+	writeJSON(w, s.connManager.Connections())
+}
+
+func (s *Server) handleDrainConnection(w http.ResponseWriter, req *http.Request) {
+	connID, err := strconv.ParseUint(req.PathValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid connection ID", http.StatusBadRequest)
+		return
+	}
+	logCxt := log.WithField("remoteAddr", req.RemoteAddr)
+	if !s.connManager.TerminateConnection(logCxt, connID, "drained via admin API") {
+		http.Error(w, "no such connection", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, drainResponse{NumDraining: 1})
+}
+
+func (s *Server) handleDrain(w http.ResponseWriter, req *http.Request) {
+	percent, err := strconv.ParseFloat(req.URL.Query().Get("percent"), 64)
+	if err != nil || percent <= 0 || percent > 100 {
+		http.Error(w, "percent must be a number in the range (0, 100]", http.StatusBadRequest)
+		return
+	}
+	log.WithFields(log.Fields{
+		"remoteAddr": req.RemoteAddr,
+		"percent":    percent,
+	}).Info("Drain requested via admin API.")
+	numDraining := s.connManager.DrainConnections(s.cxt, percent, "drained via admin API")
+	writeJSON(w, drainResponse{NumDraining: numDraining})
+}
+
+type drainResponse struct {
+	// NumDraining is the number of connections that will be dropped.
+	NumDraining int `json:"numDraining"`
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Warn("Failed to write admin API response.")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/typha/pkg/admin"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+	"github.com/projectcalico/calico/typha/pkg/syncserver"
+)
+
+var _ = Describe("Admin API", func() {
+	var (
+		connManager *mockConnManager
+		tokenFile   string
+		server      *admin.Server
+	)
+
+	BeforeEach(func() {
+		connManager = &mockConnManager{
+			conns: []syncserver.ConnectionInfo{
+				{ID: 1, Hostname: "node-1", SyncerType: syncproto.SyncerTypeFelix, BytesSent: 1234},
+				{ID: 2, Hostname: "node-2", SyncerType: syncproto.SyncerTypeBGP},
+			},
+		}
+		tokenFile = filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("s3cret\n"), 0600)).To(Succeed())
+		server = admin.New(connManager, "localhost", 0, tokenFile)
+	})
+
+	do := func(method, url, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("should reject requests without a token", func() {
+		Expect(do("GET", "/connections", "").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should reject requests with the wrong token", func() {
+		Expect(do("GET", "/connections", "wrong").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should reject all requests if the token file is empty", func() {
+		Expect(os.WriteFile(tokenFile, nil, 0600)).To(Succeed())
+		Expect(do("GET", "/connections", "s3cret").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should pick up a rotated token", func() {
+		Expect(os.WriteFile(tokenFile, []byte("n3w"), 0600)).To(Succeed())
+		Expect(do("GET", "/connections", "s3cret").Code).To(Equal(http.StatusUnauthorized))
+		Expect(do("GET", "/connections", "n3w").Code).To(Equal(http.StatusOK))
+	})
+
+	It("should list connections", func() {
+		rec := do("GET", "/connections", "s3cret")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		var conns []syncserver.ConnectionInfo
+		Expect(json.Unmarshal(rec.Body.Bytes(), &conns)).To(Succeed())
+		Expect(conns).To(Equal(connManager.conns))
+	})
+
+	It("should drain a specific connection", func() {
+		rec := do("POST", "/connections/2/drain", "s3cret")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(MatchJSON(`{"numDraining": 1}`))
+		Expect(connManager.terminated).To(Equal([]uint64{2}))
+	})
+
+	It("should return 404 when draining an unknown connection", func() {
+		Expect(do("POST", "/connections/3/drain", "s3cret").Code).To(Equal(http.StatusNotFound))
+		Expect(do("POST", "/connections/foo/drain", "s3cret").Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should drain a percentage of connections", func() {
+		rec := do("POST", "/drain?percent=50", "s3cret")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(MatchJSON(`{"numDraining": 1}`))
+		Expect(connManager.drainedPercent).To(Equal(50.0))
+	})
+
+	It("should reject an invalid percentage", func() {
+		Expect(do("POST", "/drain?percent=0", "s3cret").Code).To(Equal(http.StatusBadRequest))
+		Expect(do("POST", "/drain?percent=101", "s3cret").Code).To(Equal(http.StatusBadRequest))
+		Expect(do("POST", "/drain", "s3cret").Code).To(Equal(http.StatusBadRequest))
+		Expect(connManager.drainedPercent).To(BeZero())
+	})
+
+	It("should only allow POST for drains", func() {
+		Expect(do("GET", "/drain?percent=50", "s3cret").Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
+
+type mockConnManager struct {
+	conns          []syncserver.ConnectionInfo
+	terminated     []uint64
+	drainedPercent float64
+}
+
+func (m *mockConnManager) Connections() []syncserver.ConnectionInfo {
+	return m.conns
+}
+
+func (m *mockConnManager) TerminateConnection(_ *log.Entry, connID uint64, _ string) bool {
+	for _, c := range m.conns {
+		if c.ID == connID {
+			m.terminated = append(m.terminated, connID)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockConnManager) DrainConnections(_ context.Context, percent float64, _ string) int {
+	m.drainedPercent = percent
+	return int(float64(len(m.conns)) * percent / 100)
+}
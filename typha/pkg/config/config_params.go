@@ -113,6 +113,7 @@ type Config struct {
 	PrometheusProcessMetricsEnabled bool   `config:"bool;true"`
 
 	SnapshotCacheMaxBatchSize int `config:"int(1,);100"`
+	SnapshotCacheMaxHistory   int `config:"int(1,);1000"`
 
 	ServerMaxMessageSize                 int           `config:"int(1,);100"`
 	ServerMaxFallBehindSecs              time.Duration `config:"seconds;300"`
@@ -123,6 +124,14 @@ type Config struct {
 	ServerHandshakeTimeoutSecs           time.Duration `config:"seconds;10"`
 	ServerPort                           int           `config:"port;0"`
 
+	// Compression.  Clients choose their preferred algorithm; ServerZstdEnabled allows Typha to honour a
+	// preference for zstd.  ServerZstdLevel is on zstd's usual 1-22 scale.  ServerZstdDictionarySize is the
+	// maximum size of the dictionary that Typha trains on its cache for each syncer type; 0 disables the
+	// dictionary.
+	ServerZstdEnabled        bool `config:"bool;true"`
+	ServerZstdLevel          int  `config:"int(1,22);3"`
+	ServerZstdDictionarySize int  `config:"int(0,);32768"`
+
 	// Server-side TLS config for Typha's communication with Felix.  If any of these are
 	// specified, they _all_ must be - except that either ClientCN or ClientURISAN may be left
 	// unset - and Typha will then only accept secure (TLS) connections.  Each connecting client
@@ -134,9 +143,35 @@ type Config struct {
 	ClientCN       string `config:"string;"`
 	ClientURISAN   string `config:"string;"`
 
+	// Relay mode.  If UpstreamTyphaAddr or UpstreamTyphaK8sServiceName is set, Typha sources its snapshot
+	// caches from an upstream Typha (one connection per syncer type) instead of from the datastore.  This
+	// allows a tree of Typha instances to be built where only the root tier talks to the datastore.
+	// UpstreamTyphaAddr overrides UpstreamTyphaK8sServiceName.
+	UpstreamTyphaAddr           string        `config:"authority;;local"`
+	UpstreamTyphaK8sServiceName string        `config:"string;;local"`
+	UpstreamTyphaK8sNamespace   string        `config:"string;kube-system;non-zero,local"`
+	UpstreamTyphaReadTimeout    time.Duration `config:"seconds;30;local"`
+	UpstreamTyphaWriteTimeout   time.Duration `config:"seconds;10;local"`
+	UpstreamTyphaCompression    string        `config:"oneof(snappy,zstd);snappy;local"`
+
+	// Client-side TLS config for the connection to the upstream Typha.  If any of these are specified,
+	// they _all_ must be - except that either UpstreamTyphaCN or UpstreamTyphaURISAN may be left unset.
+	UpstreamTyphaKeyFile  string `config:"file(must-exist);;local"`
+	UpstreamTyphaCertFile string `config:"file(must-exist);;local"`
+	UpstreamTyphaCAFile   string `config:"file(must-exist);;local"`
+	UpstreamTyphaCN       string `config:"string;;local"`
+	UpstreamTyphaURISAN   string `config:"string;;local"`
+
 	DebugMemoryProfilePath  string `config:"file;;"`
 	DebugDisableLogDropping bool   `config:"bool;false"`
 
+	// AdminHost and AdminPort are where to serve the admin API, which lists the connected clients and
+	// allows them to be drained.  The API is disabled if AdminPort is 0.  Requests must present the
+	// contents of AdminTokenFile as a bearer token.
+	AdminHost      string `config:"host-address;localhost"`
+	AdminPort      int    `config:"int(0,65535);"`
+	AdminTokenFile string `config:"file(must-exist);;local"`
+
 	// DebugHost is the host to bind the debug server port to.  Only used if DebugPort is non-zero.
 	DebugHost string `config:"host-address;localhost"`
 	// DebugPort is the port to bind the pprof debug server to or 0 to disable the debug port.
@@ -311,6 +346,16 @@ func (config *Config) requiringTLS() bool {
 	return config.ServerKeyFile+config.ServerCertFile+config.CAFile+config.ClientCN+config.ClientURISAN != ""
 }
 
+// RelayMode returns true if Typha should source its caches from an upstream Typha instead of the datastore.
+func (config *Config) RelayMode() bool {
+	return config.UpstreamTyphaAddr != "" || config.UpstreamTyphaK8sServiceName != ""
+}
+
+func (config *Config) upstreamRequiringTLS() bool {
+	return config.UpstreamTyphaKeyFile+config.UpstreamTyphaCertFile+config.UpstreamTyphaCAFile+
+		config.UpstreamTyphaCN+config.UpstreamTyphaURISAN != ""
+}
+
 // Validate() performs cross-field validation.
 func (config *Config) Validate() (err error) {
 	if config.DatastoreType == "etcdv3" && len(config.EtcdEndpoints) == 0 {
@@ -335,6 +380,25 @@ func (config *Config) Validate() (err error) {
 				" - except that either ClientCN or ClientURISAN may be left unset.")
 		}
 	}
+
+	if config.upstreamRequiringTLS() {
+		if config.UpstreamTyphaKeyFile == "" ||
+			config.UpstreamTyphaCertFile == "" ||
+			config.UpstreamTyphaCAFile == "" ||
+			(config.UpstreamTyphaCN == "" && config.UpstreamTyphaURISAN == "") {
+			err = errors.New("If any upstream Typha TLS config parameters are specified," +
+				" they _all_ must be" +
+				" - except that either UpstreamTyphaCN or UpstreamTyphaURISAN may be left unset.")
+		}
+	}
+	if config.UpstreamTyphaAddr == "" &&
+		config.UpstreamTyphaK8sServiceName == config.K8sServiceName &&
+		config.UpstreamTyphaK8sNamespace == config.K8sNamespace {
+		err = errors.New("UpstreamTyphaK8sServiceName must not be the Service of this Typha")
+	}
+	if config.AdminPort != 0 && config.AdminTokenFile == "" {
+		err = errors.New("AdminTokenFile must be set to enable the admin API")
+	}
 	return
 }
 
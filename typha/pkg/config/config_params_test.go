@@ -125,4 +125,31 @@ var _ = DescribeTable("Config validation",
 		"ClientCN":       "typha-peer",
 		"ClientURISAN":   "spiffe://k8s.example.com/typha-peer",
 	}, true),
+	Entry("upstream Typha address", map[string]string{
+		"UpstreamTyphaAddr": "typha-root:5473",
+	}, true),
+	Entry("upstream Typha Service", map[string]string{
+		"UpstreamTyphaK8sServiceName": "calico-typha-root",
+	}, true),
+	Entry("upstream Typha Service is our own Service", map[string]string{
+		"UpstreamTyphaK8sServiceName": "calico-typha",
+	}, false),
+	Entry("just one upstream TLS setting", map[string]string{
+		"UpstreamTyphaAddr":    "typha-root:5473",
+		"UpstreamTyphaKeyFile": "/usr",
+	}, false),
+	Entry("all upstream TLS params", map[string]string{
+		"UpstreamTyphaAddr":     "typha-root:5473",
+		"UpstreamTyphaKeyFile":  "/usr",
+		"UpstreamTyphaCertFile": "/usr",
+		"UpstreamTyphaCAFile":   "/usr",
+		"UpstreamTyphaCN":       "typha-root",
+	}, true),
+	Entry("admin API without token file", map[string]string{
+		"AdminPort": "9099",
+	}, false),
+	Entry("admin API with token file", map[string]string{
+		"AdminPort":      "9099",
+		"AdminTokenFile": "/usr",
+	}, true),
 )
@@ -42,6 +42,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/metricsserver"
 	"github.com/projectcalico/calico/libcalico-go/lib/upgrade/migrator"
 	"github.com/projectcalico/calico/libcalico-go/lib/upgrade/migrator/clients"
+	"github.com/projectcalico/calico/typha/pkg/admin"
 	"github.com/projectcalico/calico/typha/pkg/buildinfo"
 	"github.com/projectcalico/calico/typha/pkg/calc"
 	"github.com/projectcalico/calico/typha/pkg/config"
@@ -228,6 +229,11 @@ configRetry:
 			continue configRetry
 		}
 
+		if configParams.RelayMode() {
+			// In relay mode, our data comes from the upstream Typha so we don't need a datastore client.
+			break configRetry
+		}
+
 		// We should now have enough config to connect to the datastore.
 		datastoreConfig = configParams.DatastoreConfig()
 		t.DatastoreClient, err = t.NewClientV3(datastoreConfig)
@@ -247,6 +253,12 @@ configRetry:
 	t.BuildInfoLogCxt.WithField("config", configParams).Info(
 		"Successfully loaded configuration.")
 
+	if configParams.RelayMode() {
+		log.Info("Relay mode enabled, skipping datastore initialization.")
+		t.ConfigParams = configParams
+		return nil
+	}
+
 	if datastoreConfig.Spec.DatastoreType == apiconfig.Kubernetes {
 		// Special case: for KDD v1 datamodel to v3 datamodel upgrade, we need to ensure that the datastore migration
 		// has completed before we start serving requests.  Otherwise, we might serve partially-migrated data to
@@ -351,6 +363,7 @@ func (t *TyphaDaemon) addSyncerPipeline(
 	// Create our snapshot cache, which stores point-in-time copies of the datastore contents.
 	cache := snapcache.New(snapcache.Config{
 		MaxBatchSize:     t.ConfigParams.SnapshotCacheMaxBatchSize,
+		MaxHistory:       t.ConfigParams.SnapshotCacheMaxHistory,
 		HealthAggregator: t.healthAggregator,
 		Name:             string(syncerType),
 	})
@@ -373,10 +386,18 @@ func (t *TyphaDaemon) CreateServer() {
 	t.healthAggregator = health.NewHealthAggregator()
 
 	// Now create the Syncer and caching layer (one pipeline for each syncer we support).
-	t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	if t.ConfigParams.RelayMode() {
+		// In relay mode, each "syncer" is a client of the upstream Typha.
+		discoverer := t.upstreamDiscoverer()
+		for _, syncerType := range syncproto.AllSyncerTypes {
+			t.addSyncerPipeline(syncerType, t.newRelaySyncerFn(discoverer, syncerType))
+		}
+	} else {
+		t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	}
 
 	// Create the server, which listens for connections from Felix.
 	t.Server = syncserver.New(
@@ -400,6 +421,9 @@ func (t *TyphaDaemon) CreateServer() {
 			CAFile:                         t.ConfigParams.CAFile,
 			ClientCN:                       t.ConfigParams.ClientCN,
 			ClientURISAN:                   t.ConfigParams.ClientURISAN,
+			DisableZstd:                    !t.ConfigParams.ServerZstdEnabled,
+			ZstdLevel:                      t.ConfigParams.ServerZstdLevel,
+			ZstdDictionarySize:             t.ConfigParams.ServerZstdDictionarySize,
 		},
 	)
 }
@@ -425,6 +449,9 @@ func (t *TyphaDaemon) Start(cxt context.Context) {
 	if t.ConfigParams.DebugPort != 0 {
 		debugserver.StartDebugPprofServer(t.ConfigParams.DebugHost, t.ConfigParams.DebugPort)
 	}
+	if t.ConfigParams.AdminPort != 0 {
+		admin.New(t.Server, t.ConfigParams.AdminHost, t.ConfigParams.AdminPort, t.ConfigParams.AdminTokenFile).Start(cxt)
+	}
 	if t.ConfigParams.PrometheusMetricsEnabled {
 		log.Info("Prometheus metrics enabled.  Starting server.")
 		t.configurePrometheusMetrics()
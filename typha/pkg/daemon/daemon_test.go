@@ -28,12 +28,14 @@ import (
 
 	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
 	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
 	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
 	fvtests "github.com/projectcalico/calico/typha/fv-tests"
 	"github.com/projectcalico/calico/typha/pkg/config"
 	. "github.com/projectcalico/calico/typha/pkg/daemon"
 	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/snapcache"
 	"github.com/projectcalico/calico/typha/pkg/syncclient"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	"github.com/projectcalico/calico/typha/pkg/syncserver"
@@ -239,6 +241,108 @@ var _ = Describe("Daemon", func() {
 	})
 })
 
+var _ = Describe("Daemon in relay mode", func() {
+	var (
+		d              *TyphaDaemon
+		upstreamCaches map[syncproto.SyncerType]*snapcache.Cache
+		upstream       *syncserver.Server
+		cancelUpstream context.CancelFunc
+		cancelCaches   context.CancelFunc
+		configFile     *os.File
+	)
+
+	BeforeEach(func() {
+		var cacheCxt context.Context
+		cacheCxt, cancelCaches = context.WithCancel(context.Background())
+		upstreamCaches = map[syncproto.SyncerType]*snapcache.Cache{}
+		providers := map[syncproto.SyncerType]syncserver.BreadcrumbProvider{}
+		for _, st := range syncproto.AllSyncerTypes {
+			cache := snapcache.New(snapcache.Config{})
+			cache.Start(cacheCxt)
+			upstreamCaches[st] = cache
+			providers[st] = cache
+		}
+		upstream = syncserver.New(providers, syncserver.Config{Port: syncserver.PortRandom})
+		var upstreamCxt context.Context
+		upstreamCxt, cancelUpstream = context.WithCancel(context.Background())
+		upstream.Start(upstreamCxt)
+
+		var err error
+		configFile, err = os.CreateTemp("", "typha")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = configFile.Write([]byte(fmt.Sprintf(`[default]
+LogFilePath=none
+UpstreamTyphaAddr=127.0.0.1:%d
+`, upstream.Port())))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configFile.Close()).To(Succeed())
+
+		d = New()
+		d.NewClientV3 = func(config apiconfig.CalicoAPIConfig) (c DatastoreClient, err error) {
+			Fail("Relay shouldn't create a datastore client")
+			return nil, nil
+		}
+		d.ConfigureEarlyLogging = func() {}
+		d.ConfigureLogging = func(config *config.Config) {}
+		d.ParseCommandLineArgs([]string{"-c", configFile.Name()})
+	})
+
+	AfterEach(func() {
+		for _, p := range d.SyncerPipelines {
+			p.Syncer.Stop()
+		}
+		cancelUpstream()
+		upstream.Finished.Wait()
+		cancelCaches()
+		Expect(os.Remove(configFile.Name())).To(Succeed())
+	})
+
+	It("should relay the upstream Typha's data to its own clients", func() {
+		cxt, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancelFn()
+		Expect(d.LoadConfiguration(cxt)).To(Succeed())
+		Expect(d.ConfigParams.RelayMode()).To(BeTrue())
+		d.ConfigParams.ServerPort = syncserver.PortRandom
+		d.CreateServer()
+		Expect(d.CachesBySyncerType).To(HaveLen(syncproto.NumSyncerTypes))
+		d.Start(cxt)
+
+		cbs := fvtests.NewRecorder()
+		client := syncclient.New(
+			discovery.New(discovery.WithAddrOverride(fmt.Sprintf("127.0.0.1:%d", d.Server.Port()))),
+			"",
+			"",
+			"",
+			cbs,
+			&syncclient.Options{SyncerType: syncproto.SyncerTypeBGP},
+		)
+		clientCxt, clientCancelFn := context.WithCancel(context.Background())
+		recorderCtx, recorderCancelFn := context.WithCancel(context.Background())
+		defer func() {
+			clientCancelFn()
+			client.Finished.Wait()
+			recorderCancelFn()
+		}()
+		go cbs.Loop(recorderCtx)
+		Expect(client.Start(clientCxt)).To(Succeed())
+
+		update := bapi.Update{
+			KVPair: model.KVPair{
+				Key:      model.GlobalBGPConfigKey{Name: "foo"},
+				Value:    "bar",
+				Revision: "1234",
+			},
+			UpdateType: bapi.UpdateTypeKVNew,
+		}
+		upstreamCaches[syncproto.SyncerTypeBGP].OnUpdates([]bapi.Update{update})
+		upstreamCaches[syncproto.SyncerTypeBGP].OnStatusUpdated(bapi.InSync)
+		Eventually(cbs.Status).Should(Equal(bapi.InSync))
+		Eventually(cbs.KVs).Should(Equal(map[string]bapi.Update{
+			"/calico/bgp/v1/global/foo": update,
+		}))
+	})
+})
+
 type mockDatastore struct {
 	mutex                        sync.Mutex
 	allocateTunnelIpSyncerCalled bool
@@ -312,6 +416,14 @@ func (b *mockDatastore) NetworkPolicies() clientv3.NetworkPolicyInterface {
 	panic("not implemented")
 }
 
+func (b *mockDatastore) StagedGlobalNetworkPolicies() clientv3.StagedGlobalNetworkPolicyInterface {
+	panic("not implemented")
+}
+
+func (b *mockDatastore) StagedNetworkPolicies() clientv3.StagedNetworkPolicyInterface {
+	panic("not implemented")
+}
+
 // IPPools returns an interface for managing IP pool resources.
 func (b *mockDatastore) IPPools() clientv3.IPPoolInterface {
 	panic("not implemented")
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/typha/pkg/buildinfo"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+)
+
+// upstreamDiscoverer returns a Discoverer for the upstream Typha, used in relay mode.
+func (t *TyphaDaemon) upstreamDiscoverer() *discovery.Discoverer {
+	return discovery.New(
+		discovery.WithAddrOverride(t.ConfigParams.UpstreamTyphaAddr),
+		discovery.WithInClusterKubeClient(),
+		discovery.WithKubeService(t.ConfigParams.UpstreamTyphaK8sNamespace, t.ConfigParams.UpstreamTyphaK8sServiceName),
+	)
+}
+
+// newRelaySyncerFn returns a function that creates a relaySyncer for the given syncer type.  It has the same
+// signature as the DatastoreClient's syncer factory methods so that it can be used in addSyncerPipeline.
+func (t *TyphaDaemon) newRelaySyncerFn(
+	discoverer *discovery.Discoverer,
+	syncerType syncproto.SyncerType,
+) func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+	return func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+		hostname, err := os.Hostname()
+		if err != nil {
+			log.WithError(err).Warn("Failed to get hostname, using placeholder in hello to upstream Typha.")
+			hostname = "unknown"
+		}
+		client := syncclient.New(
+			discoverer,
+			buildinfo.GitVersion,
+			hostname,
+			fmt.Sprintf("Typha relay; Revision: %s; Build date: %s", buildinfo.GitRevision, buildinfo.BuildDate),
+			callbacks,
+			&syncclient.Options{
+				SyncerType:   syncerType,
+				ReadTimeout:  t.ConfigParams.UpstreamTyphaReadTimeout,
+				WriteTimeout: t.ConfigParams.UpstreamTyphaWriteTimeout,
+				KeyFile:      t.ConfigParams.UpstreamTyphaKeyFile,
+				CertFile:     t.ConfigParams.UpstreamTyphaCertFile,
+				CAFile:       t.ConfigParams.UpstreamTyphaCAFile,
+				ServerCN:     t.ConfigParams.UpstreamTyphaCN,
+				ServerURISAN: t.ConfigParams.UpstreamTyphaURISAN,
+				// Resume from where we left off if the connection fails so that our own clients see a
+				// short gap rather than a resync.  We never filter by node since our clients are on
+				// every node.
+				ResumeOnReconnect:    true,
+				PreferredCompression: syncproto.CompressionAlgorithm(t.ConfigParams.UpstreamTyphaCompression),
+			},
+		)
+		return &relaySyncer{
+			syncerType: syncerType,
+			discoverer: discoverer,
+			client:     client,
+		}
+	}
+}
+
+// relaySyncer adapts a syncclient.SyncerClient to the bapi.Syncer interface so that a Typha in relay mode
+// can feed its syncer pipelines from an upstream Typha.  The upstream Typha passes through the sync status
+// and the KVs from its own datastore syncer, so the downstream caches end up with the same contents.
+type relaySyncer struct {
+	syncerType syncproto.SyncerType
+	discoverer *discovery.Discoverer
+	client     *syncclient.SyncerClient
+	cancel     context.CancelFunc
+}
+
+// Start connects to the upstream Typha.  Like Felix, we exit if we can't connect or if the connection fails
+// in a way that can't be resumed; the caches can't recover from a gap in the upstream data.
+func (r *relaySyncer) Start() {
+	logCxt := log.WithField("syncerType", r.syncerType)
+	if _, err := r.discoverer.LoadTyphaAddrs(); err != nil {
+		logCxt.WithError(err).Fatal("Failed to discover upstream Typha.")
+	}
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	logCxt.Info("Connecting to upstream Typha.")
+	if err := r.client.Start(ctx); err != nil {
+		logCxt.WithError(err).Fatal("Failed to connect to upstream Typha.")
+	}
+	go func() {
+		r.client.Finished.Wait()
+		if ctx.Err() == nil {
+			logCxt.Fatal("Connection to upstream Typha failed.")
+		}
+	}()
+}
+
+func (r *relaySyncer) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	r.client.Finished.Wait()
+}
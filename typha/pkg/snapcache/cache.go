@@ -23,6 +23,7 @@ import (
 	"unsafe"
 
 	"github.com/google/btree"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
@@ -37,6 +38,7 @@ import (
 const (
 	defaultMaxBatchSize   = 100
 	defaultWakeUpInterval = time.Second
+	defaultMaxHistory     = 1000
 )
 
 var (
@@ -118,9 +120,19 @@ func init() {
 // to one slow client) and keep track of what we'd sent to each channel.  All doable but, I think,
 // more fiddly than using a non-blocking linked list and a condition variable and letting each
 // client look after itself.
+//
+// # History
+//
+// The cache also keeps a bounded history of the most recent Breadcrumbs so that a client that
+// reconnects can resume from the last Breadcrumb that it applied, rather than taking a fresh
+// snapshot.  Sequence numbers are only meaningful within one Cache so the history is tagged with
+// a random ID that is generated when the Cache is created.
 type Cache struct {
 	config Config
 
+	// id uniquely identifies this Cache (and hence its sequence numbers).
+	id string
+
 	inputC chan interface{}
 
 	pendingStatus  api.SyncStatus
@@ -138,6 +150,11 @@ type Cache struct {
 	// blocking.
 	currentBreadcrumb unsafe.Pointer
 
+	// historyLock protects history, which contains the most recent Breadcrumbs, oldest first, with
+	// contiguous sequence numbers.
+	historyLock sync.Mutex
+	history     []*Breadcrumb
+
 	wakeUpTicker *jitter.Ticker
 	healthTicks  <-chan time.Time
 
@@ -160,8 +177,11 @@ type healthAggregator interface {
 }
 
 type Config struct {
-	MaxBatchSize     int
-	WakeUpInterval   time.Duration
+	MaxBatchSize   int
+	WakeUpInterval time.Duration
+	// MaxHistory is the number of recent Breadcrumbs to keep so that reconnecting clients can
+	// resume from where they left off.
+	MaxHistory       int
 	HealthAggregator healthAggregator
 	Name             string
 	HealthName       string
@@ -182,6 +202,13 @@ func (config *Config) ApplyDefaults() {
 		}).Info("Defaulting WakeUpInterval.")
 		config.WakeUpInterval = defaultWakeUpInterval
 	}
+	if config.MaxHistory <= 0 {
+		log.WithFields(log.Fields{
+			"value":   config.MaxHistory,
+			"default": defaultMaxHistory,
+		}).Info("Defaulting MaxHistory.")
+		config.MaxHistory = defaultMaxHistory
+	}
 	if config.HealthName == "" {
 		if config.Name == "" {
 			config.HealthName = "cache"
@@ -203,6 +230,7 @@ func New(config Config) *Cache {
 
 	c := &Cache{
 		config:         config,
+		id:             uuid.NewString(),
 		inputC:         make(chan interface{}, config.MaxBatchSize*2),
 		breadcrumbCond: cond,
 		kvs:            kvs,
@@ -238,6 +266,7 @@ func New(config Config) *Cache {
 		counterBreadcrumbNonBlock: c.counterBreadcrumbNonBlock,
 	}
 	c.currentBreadcrumb = (unsafe.Pointer)(snap)
+	c.history = []*Breadcrumb{snap}
 
 	if config.HealthAggregator != nil {
 		config.HealthAggregator.RegisterReporter(config.HealthName, &health.HealthReport{Live: true, Ready: true}, healthInterval*2)
@@ -253,6 +282,24 @@ func (c *Cache) CurrentBreadcrumb() *Breadcrumb {
 	return (*Breadcrumb)(atomic.LoadPointer(&c.currentBreadcrumb))
 }
 
+// ID returns the random ID of this Cache.  Sequence numbers of Breadcrumbs from different Caches
+// (for example, from different Typha instances) are not comparable.
+func (c *Cache) ID() string {
+	return c.id
+}
+
+// BreadcrumbBySequenceNumber returns the Breadcrumb with the given sequence number if it is still in
+// the history, or nil otherwise.  It is safe to call from any goroutine.
+func (c *Cache) BreadcrumbBySequenceNumber(seqNo uint64) *Breadcrumb {
+	c.historyLock.Lock()
+	defer c.historyLock.Unlock()
+	oldest := c.history[0].SequenceNumber
+	if seqNo < oldest || seqNo-oldest >= uint64(len(c.history)) {
+		return nil
+	}
+	return c.history[seqNo-oldest]
+}
+
 // OnStatusUpdated implements the SyncerCallbacks API.  It shouldn't be called directly.
 func (c *Cache) OnStatusUpdated(status api.SyncStatus) {
 	c.inputC <- status
@@ -467,6 +514,7 @@ func (c *Cache) publishBreadcrumb() {
 	atomic.StorePointer(&(oldCrumb.next), (unsafe.Pointer)(newCrumb))
 	atomic.StorePointer(&c.currentBreadcrumb, (unsafe.Pointer)(newCrumb))
 	c.breadcrumbCond.L.Unlock()
+	c.addToHistory(newCrumb)
 	// Then wake up any watching clients.  Note: Go's Cond doesn't require us to hold the lock
 	// while calling Broadcast.
 	log.WithField("seqNo", newCrumb.SequenceNumber).Debug("Broadcasting new Breadcrumb")
@@ -475,6 +523,19 @@ func (c *Cache) publishBreadcrumb() {
 	c.gaugeCurrentSequenceNumber.Set(float64(newCrumb.SequenceNumber))
 }
 
+// addToHistory appends the given Breadcrumb to the history, dropping the oldest Breadcrumb if the
+// history is full.
+func (c *Cache) addToHistory(crumb *Breadcrumb) {
+	c.historyLock.Lock()
+	defer c.historyLock.Unlock()
+	if len(c.history) >= c.config.MaxHistory {
+		// Clear the dropped entry so that the backing array doesn't keep it alive.
+		c.history[0] = nil
+		c.history = c.history[1:]
+	}
+	c.history = append(c.history, crumb)
+}
+
 type Breadcrumb struct {
 	SequenceNumber uint64
 	Timestamp      time.Time
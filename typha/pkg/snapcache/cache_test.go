@@ -634,6 +634,9 @@ var _ = Describe("Zero config after applying defaults", func() {
 	It("should default the wake up interval", func() {
 		Expect(config.WakeUpInterval).To(Equal(time.Second))
 	})
+	It("should default the max history", func() {
+		Expect(config.MaxHistory).To(Equal(1000))
+	})
 })
 
 var _ = Describe("Non-zero config after applying defaults", func() {
@@ -643,6 +646,7 @@ var _ = Describe("Non-zero config after applying defaults", func() {
 		config = snapcache.Config{
 			WakeUpInterval: 10 * time.Second,
 			MaxBatchSize:   1000,
+			MaxHistory:     5,
 		}
 		config.ApplyDefaults()
 	})
@@ -653,6 +657,74 @@ var _ = Describe("Non-zero config after applying defaults", func() {
 	It("should not default the wake up interval", func() {
 		Expect(config.WakeUpInterval).To(Equal(10 * time.Second))
 	})
+	It("should not default the max history", func() {
+		Expect(config.MaxHistory).To(Equal(5))
+	})
+})
+
+var _ = Describe("Breadcrumb history", func() {
+	var cache *snapcache.Cache
+	var cancel context.CancelFunc
+
+	BeforeEach(func() {
+		cache = snapcache.New(snapcache.Config{
+			MaxBatchSize: 1,
+			MaxHistory:   3,
+			Name:         "history-cache",
+		})
+		var cxt context.Context
+		cxt, cancel = context.WithCancel(context.Background())
+		cache.Start(cxt)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	sendUpdates := func(n int) {
+		for i := 0; i < n; i++ {
+			cache.OnUpdates([]api.Update{{
+				KVPair: model.KVPair{
+					Key:      model.GlobalConfigKey{Name: fmt.Sprintf("key%d", i)},
+					Value:    "value",
+					Revision: fmt.Sprint(i),
+				},
+				UpdateType: api.UpdateTypeKVNew,
+			}})
+		}
+	}
+
+	It("should have a unique ID", func() {
+		other := snapcache.New(snapcache.Config{Name: "history-cache"})
+		Expect(cache.ID()).NotTo(BeEmpty())
+		Expect(cache.ID()).NotTo(Equal(other.ID()))
+	})
+
+	It("should look up the initial breadcrumb", func() {
+		Expect(cache.BreadcrumbBySequenceNumber(0)).To(BeIdenticalTo(cache.CurrentBreadcrumb()))
+		Expect(cache.BreadcrumbBySequenceNumber(1)).To(BeNil())
+	})
+
+	It("should keep only the most recent breadcrumbs", func() {
+		sendUpdates(5)
+		Eventually(func() uint64 { return cache.CurrentBreadcrumb().SequenceNumber }).Should(Equal(uint64(5)))
+
+		for seqNo := uint64(0); seqNo <= 2; seqNo++ {
+			Expect(cache.BreadcrumbBySequenceNumber(seqNo)).To(BeNil(), fmt.Sprintf("seqNo %d", seqNo))
+		}
+		for seqNo := uint64(3); seqNo <= 5; seqNo++ {
+			crumb := cache.BreadcrumbBySequenceNumber(seqNo)
+			Expect(crumb).NotTo(BeNil(), fmt.Sprintf("seqNo %d", seqNo))
+			Expect(crumb.SequenceNumber).To(Equal(seqNo))
+		}
+		Expect(cache.BreadcrumbBySequenceNumber(6)).To(BeNil())
+
+		// Following the chain from a breadcrumb in the history should reach the current breadcrumb.
+		crumb := cache.BreadcrumbBySequenceNumber(4)
+		next, err := crumb.Next(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(BeIdenticalTo(cache.CurrentBreadcrumb()))
+	})
 })
 
 func deserialiseUpdates(serializedUpdates []syncproto.SerializedUpdate) []api.Update {
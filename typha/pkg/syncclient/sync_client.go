@@ -28,10 +28,12 @@ import (
 	"time"
 
 	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	log "github.com/sirupsen/logrus"
 
 	calicotls "github.com/projectcalico/calico/crypto/pkg/tls"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/readlogger"
 	"github.com/projectcalico/calico/typha/pkg/discovery"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
@@ -60,6 +62,24 @@ type Options struct {
 	// it (such as compression).  Useful for simulating an older client in UT.
 	DisableDecoderRestart bool
 
+	// ResumeOnReconnect tells the client to reconnect if its connection to Typha fails after the initial
+	// snapshot, instead of exiting.  When reconnecting, the client asks Typha to resume from the last
+	// position that it received; if Typha can't do that, it sends a new snapshot and the client deletes
+	// any keys that are missing from it.  Enabling this option means that the client keeps a set of all
+	// the keys that it has seen.
+	ResumeOnReconnect bool
+
+	// FilterByNode asks Typha to filter out KVs that belong to other nodes and that a client on this node
+	// doesn't need.  The client's hostname is used as the node name.  Typha only filters the syncer types
+	// that it has a filtering policy for; older Typha instances ignore the request.
+	FilterByNode bool
+
+	// PreferredCompression is the compression algorithm that the client asks Typha to use.  If empty,
+	// snappy is preferred.  zstd compresses much better than snappy at the cost of more CPU so it is
+	// useful for clients on slow links.  Typha falls back to snappy if it doesn't support (or has disabled)
+	// the preferred algorithm.
+	PreferredCompression syncproto.CompressionAlgorithm
+
 	// DebugLogReads tells the client to wrap each connection with a Reader that
 	// logs every read.  Intended only for use in tests!
 	DebugLogReads bool
@@ -103,6 +123,13 @@ func (o *Options) validate() (err error) {
 				" - except that either ServerCN or ServerURISAN may be left unset.")
 		}
 	}
+	if o != nil {
+		switch o.PreferredCompression {
+		case "", syncproto.CompressionSnappy, syncproto.CompressionZstd:
+		default:
+			err = fmt.Errorf("unknown compression algorithm %q", o.PreferredCompression)
+		}
+	}
 	return
 }
 
@@ -124,6 +151,10 @@ func New(
 	if !ok {
 		cbskn = callbacksWithKeysKnownAdapter{cbs}
 	}
+	var knownKeys map[string]struct{}
+	if options.ResumeOnReconnect {
+		knownKeys = map[string]struct{}{}
+	}
 	return &SyncerClient{
 		ID: id,
 		logCxt: log.WithFields(log.Fields{
@@ -137,7 +168,8 @@ func New(
 		myHostname: myHostname,
 		myInfo:     myInfo,
 
-		options: options,
+		options:   options,
+		knownKeys: knownKeys,
 		handshakeStatus: &handshakeStatus{
 			helloReceivedChan: make(chan struct{}, 1),
 		},
@@ -152,13 +184,27 @@ type SyncerClient struct {
 	myHostname, myVersion, myInfo string
 	options                       *Options
 
+	// connLock protects connection, which is replaced when we reconnect.
+	connLock                    sync.Mutex
 	connection                  net.Conn
 	connR                       io.Reader
 	encoder                     *gob.Encoder
 	decoder                     *gob.Decoder
+	zstdDecoder                 *zstd.Decoder
 	handshakeStatus             *handshakeStatus
 	supportsNodeResourceUpdates bool
 
+	// Resume state, only used if ResumeOnReconnect is set.  resumeCacheID and resumeSeqNo record the last
+	// position that we received from the server.  knownKeys contains all the keys that we've passed to the
+	// callbacks and not deleted.  snapshotKeys is non-nil while we're receiving a new snapshot after a
+	// reconnect; it records the keys that were in the snapshot.
+	resumeCacheID        string
+	resumeSeqNo          uint64
+	numPositionsReceived int
+	knownKeys            map[string]struct{}
+	snapshotKeys         map[string]struct{}
+	helloReceivedBefore  bool
+
 	callbacks callbacksWithKeysKnown
 	Finished  sync.WaitGroup
 }
@@ -184,7 +230,34 @@ type handshakeStatus struct {
 func (s *SyncerClient) Start(cxt context.Context) error {
 	// Connect synchronously so that we can return an error early if we can't connect at all.
 	s.logCxt.Info("Starting Typha client...")
+	if err := s.connectToAnyTypha(cxt); err != nil {
+		return err
+	}
+
+	// Then start our background goroutines.  We start the main loop and a second goroutine to
+	// manage shutdown.
+	cxt, cancelFn := context.WithCancel(cxt)
+	s.Finished.Add(1)
+	go s.loop(cxt, cancelFn)
+
+	s.Finished.Add(1)
+	go func() {
+		// Broadcast that we're finished.
+		defer s.Finished.Done()
+
+		// Wait for the context to finish, either due to external cancel or our own loop
+		// exiting.
+		<-cxt.Done()
+		s.logCxt.Info("Typha client Context asked us to exit, closing connection...")
+		// Close the connection.  This will trigger the main loop to exit if it hasn't
+		// already.
+		s.closeConnection()
+	}()
+	return nil
+}
 
+// connectToAnyTypha tries the discovered Typha instances in turn until it connects to one of them.
+func (s *SyncerClient) connectToAnyTypha(cxt context.Context) error {
 	// Defensive: in case there's a bug in NextAddr() and it never stops returning values,
 	// set a sanity limit on the number of tries.
 	maxTries := s.calculateConnectionAttemptLimit(len(s.discoverer.CachedTyphaAddrs()))
@@ -206,33 +279,22 @@ func (s *SyncerClient) Start(cxt context.Context) error {
 			time.Sleep(100 * time.Millisecond) // Avoid tight loop.
 		} else {
 			s.logCxt.Infof("Successfully connected to Typha at %s.", addr.Addr)
-			break
+			return nil
 		}
 	}
+}
 
-	// Then start our background goroutines.  We start the main loop and a second goroutine to
-	// manage shutdown.
-	cxt, cancelFn := context.WithCancel(cxt)
-	s.Finished.Add(1)
-	go s.loop(cxt, cancelFn)
-
-	s.Finished.Add(1)
-	go func() {
-		// Broadcast that we're finished.
-		defer s.Finished.Done()
-
-		// Wait for the context to finish, either due to external cancel or our own loop
-		// exiting.
-		<-cxt.Done()
-		s.logCxt.Info("Typha client Context asked us to exit, closing connection...")
-		// Close the connection.  This will trigger the main loop to exit if it hasn't
-		// already.
-		err := s.connection.Close()
-		if err != nil {
-			log.WithError(err).Warn("Ignoring error from Close during shut-down of client.")
-		}
-	}()
-	return nil
+// closeConnection closes the current connection.  It may be called from any goroutine.
+func (s *SyncerClient) closeConnection() {
+	s.connLock.Lock()
+	defer s.connLock.Unlock()
+	if s.connection == nil {
+		return
+	}
+	err := s.connection.Close()
+	if err != nil {
+		log.WithError(err).Warn("Ignoring error from Close during shut-down of client.")
+	}
 }
 
 func (s *SyncerClient) calculateConnectionAttemptLimit(numDiscoveredTyphas int) int {
@@ -271,7 +333,6 @@ func (s *SyncerClient) SupportsNodeResourceUpdates(timeout time.Duration) (bool,
 
 func (s *SyncerClient) connect(cxt context.Context, typhaAddr discovery.Typha) error {
 	log.Info("Starting Typha client")
-	var err error
 	logCxt := s.logCxt.WithField("address", typhaAddr)
 
 	var connFunc func(string) (net.Conn, error)
@@ -324,22 +385,20 @@ func (s *SyncerClient) connect(cxt context.Context, typhaAddr discovery.Typha) e
 	}
 	if cxt.Err() == nil {
 		logCxt.Info("Connecting to Typha.")
-		s.connection, err = connFunc(typhaAddr.Addr)
+		conn, err := connFunc(typhaAddr.Addr)
 		if err != nil {
 			return err
 		}
+		s.connLock.Lock()
+		s.connection = conn
+		s.connLock.Unlock()
 		s.connR = s.connection
 		if s.options.DebugLogReads {
 			s.connR = readlogger.New(s.connection)
 		}
 	}
 	if cxt.Err() != nil {
-		if s.connection != nil {
-			err := s.connection.Close()
-			if err != nil {
-				log.WithError(err).Warn("Ignoring error from Close during shut-down of client.")
-			}
-		}
+		s.closeConnection()
 		return cxt.Err()
 	}
 
@@ -404,9 +463,64 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 	defer s.Finished.Done()
 	defer cancelFn()
 
+	// Limit the number of reconnections that make no progress so that we don't loop forever if, for
+	// example, Typha keeps dropping us before it sends anything.
+	maxAttemptsWithoutProgress := s.calculateConnectionAttemptLimit(len(s.discoverer.CachedTyphaAddrs()))
+	attemptsWithoutProgress := 0
+	for {
+		numPositionsBefore := s.numPositionsReceived
+		canReconnect := s.handleConnection(cxt)
+		if cxt.Err() != nil || !canReconnect {
+			return
+		}
+		if s.numPositionsReceived > numPositionsBefore {
+			attemptsWithoutProgress = 0
+		} else {
+			attemptsWithoutProgress++
+			if attemptsWithoutProgress >= maxAttemptsWithoutProgress {
+				s.logCxt.WithField("attempts", attemptsWithoutProgress).Error(
+					"Reconnected to Typha repeatedly without making progress, giving up.")
+				return
+			}
+		}
+
+		s.closeConnection()
+		s.logCxt.WithFields(log.Fields{
+			"cacheID": s.resumeCacheID,
+			"seqNo":   s.resumeSeqNo,
+		}).Info("Connection to Typha lost, reconnecting to resume.")
+		if err := s.connectToAnyTypha(cxt); err != nil {
+			s.logCxt.WithError(err).Error("Failed to reconnect to Typha.")
+			return
+		}
+	}
+}
+
+// nodeNameForFiltering returns the node name to send in our hello, or "" if we don't want Typha to filter
+// by node.
+func (s *SyncerClient) nodeNameForFiltering() string {
+	if !s.options.FilterByNode {
+		return ""
+	}
+	return s.myHostname
+}
+
+// handleConnection does the handshake on the current connection and then processes messages from the
+// server until the connection fails.  It returns true if the connection failed in a way that we can
+// recover from by reconnecting and resuming.
+func (s *SyncerClient) handleConnection(cxt context.Context) (canReconnect bool) {
 	logCxt := s.logCxt.WithField("connection", s.connInfo)
 	logCxt.Info("Started Typha client main loop")
 
+	// Once we've had the handshake, connection failures are recoverable if we've received a position
+	// that we can resume from.
+	handshakeDone := false
+	defer func() {
+		if handshakeDone && s.resumeCacheID != "" {
+			canReconnect = true
+		}
+	}()
+
 	// Always start with basic gob encoding for the handshake.  We may upgrade to a compressed version below.
 	s.encoder = gob.NewEncoder(s.connection)
 	s.decoder = gob.NewDecoder(s.connR)
@@ -415,7 +529,13 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 	if ourSyncerType == "" {
 		ourSyncerType = syncproto.SyncerTypeFelix
 	}
-	compAlgs := []syncproto.CompressionAlgorithm{syncproto.CompressionSnappy}
+	resumeCacheID := s.resumeCacheID
+	if s.snapshotKeys != nil {
+		// We were part way through receiving a new snapshot when the connection failed so our state is a
+		// mix of the old and new snapshots; we can't resume from the old position.
+		resumeCacheID = ""
+	}
+	compAlgs := s.supportedCompressionAlgorithms()
 	if s.options.DisableDecoderRestart {
 		// Compression requires decoder restart.
 		compAlgs = nil
@@ -429,6 +549,10 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 			SupportsDecoderRestart:         !s.options.DisableDecoderRestart,
 			SupportedCompressionAlgorithms: compAlgs,
 			ClientConnID:                   s.ID,
+			SupportsResume:                 s.options.ResumeOnReconnect,
+			ResumeCacheID:                  resumeCacheID,
+			ResumeSequenceNumber:           s.resumeSeqNo,
+			NodeName:                       s.nodeNameForFiltering(),
 		},
 	)
 	if err != nil {
@@ -454,8 +578,22 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 	if !serverHello.SupportsNodeResourceUpdates {
 		logCxt.Info("Server responded without support for node resource updates, assuming older Typha")
 	}
-	s.supportsNodeResourceUpdates = serverHello.SupportsNodeResourceUpdates
-	s.handshakeStatus.helloReceivedChan <- struct{}{}
+	if s.helloReceivedBefore {
+		// Reconnecting.  Our callers have already acted on the server's capabilities so we can only continue
+		// if the new server has the same ones.
+		if serverHello.SupportsNodeResourceUpdates != s.supportsNodeResourceUpdates {
+			logCxt.Error("Reconnected to a Typha with different support for node resource updates.")
+			return
+		}
+		if !serverHello.SupportsResume {
+			logCxt.Error("Reconnected to a Typha that doesn't support resume.")
+			return
+		}
+	} else {
+		s.supportsNodeResourceUpdates = serverHello.SupportsNodeResourceUpdates
+		s.handshakeStatus.helloReceivedChan <- struct{}{}
+		s.helloReceivedBefore = true
+	}
 
 	// Check the SyncerType reported by the server.  If the server is too old to support SyncerType then
 	// the message will have an empty string in place of the SyncerType.  In that case we only proceed if
@@ -471,7 +609,23 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 		return
 	}
 
+	if s.options.FilterByNode && !serverHello.FilteringByNode {
+		logCxt.Info("Typha isn't filtering by node, expecting KVs for all nodes.")
+	}
+
+	if serverHello.Resumed {
+		logCxt.WithField("seqNo", s.resumeSeqNo).Info("Typha resumed our session, expecting only deltas.")
+	} else if s.resumeCacheID != "" {
+		// We've had a snapshot before, but Typha is sending a new one.  Record the keys that it contains so
+		// that we can delete the ones that have gone away once the snapshot is complete.  (If we were part
+		// way through a previous snapshot, its keys are in knownKeys so they're covered too.)
+		logCxt.Info("Typha couldn't resume our session, expecting a new snapshot.")
+		s.snapshotKeys = map[string]struct{}{}
+	}
+	handshakeDone = true
+
 	// Handshake done, start processing messages from the server.
+	defer s.closeZstdDecoder()
 	for cxt.Err() == nil {
 		msg, err := s.readMessageFromServer(cxt, logCxt)
 		if err != nil {
@@ -514,8 +668,22 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 				}
 				updates = append(updates, update)
 				keys = append(keys, kv.Key)
+				s.trackKey(kv.Key, update.Value == nil)
 			}
 			s.callbacks.OnUpdatesKeysKnown(updates, keys)
+		case syncproto.MsgSyncPosition:
+			if !s.options.ResumeOnReconnect {
+				log.Error("Server sent MsgSyncPosition but we signalled no support.")
+				return
+			}
+			logCxt.WithField("position", msg).Debug("Position update from Typha.")
+			if s.snapshotKeys != nil {
+				// The first position after a new snapshot marks the end of the snapshot.
+				s.deleteKeysMissingFromSnapshot(logCxt)
+			}
+			s.resumeCacheID = msg.CacheID
+			s.resumeSeqNo = msg.SequenceNumber
+			s.numPositionsReceived++
 		case syncproto.MsgDecoderRestart:
 			if s.options.DisableDecoderRestart {
 				log.Error("Server sent MsgDecoderRestart but we signalled no support.")
@@ -531,16 +699,77 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 			return
 		}
 	}
+	return
+}
+
+// trackKey records that the given key was created/updated or deleted, if we're tracking keys to support
+// resume.
+func (s *SyncerClient) trackKey(key string, deleted bool) {
+	if s.knownKeys == nil {
+		return
+	}
+	if deleted {
+		delete(s.knownKeys, key)
+	} else {
+		s.knownKeys[key] = struct{}{}
+	}
+	if s.snapshotKeys != nil {
+		s.snapshotKeys[key] = struct{}{}
+	}
+}
+
+// deleteKeysMissingFromSnapshot sends deletions for all the keys that we knew about before receiving a new
+// snapshot but that weren't in the new snapshot.
+func (s *SyncerClient) deleteKeysMissingFromSnapshot(logCxt *log.Entry) {
+	var updates []api.Update
+	var keys []string
+	for key := range s.knownKeys {
+		if _, ok := s.snapshotKeys[key]; ok {
+			continue
+		}
+		delete(s.knownKeys, key)
+		modelKey := model.KeyFromDefaultPath(key)
+		if modelKey == nil {
+			logCxt.WithField("key", key).Warn("Failed to parse stale key, unable to delete it.")
+			continue
+		}
+		updates = append(updates, api.Update{
+			KVPair:     model.KVPair{Key: modelKey},
+			UpdateType: api.UpdateTypeKVDeleted,
+		})
+		keys = append(keys, key)
+	}
+	s.snapshotKeys = nil
+	logCxt.WithField("numDeleted", len(updates)).Info("Finished receiving new snapshot, deleted stale keys.")
+	if len(updates) > 0 {
+		s.callbacks.OnUpdatesKeysKnown(updates, keys)
+	}
 }
 
 func (s *SyncerClient) restartDecoder(cxt context.Context, logCxt *log.Entry, msg syncproto.MsgDecoderRestart) error {
 	logCxt.WithField("msg", msg).Info("Server asked us to restart our decoder")
 	// Check if we should enable compression.
+	s.closeZstdDecoder()
 	switch msg.CompressionAlgorithm {
 	case syncproto.CompressionSnappy:
 		logCxt.Info("Server selected snappy compression.")
 		r := snappy.NewReader(s.connR)
 		s.decoder = gob.NewDecoder(r)
+	case syncproto.CompressionZstd:
+		logCxt.WithField("dictionarySize", len(msg.CompressionDictionary)).Info("Server selected zstd compression.")
+		opts := []zstd.DOption{
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderLowmem(true),
+		}
+		if msg.CompressionDictionary != nil {
+			opts = append(opts, zstd.WithDecoderDicts(msg.CompressionDictionary))
+		}
+		r, err := zstd.NewReader(s.connR, opts...)
+		if err != nil {
+			return err
+		}
+		s.zstdDecoder = r
+		s.decoder = gob.NewDecoder(r)
 	case "":
 		logCxt.Info("Server selected no compression.")
 		s.decoder = gob.NewDecoder(s.connR)
@@ -552,6 +781,24 @@ func (s *SyncerClient) restartDecoder(cxt context.Context, logCxt *log.Entry, ms
 	return err
 }
 
+// supportedCompressionAlgorithms returns the compression algorithms to list in our hello, most preferred
+// first.
+func (s *SyncerClient) supportedCompressionAlgorithms() []syncproto.CompressionAlgorithm {
+	if s.options.PreferredCompression == syncproto.CompressionZstd {
+		return []syncproto.CompressionAlgorithm{syncproto.CompressionZstd, syncproto.CompressionSnappy}
+	}
+	return []syncproto.CompressionAlgorithm{syncproto.CompressionSnappy, syncproto.CompressionZstd}
+}
+
+// closeZstdDecoder releases the zstd decoder for the current connection, if any.
+func (s *SyncerClient) closeZstdDecoder() {
+	if s.zstdDecoder == nil {
+		return
+	}
+	s.zstdDecoder.Close()
+	s.zstdDecoder = nil
+}
+
 // sendMessageToServer sends a single value-type MsgXYZ object to the server.  It updates the connection's
 // write deadline to ensure we don't block forever.  Logs errors via logConnectionFailure.
 func (s *SyncerClient) sendMessageToServer(cxt context.Context, logCxt *log.Entry, op string, message interface{}) error {
@@ -44,6 +44,13 @@ type TyphaConfig struct {
 	CAFile   string
 	CN       string
 	URISAN   string
+
+	// NodeFiltering asks Typha to filter out resources that belong to other nodes, for the syncer types
+	// that Typha has a filtering policy for.
+	NodeFiltering bool
+
+	// Compression is the compression algorithm to ask Typha to use: "snappy" (the default) or "zstd".
+	Compression string
 }
 
 // ReadTyphaConfig reads the TyphaConfig from environment variables.
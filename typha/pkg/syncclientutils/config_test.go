@@ -28,6 +28,8 @@ var _ = Describe("Test TyphaConfig", func() {
 	BeforeEach(func() {
 		os.Setenv("FELIX_TYPHACAFILE", "cafile")
 		os.Setenv("FELIX_TYPHAREADTIMEOUT", "100")
+		os.Setenv("FELIX_TYPHANODEFILTERING", "true")
+		os.Setenv("FELIX_TYPHACOMPRESSION", "zstd")
 
 	})
 
@@ -35,5 +37,7 @@ var _ = Describe("Test TyphaConfig", func() {
 		typhaConfig := syncclientutils.ReadTyphaConfig([]string{"FELIX_"})
 		Expect(typhaConfig.CAFile).To(Equal("cafile"))
 		Expect(typhaConfig.ReadTimeout.Seconds()).To(Equal(100.))
+		Expect(typhaConfig.NodeFiltering).To(BeTrue())
+		Expect(typhaConfig.Compression).To(Equal("zstd"))
 	})
 })
@@ -80,6 +80,10 @@ func MustStartSyncerClientIfTyphaConfigured(
 			CAFile:       typhaConfig.CAFile,
 			ServerCN:     typhaConfig.CN,
 			ServerURISAN: typhaConfig.URISAN,
+			// Reconnect and resume from where we left off rather than exiting.
+			ResumeOnReconnect:    true,
+			FilterByNode:         typhaConfig.NodeFiltering,
+			PreferredCompression: syncproto.CompressionAlgorithm(typhaConfig.Compression),
 		},
 	)
 	if err := typhaConnection.Start(context.Background()); err != nil {
@@ -83,6 +83,43 @@
 //	|<-----------------------|
 //	|                        |
 //
+// # Resuming a session
+//
+// If both sides support it, Typha follows each batch of KVs (including the end of
+// the initial snapshot) with a SyncPosition message, which records the ID of Typha's
+// snapshot cache and the sequence number of the last Breadcrumb that the client has
+// been sent.  If the connection is lost, the client can present that position in its
+// next ClientHello.  If the client reconnects to the same Typha and that Breadcrumb
+// is still in the cache's history, Typha sets Resumed in its ServerHello and skips
+// the snapshot, sending only the deltas since that Breadcrumb.  Otherwise, Typha
+// sends a full snapshot as usual and the client is responsible for deleting any keys
+// that it knew about but that were not in the new snapshot.
+//
+// Sequence numbers are local to one Typha instance so reconnecting to a different
+// instance (for example, after a Typha restart) always results in a full snapshot.
+//
+// # Node filtering
+//
+// A client may set NodeName in its ClientHello to ask Typha to filter the stream for
+// a client running on that node.  If Typha has a node filtering policy for the
+// requested syncer type, it sets FilteringByNode in its ServerHello and then drops
+// (or trims) the KVs that belong to other nodes and that such a client doesn't need.
+// Since the binary snapshot is shared by all clients, a filtered client is always
+// sent a streamed snapshot.  The filter depends only on the KV and the node name so
+// it is applied consistently to the snapshot, the deltas and a resumed session.
+//
+// # Compression
+//
+// The client lists the compression algorithms that it supports in its ClientHello,
+// in order of preference, and Typha picks the first one that it also supports.  The
+// chosen algorithm is sent in the MsgDecoderRestart that switches the stream over.
+// Snappy is cheap on CPU; zstd trades more CPU for a much better ratio, which is
+// worthwhile for clients on slow links.  With zstd, Typha may also send a dictionary
+// in the MsgDecoderRestart.  The dictionary is trained on the KVs in Typha's cache so
+// it captures the keys and JSON field names that recur in nearly every update; the
+// client must load it into its decoder before sending the ACK.  Typha only sends a
+// precalculated binary snapshot for snappy so zstd clients get a streamed snapshot.
+//
 // # Wire format
 //
 // The protocol uses gob to encode messages.  Each message is wrapped in an Envelope
@@ -244,6 +281,7 @@ type CompressionAlgorithm string
 
 const (
 	CompressionSnappy CompressionAlgorithm = "snappy"
+	CompressionZstd   CompressionAlgorithm = "zstd"
 )
 
 // MsgClientHello is the first message sent by the client after it opens the connection.  It begins the handshake.
@@ -257,10 +295,22 @@ type MsgClientHello struct {
 	// SyncerTypeFelix.
 	SyncerType SyncerType
 
-	SupportsDecoderRestart         bool
+	SupportsDecoderRestart bool
+	// SupportedCompressionAlgorithms lists the algorithms that the client supports, most preferred first.
+	// Older clients only list snappy.
 	SupportedCompressionAlgorithms []CompressionAlgorithm
 
 	ClientConnID uint64
+
+	// SupportsResume is set if the client understands MsgSyncPosition.  If ResumeCacheID is also set,
+	// the client is asking to resume from the Breadcrumb with sequence number ResumeSequenceNumber.
+	SupportsResume       bool
+	ResumeCacheID        string
+	ResumeSequenceNumber uint64
+
+	// NodeName, if set, asks the server to filter out KVs that belong to other nodes and that a client
+	// on this node doesn't need.  Older servers ignore it and send everything.
+	NodeName string
 }
 
 // MsgServerHello is the server's response to MsgClientHello.
@@ -275,6 +325,16 @@ type MsgServerHello struct {
 	SupportsNodeResourceUpdates bool
 
 	ServerConnID uint64
+
+	// SupportsResume is set if the server will send MsgSyncPosition messages.
+	SupportsResume bool
+	// Resumed is set if the server resumed the session from the position in the client's hello.  In that
+	// case, the server will not send a snapshot, only the deltas since that position.
+	Resumed bool
+
+	// FilteringByNode is set if the server is filtering the KVs that it sends according to the NodeName in
+	// the client's hello.
+	FilteringByNode bool
 }
 
 // MsgDecoderRestart is sent (currently only from server to client) to tell it to restart its decoder with new
@@ -282,6 +342,9 @@ type MsgServerHello struct {
 type MsgDecoderRestart struct {
 	Message              string
 	CompressionAlgorithm CompressionAlgorithm
+	// CompressionDictionary, if set, is the zstd dictionary (in zstd's dictionary format) that the server
+	// will use when compressing the stream.  Only used with CompressionZstd.
+	CompressionDictionary []byte
 }
 
 // MsgACK is a general-purpose ACK message, currently used during the initial handshake to acknowledge the
@@ -302,6 +365,13 @@ type MsgKVs struct {
 	KVs []SerializedUpdate
 }
 
+// MsgSyncPosition is sent to clients that support resume after the snapshot and after each batch of
+// deltas.  It records the position that the client has reached in the server's cache.
+type MsgSyncPosition struct {
+	CacheID        string
+	SequenceNumber uint64
+}
+
 func (m MsgKVs) String() string {
 	var b strings.Builder
 	const limit = 10
@@ -333,6 +403,7 @@ func init() {
 	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgPing", MsgPing{})
 	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgPong", MsgPong{})
 	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgKVs", MsgKVs{})
+	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgSyncPosition", MsgSyncPosition{})
 }
 
 func SerializeUpdate(u api.Update) (su SerializedUpdate, err error) {
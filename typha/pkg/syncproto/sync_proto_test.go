@@ -78,3 +78,22 @@ func TestEncodeCanned(t *testing.T) {
 
 	t.Logf("%q", b2.String())
 }
+
+// TestSyncPositionRoundTrip checks that a resume position survives the trip through an Envelope.
+func TestSyncPositionRoundTrip(t *testing.T) {
+	RegisterTestingT(t)
+	var b bytes.Buffer
+	sent := Envelope{
+		Message: MsgSyncPosition{
+			CacheID:        "cache-id",
+			SequenceNumber: 1234,
+		},
+	}
+	err := gob.NewEncoder(&b).Encode(&sent)
+	Expect(err).NotTo(HaveOccurred())
+
+	var received Envelope
+	err = gob.NewDecoder(&b).Decode(&received)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(received).To(Equal(sent))
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncserver
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/typha/pkg/promutils"
+	"github.com/projectcalico/calico/typha/pkg/snapcache"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+)
+
+var (
+	counterVecBytesUncompressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_client_bytes_uncompressed",
+		Help: "Total number of bytes sent to clients over compressed streams, before compression.",
+	}, []string{"syncer", "compression"})
+	counterVecBytesCompressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_client_bytes_compressed",
+		Help: "Total number of bytes sent to clients over compressed streams, after compression.",
+	}, []string{"syncer", "compression"})
+	gaugeVecZstdDictionaryBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "typha_zstd_dictionary_bytes",
+		Help: "Size of the most recently trained zstd dictionary.",
+	}, []string{"syncer"})
+)
+
+func init() {
+	prometheus.MustRegister(counterVecBytesUncompressed)
+	prometheus.MustRegister(counterVecBytesCompressed)
+	for _, st := range syncproto.AllSyncerTypes {
+		for _, alg := range []syncproto.CompressionAlgorithm{syncproto.CompressionSnappy, syncproto.CompressionZstd} {
+			counterVecBytesUncompressed.WithLabelValues(string(st), string(alg))
+			counterVecBytesCompressed.WithLabelValues(string(st), string(alg))
+		}
+	}
+	prometheus.MustRegister(gaugeVecZstdDictionaryBytes)
+	promutils.PreCreateGaugePerSyncer(gaugeVecZstdDictionaryBytes)
+}
+
+const (
+	// zstdWindowSize limits the memory used by each zstd stream.  The default window is several MB, which
+	// adds up quickly with thousands of connections, and our messages are small so a large window gains
+	// little.
+	zstdWindowSize = 1 << 20
+
+	// zstdDictMaxSamples is the number of KVs that we sample from the cache to train a dictionary.
+	zstdDictMaxSamples = 2000
+	// zstdDictRetrainInterval is how long we use a dictionary for new connections before retraining it on
+	// the current contents of the cache.
+	zstdDictRetrainInterval = 1 * time.Hour
+	// zstdDictRetryInterval is how long we wait before retrying after failing to train a dictionary, for
+	// example, because the cache was nearly empty.
+	zstdDictRetryInterval = 1 * time.Minute
+)
+
+// zstdEncoderOptions returns the options for a per-connection zstd stream.
+func zstdEncoderOptions(level int, dictionary []byte) []zstd.EOption {
+	opts := []zstd.EOption{
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1),
+		zstd.WithWindowSize(zstdWindowSize),
+		zstd.WithLowerEncoderMem(true),
+	}
+	if dictionary != nil {
+		opts = append(opts, zstd.WithEncoderDict(dictionary))
+	}
+	return opts
+}
+
+// newCompressingWriter wraps bw with a compressor for the given algorithm.  It returns the writer to pass
+// to the gob encoder and a function that flushes both the compressor and bw.  The sizes of the data
+// before and after compression are recorded in stats.
+func newCompressingWriter(
+	alg syncproto.CompressionAlgorithm,
+	bw *bufio.Writer,
+	zstdLevel int,
+	zstdDictionary []byte,
+	stats *compressionStats,
+) (io.Writer, func() error, error) {
+	if alg != syncproto.CompressionSnappy && alg != syncproto.CompressionZstd {
+		return bw, bw.Flush, nil
+	}
+	compressedW := &countingWriter{w: bw, count: &stats.compressedBytes, counter: stats.counterCompressed}
+	var w io.Writer
+	var flush func() error
+	if alg == syncproto.CompressionSnappy {
+		sw := snappy.NewBufferedWriter(compressedW)
+		w, flush = sw, sw.Flush
+	} else {
+		zw, err := zstd.NewWriter(compressedW, zstdEncoderOptions(zstdLevel, zstdDictionary)...)
+		if err != nil {
+			return nil, nil, err
+		}
+		w, flush = zw, zw.Flush
+	}
+	uncompressedW := &countingWriter{w: w, count: &stats.uncompressedBytes, counter: stats.counterUncompressed}
+	return uncompressedW, func() error {
+		err := flush()
+		if err != nil {
+			return err
+		}
+		return bw.Flush()
+	}, nil
+}
+
+// compressionStats tracks the number of bytes that one connection has sent before and after compression.
+type compressionStats struct {
+	uncompressedBytes atomic.Uint64
+	compressedBytes   atomic.Uint64
+
+	counterUncompressed prometheus.Counter
+	counterCompressed   prometheus.Counter
+}
+
+func newCompressionStats(syncerType syncproto.SyncerType, alg syncproto.CompressionAlgorithm) *compressionStats {
+	return &compressionStats{
+		counterUncompressed: counterVecBytesUncompressed.WithLabelValues(string(syncerType), string(alg)),
+		counterCompressed:   counterVecBytesCompressed.WithLabelValues(string(syncerType), string(alg)),
+	}
+}
+
+// Ratio returns the ratio of uncompressed to compressed bytes, or 0 if nothing has been sent yet.
+func (s *compressionStats) Ratio() float64 {
+	compressed := s.compressedBytes.Load()
+	if compressed == 0 {
+		return 0
+	}
+	return float64(s.uncompressedBytes.Load()) / float64(compressed)
+}
+
+// countingWriter counts the bytes written through it, in count and, optionally, a Prometheus counter.
+type countingWriter struct {
+	w       io.Writer
+	count   *atomic.Uint64
+	counter prometheus.Counter
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.count.Add(uint64(n))
+	if c.counter != nil {
+		c.counter.Add(float64(n))
+	}
+	return n, err
+}
+
+// zstdDictionary trains and caches the zstd dictionary for one syncer type.  The dictionary is trained on a
+// sample of the KVs in the cache; since most KVs of a given type share their key prefixes and JSON field
+// names, a dictionary lets zstd compress even small deltas well.  The dictionary is sent to each client in
+// its MsgDecoderRestart so the client doesn't need to train one of its own.
+type zstdDictionary struct {
+	syncerType syncproto.SyncerType
+	maxSize    int
+	level      int
+
+	lock        sync.Mutex
+	dictionary  []byte
+	lastAttempt time.Time
+	gaugeSize   prometheus.Gauge
+}
+
+func newZstdDictionary(syncerType syncproto.SyncerType, maxSize int, level int) *zstdDictionary {
+	return &zstdDictionary{
+		syncerType: syncerType,
+		maxSize:    maxSize,
+		level:      level,
+		gaugeSize:  gaugeVecZstdDictionaryBytes.WithLabelValues(string(syncerType)),
+	}
+}
+
+// Get returns the current dictionary, (re)training it from the given cache if it is missing or stale.  It
+// returns nil if there isn't enough data to train a dictionary.
+func (d *zstdDictionary) Get(cache BreadcrumbProvider) []byte {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	interval := zstdDictRetrainInterval
+	if d.dictionary == nil {
+		interval = zstdDictRetryInterval
+	}
+	if !d.lastAttempt.IsZero() && time.Since(d.lastAttempt) < interval {
+		return d.dictionary
+	}
+	d.lastAttempt = time.Now()
+
+	logCxt := log.WithField("syncer", d.syncerType)
+	samples := sampleKVs(cache.CurrentBreadcrumb(), zstdDictMaxSamples)
+	dictionary, err := dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: d.maxSize,
+		HashBytes:   6,
+		ZstdLevel:   zstd.EncoderLevelFromZstd(d.level),
+	})
+	if err != nil {
+		// Most likely the cache doesn't have enough data yet.  Keep using the old dictionary, if any.
+		logCxt.WithError(err).WithField("numSamples", len(samples)).Debug("Failed to train zstd dictionary.")
+		return d.dictionary
+	}
+	logCxt.WithFields(log.Fields{
+		"numSamples": len(samples),
+		"size":       len(dictionary),
+	}).Info("Trained zstd dictionary.")
+	d.dictionary = dictionary
+	d.gaugeSize.Set(float64(len(dictionary)))
+	return d.dictionary
+}
+
+// sampleKVs returns the keys and values of up to maxSamples KVs, evenly spaced through the breadcrumb's
+// snapshot.
+func sampleKVs(breadcrumb *snapcache.Breadcrumb, maxSamples int) [][]byte {
+	if breadcrumb == nil || breadcrumb.KVs == nil {
+		return nil
+	}
+	step := breadcrumb.KVs.Len()/maxSamples + 1
+	samples := make([][]byte, 0, min(breadcrumb.KVs.Len(), maxSamples))
+	i := 0
+	breadcrumb.KVs.Ascend(func(upd syncproto.SerializedUpdate) bool {
+		if i%step == 0 {
+			sample := make([]byte, 0, len(upd.Key)+len(upd.Value))
+			sample = append(sample, upd.Key...)
+			sample = append(sample, upd.Value...)
+			samples = append(samples, sample)
+		}
+		i++
+		return len(samples) < maxSamples
+	})
+	return samples
+}
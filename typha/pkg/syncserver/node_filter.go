@@ -0,0 +1,254 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/typha/pkg/promutils"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+)
+
+var (
+	counterVecNodeFilterKVsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_node_filter_kvs_dropped",
+		Help: "Total number of KVs that were not sent to clients because they belong to another node.",
+	}, []string{"syncer"})
+	counterVecNodeFilterKVsTrimmed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_node_filter_kvs_trimmed",
+		Help: "Total number of KVs that were sent to clients with fields that they don't need removed.",
+	}, []string{"syncer"})
+	counterVecNodeFilterBytesSaved = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_node_filter_bytes_saved",
+		Help: "Total number of (uncompressed) key and value bytes that were not sent to clients due to node " +
+			"filtering.",
+	}, []string{"syncer"})
+	gaugeVecNumConnectionsNodeFiltered = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "typha_connections_node_filtered",
+		Help: "Number of streaming client connections that are filtered by node.",
+	}, []string{"syncer"})
+)
+
+func init() {
+	prometheus.MustRegister(counterVecNodeFilterKVsDropped)
+	promutils.PreCreateCounterPerSyncer(counterVecNodeFilterKVsDropped)
+	prometheus.MustRegister(counterVecNodeFilterKVsTrimmed)
+	promutils.PreCreateCounterPerSyncer(counterVecNodeFilterKVsTrimmed)
+	prometheus.MustRegister(counterVecNodeFilterBytesSaved)
+	promutils.PreCreateCounterPerSyncer(counterVecNodeFilterBytesSaved)
+	prometheus.MustRegister(gaugeVecNumConnectionsNodeFiltered)
+	promutils.PreCreateGaugePerSyncer(gaugeVecNumConnectionsNodeFiltered)
+}
+
+const (
+	hostPathPrefix = "/calico/v1/host/"
+	nodePathPrefix = "/calico/resources/v3/projectcalico.org/nodes/"
+
+	// maxTrimCacheSize bounds the number of trimmed values that a nodeFilter remembers.  Entries are removed
+	// when a client sees the deletion of the KV but a deletion may be missed if no filtered client is
+	// connected at the time so we clear the cache if it gets too big.
+	maxTrimCacheSize = 200000
+)
+
+// nodeFilterPolicy describes which per-node KVs a particular type of client needs when they belong to nodes
+// other than its own.  All decisions are based on the key so that a KV can't move from "sent" to "not sent"
+// (which would need us to send a deletion).
+type nodeFilterPolicy struct {
+	// nodeOf returns the node that the KV with the given key belongs to or "" if it is not a per-node KV.
+	nodeOf func(key string) string
+	// neededByOtherNodes, if non-nil, returns true for per-node KVs that clients on other nodes still need.
+	// If nil, per-node KVs are only sent to clients on their own node.
+	neededByOtherNodes func(key string) bool
+	// trimForOtherNodes, if non-nil, removes the fields that clients on other nodes don't need from the value
+	// of a per-node KV.  It returns nil if the KV should be sent unchanged.
+	trimForOtherNodes func(key string, value []byte) ([]byte, error)
+}
+
+// nodeFilterPolicies contains the policy for each syncer type that supports node filtering.
+//
+// The BGP syncer has no policy because BIRD needs the routing information for every node.  Similarly, the
+// node status syncer has no policy because the node that a CalicoNodeStatus belongs to is in its value
+// rather than its key.
+var nodeFilterPolicies = map[syncproto.SyncerType]nodeFilterPolicy{
+	syncproto.SyncerTypeFelix: {
+		// Felix needs remote endpoints for IP sets and routes and remote host IPs, Wireguard keys and
+		// VXLAN tunnel config for routes and tunnels.  It ignores other nodes' config and status.
+		nodeOf:             nodeFromHostPath,
+		neededByOtherNodes: felixNeedsOtherNodesKey,
+		trimForOtherNodes:  trimWorkloadEndpointForOtherNodes,
+	},
+	syncproto.SyncerTypeTunnelIPAllocation: {
+		// The tunnel IP allocator only looks at its own Node resource.
+		nodeOf: nodeFromNodeResourcePath,
+	},
+}
+
+// felixOtherNodesConfig contains the per-host config names that Felix needs for nodes other than its own.
+var felixOtherNodesConfig = map[string]bool{
+	"IPv4VXLANTunnelAddr":  true,
+	"VXLANTunnelMACAddr":   true,
+	"IPv6VXLANTunnelAddr":  true,
+	"VXLANTunnelMACAddrV6": true,
+}
+
+// nodeFromHostPath returns the host from a "/calico/v1/host/<host>/..." key.
+func nodeFromHostPath(key string) string {
+	if !strings.HasPrefix(key, hostPathPrefix) {
+		return ""
+	}
+	node, _, _ := strings.Cut(key[len(hostPathPrefix):], "/")
+	return node
+}
+
+// nodeFromNodeResourcePath returns the name of the node from a Node resource key.
+func nodeFromNodeResourcePath(key string) string {
+	if !strings.HasPrefix(key, nodePathPrefix) {
+		return ""
+	}
+	return key[len(nodePathPrefix):]
+}
+
+func felixNeedsOtherNodesKey(key string) bool {
+	rest := key[len(hostPathPrefix):]
+	_, rest, _ = strings.Cut(rest, "/")
+	if name, ok := strings.CutPrefix(rest, "config/"); ok {
+		return felixOtherNodesConfig[name]
+	}
+	return true
+}
+
+// trimWorkloadEndpointForOtherNodes removes the fields of a WorkloadEndpoint that Felix only uses for its
+// own endpoints.  Felix uses the remaining fields for IP sets (labels, profiles, IPs and named ports),
+// routes (IPs) and validation (name and spoofing prefixes).
+func trimWorkloadEndpointForOtherNodes(key string, value []byte) ([]byte, error) {
+	if !strings.Contains(key, "/workload/") {
+		return nil, nil
+	}
+	var wep model.WorkloadEndpoint
+	if err := json.Unmarshal(value, &wep); err != nil {
+		return nil, err
+	}
+	wep.State = ""
+	wep.ActiveInstanceID = ""
+	wep.Mac = nil
+	wep.IPv4Gateway = nil
+	wep.IPv6Gateway = nil
+	wep.Annotations = nil
+	wep.QoSControls = nil
+	return json.Marshal(&wep)
+}
+
+// nodeFilter applies a nodeFilterPolicy to the KVs sent to the clients of one syncer type.  It is shared by
+// all the connections of that type so that each value is only trimmed once.
+type nodeFilter struct {
+	policy nodeFilterPolicy
+
+	trimLock sync.Mutex
+	trimmed  map[string]trimmedValue
+
+	counterKVsDropped prometheus.Counter
+	counterKVsTrimmed prometheus.Counter
+	counterBytesSaved prometheus.Counter
+	gaugeConnections  prometheus.Gauge
+}
+
+type trimmedValue struct {
+	original []byte
+	trimmed  []byte
+}
+
+// newNodeFilter returns a nodeFilter for the given syncer type or nil if there is no policy for that type.
+func newNodeFilter(syncerType syncproto.SyncerType) *nodeFilter {
+	policy, ok := nodeFilterPolicies[syncerType]
+	if !ok {
+		return nil
+	}
+	return &nodeFilter{
+		policy:            policy,
+		trimmed:           map[string]trimmedValue{},
+		counterKVsDropped: counterVecNodeFilterKVsDropped.WithLabelValues(string(syncerType)),
+		counterKVsTrimmed: counterVecNodeFilterKVsTrimmed.WithLabelValues(string(syncerType)),
+		counterBytesSaved: counterVecNodeFilterBytesSaved.WithLabelValues(string(syncerType)),
+		gaugeConnections:  gaugeVecNumConnectionsNodeFiltered.WithLabelValues(string(syncerType)),
+	}
+}
+
+// Filter returns the version of the update to send to a client on the given node and whether it should be
+// sent at all.
+func (f *nodeFilter) Filter(nodeName string, upd syncproto.SerializedUpdate) (syncproto.SerializedUpdate, bool) {
+	owner := f.policy.nodeOf(upd.Key)
+	if owner == "" || owner == nodeName {
+		return upd, true
+	}
+	if f.policy.neededByOtherNodes == nil || !f.policy.neededByOtherNodes(upd.Key) {
+		f.counterKVsDropped.Inc()
+		f.counterBytesSaved.Add(float64(len(upd.Key) + len(upd.Value)))
+		return upd, false
+	}
+	if f.policy.trimForOtherNodes == nil {
+		return upd, true
+	}
+	if upd.Value == nil {
+		f.forgetTrimmed(upd.Key)
+		return upd, true
+	}
+	trimmed := f.trim(upd.Key, upd.Value)
+	if trimmed == nil || len(trimmed) >= len(upd.Value) {
+		return upd, true
+	}
+	f.counterKVsTrimmed.Inc()
+	f.counterBytesSaved.Add(float64(len(upd.Value) - len(trimmed)))
+	upd.Value = trimmed
+	return upd, true
+}
+
+// trim returns the trimmed version of the given value, reusing the result from a previous call if the value
+// hasn't changed.  It returns nil if the value should be sent unchanged.
+func (f *nodeFilter) trim(key string, value []byte) []byte {
+	f.trimLock.Lock()
+	cached, ok := f.trimmed[key]
+	f.trimLock.Unlock()
+	if ok && bytes.Equal(cached.original, value) {
+		return cached.trimmed
+	}
+
+	trimmed, err := f.policy.trimForOtherNodes(key, value)
+	if err != nil {
+		log.WithError(err).WithField("key", key).Warn("Failed to trim value for node filtering; sending it unchanged.")
+		trimmed = nil
+	}
+
+	f.trimLock.Lock()
+	defer f.trimLock.Unlock()
+	if len(f.trimmed) >= maxTrimCacheSize {
+		log.WithField("size", len(f.trimmed)).Info("Node filter's trim cache is full, clearing it.")
+		f.trimmed = map[string]trimmedValue{}
+	}
+	f.trimmed[key] = trimmedValue{original: value, trimmed: trimmed}
+	return trimmed
+}
+
+func (f *nodeFilter) forgetTrimmed(key string) {
+	f.trimLock.Lock()
+	defer f.trimLock.Unlock()
+	delete(f.trimmed, key)
+}
@@ -191,6 +191,7 @@ func (s *SnappySnapshotCache) writeDataToSnapshot(snap *snapshot) {
 		context.Background(),
 		s.logCtx.WithField("destination", "compressed in-memory cache"),
 		snap.crumb,
+		nil, // The binary snapshot is shared by all clients so it is never filtered.
 		writeMsg,
 		1000, // Allow bigger messages in the snapshot.
 	)
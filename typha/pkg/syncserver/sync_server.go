@@ -26,11 +26,12 @@ import (
 	"math"
 	"net"
 	"os"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/golang/snappy"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
@@ -78,6 +79,11 @@ var (
 		Help: "Total number of connections that made use of the grace period to catch up after sending the initial " +
 			"snapshot.",
 	}, []string{"syncer"})
+	counterVecConnectionsResumed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_connections_resumed",
+		Help: "Total number of connections that resumed from the client's last position instead of receiving " +
+			"a snapshot.",
+	}, []string{"syncer"})
 )
 
 func init() {
@@ -89,6 +95,8 @@ func init() {
 	promutils.PreCreateGaugePerSyncer(gaugeVecNumConnectionsStreaming)
 	prometheus.MustRegister(counterVecGracePeriodUsed)
 	promutils.PreCreateCounterPerSyncer(counterVecGracePeriodUsed)
+	prometheus.MustRegister(counterVecConnectionsResumed)
+	promutils.PreCreateCounterPerSyncer(counterVecConnectionsResumed)
 }
 
 const (
@@ -103,6 +111,7 @@ const (
 	defaultDropInterval                   = 1 * time.Second
 	defaultShutdownTimeout                = 300 * time.Second
 	defaultMaxConns                       = math.MaxInt32
+	defaultZstdLevel                      = 3
 	PortRandom                            = -1
 )
 
@@ -122,6 +131,8 @@ type Server struct {
 	connIDToConn map[uint64]*connection
 
 	perSyncerConnMetrics map[syncproto.SyncerType]perSyncerConnMetrics
+	nodeFilters          map[syncproto.SyncerType]*nodeFilter
+	zstdDictionaries     map[syncproto.SyncerType]*zstdDictionary
 
 	Finished sync.WaitGroup
 }
@@ -130,6 +141,14 @@ type BreadcrumbProvider interface {
 	CurrentBreadcrumb() *snapcache.Breadcrumb
 }
 
+// ResumableBreadcrumbProvider is a BreadcrumbProvider that keeps a history of recent Breadcrumbs, allowing
+// clients to resume their session after a reconnect.
+type ResumableBreadcrumbProvider interface {
+	BreadcrumbProvider
+	ID() string
+	BreadcrumbBySequenceNumber(seqNo uint64) *snapcache.Breadcrumb
+}
+
 type Config struct {
 	Port                           int
 	MaxMessageSize                 int
@@ -153,6 +172,14 @@ type Config struct {
 	ClientURISAN                   string
 	WriteBufferSize                int
 
+	// DisableZstd stops the server from choosing zstd compression, even if the client prefers it.
+	DisableZstd bool
+	// ZstdLevel is the zstd compression level to use, on zstd's usual 1-22 scale.
+	ZstdLevel int
+	// ZstdDictionarySize is the maximum size of the zstd dictionary that the server trains for each syncer
+	// type.  If zero, no dictionary is used.
+	ZstdDictionarySize int
+
 	// DebugLogWrites tells the server to wrap each connection with a Writer that
 	// logs every write.  Intended only for use in tests!
 	DebugLogWrites bool
@@ -253,6 +280,13 @@ func (c *Config) ApplyDefaults() {
 		}).Info("Defaulting MaxConns.")
 		c.MaxConns = defaultMaxConns
 	}
+	if c.ZstdLevel <= 0 {
+		log.WithFields(log.Fields{
+			"value":   c.ZstdLevel,
+			"default": defaultZstdLevel,
+		}).Info("Defaulting ZstdLevel.")
+		c.ZstdLevel = defaultZstdLevel
+	}
 	if c.Port == 0 {
 		// We use 0 to mean "use the default port".
 		log.WithFields(log.Fields{
@@ -288,11 +322,19 @@ func New(caches map[syncproto.SyncerType]BreadcrumbProvider, config Config) *Ser
 		connIDToConn:         map[uint64]*connection{},
 		listeningC:           make(chan struct{}),
 		perSyncerConnMetrics: map[syncproto.SyncerType]perSyncerConnMetrics{},
+		nodeFilters:          map[syncproto.SyncerType]*nodeFilter{},
+		zstdDictionaries:     map[syncproto.SyncerType]*zstdDictionary{},
 	}
 
 	s.binSnapCaches[syncproto.CompressionSnappy] = map[syncproto.SyncerType]snapshotCache{}
 	for st, cache := range caches {
 		s.perSyncerConnMetrics[st] = makePerSyncerConnMetrics(st)
+		if f := newNodeFilter(st); f != nil {
+			s.nodeFilters[st] = f
+		}
+		if config.ZstdDictionarySize > 0 {
+			s.zstdDictionaries[st] = newZstdDictionary(st, config.ZstdDictionarySize, config.ZstdLevel)
+		}
 		s.binSnapCaches[syncproto.CompressionSnappy][st] = NewSnappySnapCache(string(st), cache, config.BinarySnapshotTimeout, config.WriteTimeout)
 	}
 
@@ -440,6 +482,7 @@ func (s *Server) serve(cxt context.Context) {
 		}
 		connection := &connection{
 			ID:              connID,
+			connectedAt:     time.Now(),
 			config:          &s.config,
 			allCaches:       s.caches,
 			allSnapshotters: s.binSnapCaches,
@@ -452,12 +495,16 @@ func (s *Server) serve(cxt context.Context) {
 				"connID": connID,
 			}),
 
-			encoder:     gob.NewEncoder(connW),
 			flushWriter: func() error { return nil },
 			readC:       make(chan interface{}),
 
-			allMetrics: s.perSyncerConnMetrics,
+			allMetrics:          s.perSyncerConnMetrics,
+			allNodeFilters:      s.nodeFilters,
+			allZstdDictionaries: s.zstdDictionaries,
 		}
+		// Count all the bytes that we send, after compression (but before TLS).
+		connection.connW = &countingWriter{w: connW, count: &connection.bytesSent}
+		connection.encoder = gob.NewEncoder(connection.connW)
 		// Track the connection's lifetime in connIDToConn so we can kill it later if needed.
 		s.recordConnection(connection)
 		// Defer to the connection-handler.
@@ -585,27 +632,16 @@ func (s *Server) handleGracefulShutDown(cxt context.Context, serverCancelFn cont
 	} else {
 		logCxt.WithField("dropInterval", dropInterval).Info("Calculated drop interval from shutdown timeout.")
 	}
-	ticker := jitter.NewTicker(dropInterval*95/100, dropInterval*10/100)
-	for {
-		select {
-		case <-ticker.C:
-			numConns := s.NumActiveConnections()
-			logCxt := logCxt.WithField("remainingConns", numConns)
-			dropped := s.TerminateRandomConnection(logCxt, "graceful shutdown in progress")
-			if numConns <= 1 || !dropped {
-				logCxt.Info("Finished closing connections, completing shut down...")
-				// Note: we release the lock between NumActiveConnections and TerminateRandomConnection so,
-				// in theory, if we haven't yet closed the listen socket, a new connection could just have been added.
-				// We don't need to worry about that because serverCancelFn will shut down all remaining connections
-				// by canceling their parent context.
-				serverCancelFn()
-				return
-			}
-		case <-cxt.Done():
-			logCxt.Info("Context asked us to stop")
-			return
-		}
+	s.dropConnectionsGradually(cxt, logCxt, math.MaxInt, dropInterval, "graceful shutdown in progress")
+	if cxt.Err() != nil {
+		return
 	}
+	logCxt.Info("Finished closing connections, completing shut down...")
+	// Note: we release the lock between NumActiveConnections and TerminateRandomConnection so,
+	// in theory, if we haven't yet closed the listen socket, a new connection could just have been added.
+	// We don't need to worry about that because serverCancelFn will shut down all remaining connections
+	// by canceling their parent context.
+	serverCancelFn()
 }
 
 func (s *Server) NumActiveConnections() int {
@@ -633,6 +669,93 @@ func (s *Server) TerminateRandomConnection(logCtx *log.Entry, reason string) boo
 	return false
 }
 
+// TerminateConnection drops the connection with the given ID.  Returns false if there is no such connection.
+func (s *Server) TerminateConnection(logCtx *log.Entry, connID uint64, reason string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	conn := s.connIDToConn[connID]
+	if conn == nil {
+		return false
+	}
+	logCtx.WithField("connID", connID).Infof("Closing connection; reason: %s.", reason)
+	conn.cancelCxt()
+	return true
+}
+
+// DrainConnections drops the given percentage of the current connections in the background.  Like a graceful
+// shutdown, it drops one connection at a time, at random, so that the clients reconnect gradually (usually to
+// other Typha instances).  The connections are dropped at the rebalancing DropInterval.  Returns the number of
+// connections that will be dropped.
+func (s *Server) DrainConnections(cxt context.Context, percent float64, reason string) int {
+	numToDrop := int(math.Ceil(float64(s.NumActiveConnections()) * percent / 100))
+	if numToDrop <= 0 {
+		return 0
+	}
+	logCxt := log.WithFields(log.Fields{
+		"thread":    "drain",
+		"percent":   percent,
+		"numToDrop": numToDrop,
+	})
+	logCxt.Info("Draining connections...")
+	s.Finished.Add(1)
+	go func() {
+		defer s.Finished.Done()
+		numDropped := s.dropConnectionsGradually(cxt, logCxt, numToDrop, s.config.DropInterval, reason)
+		logCxt.WithField("numDropped", numDropped).Info("Finished draining connections.")
+	}()
+	return numToDrop
+}
+
+// dropConnectionsGradually drops up to maxToDrop connections at random, one every dropInterval (with jitter).
+// It returns the number of connections dropped once it has dropped maxToDrop connections, there are no more
+// connections to drop, or the context is canceled.
+func (s *Server) dropConnectionsGradually(
+	cxt context.Context,
+	logCxt *log.Entry,
+	maxToDrop int,
+	dropInterval time.Duration,
+	reason string,
+) (numDropped int) {
+	ticker := jitter.NewTicker(dropInterval*95/100, dropInterval*10/100)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			numConns := s.NumActiveConnections()
+			logCxt := logCxt.WithField("remainingConns", numConns)
+			dropped := s.TerminateRandomConnection(logCxt, reason)
+			if dropped {
+				numDropped++
+			}
+			if numConns <= 1 || !dropped || numDropped >= maxToDrop {
+				return
+			}
+		case <-cxt.Done():
+			logCxt.Info("Context asked us to stop")
+			return
+		}
+	}
+}
+
+// Connections returns information about each of the current connections, in order of connection ID.
+func (s *Server) Connections() []ConnectionInfo {
+	s.lock.Lock()
+	conns := make([]*connection, 0, len(s.connIDToConn))
+	for _, conn := range s.connIDToConn {
+		conns = append(conns, conn)
+	}
+	s.lock.Unlock()
+
+	infos := make([]ConnectionInfo, 0, len(conns))
+	for _, conn := range conns {
+		infos = append(infos, conn.Info())
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
 func (s *Server) reportHealth() {
 	if s.config.HealthAggregator != nil {
 		s.config.HealthAggregator.Report(healthName, &health.HealthReport{Live: true})
@@ -652,8 +775,9 @@ func (s *Server) ShutDownGracefully() {
 }
 
 type connection struct {
-	ID     uint64
-	config *Config
+	ID          uint64
+	config      *Config
+	connectedAt time.Time
 
 	// cxt is the per-connection context.
 	cxt context.Context
@@ -672,8 +796,14 @@ type connection struct {
 	syncerType      syncproto.SyncerType
 	conn            net.Conn
 	// connW is the writer to use to send things to the client.  It may be the net.Conn itself or a wrapper
-	// around it.
-	connW io.Writer
+	// around it.  bytesSent counts the bytes written to connW.
+	connW     io.Writer
+	bytesSent atomic.Uint64
+
+	// clientDetails is set once the handshake is complete.  sentBreadcrumb is the latest Breadcrumb that
+	// we've sent (or are sending) to the client.  Both are read by Info(), from another goroutine.
+	clientDetails  atomic.Pointer[clientDetails]
+	sentBreadcrumb atomic.Pointer[snapcache.Breadcrumb]
 
 	// writeLock is used to protect calls that write to the connection after the initial synchronous handshake.
 	// The delta-sending goroutine and the pinger both write to the connection.
@@ -687,12 +817,93 @@ type connection struct {
 	chosenCompression            syncproto.CompressionAlgorithm
 	clientSupportsDecoderRestart bool
 
+	// zstdDictionary is the dictionary that we sent to the client in our MsgDecoderRestart, if any.
+	// compressionStats is set once we've switched to a compressed stream.
+	allZstdDictionaries map[syncproto.SyncerType]*zstdDictionary
+	zstdDictionary      []byte
+	compressionStats    atomic.Pointer[compressionStats]
+
+	// clientSupportsResume is set if the client understands MsgSyncPosition and our cache supports resume.
+	// cacheID is then the ID of our cache.  resumeFrom is the Breadcrumb that the client asked to resume
+	// from, if it is still in the cache's history.
+	clientSupportsResume bool
+	cacheID              string
+	resumeFrom           *snapcache.Breadcrumb
+
+	// nodeFilter is set if the client asked us to filter by node and there is a node filtering policy for its
+	// syncer type.  nodeName is then the client's node.
+	allNodeFilters map[syncproto.SyncerType]*nodeFilter
+	nodeFilter     *nodeFilter
+	nodeName       string
+
 	// Similarly to allCaches, allMetrics contains all the metrics relevant to a particular syncer.  We copy one
 	// of them to the unnamed field after the handshake.
 	allMetrics map[syncproto.SyncerType]perSyncerConnMetrics
 	perSyncerConnMetrics
 }
 
+// clientDetails holds the information that the client sent in its hello, along with the choices that we made
+// during the handshake.
+type clientDetails struct {
+	hostname    string
+	version     string
+	info        string
+	syncerType  syncproto.SyncerType
+	compression syncproto.CompressionAlgorithm
+	nodeName    string
+}
+
+// ConnectionInfo describes a client connection, for the admin API.
+type ConnectionInfo struct {
+	ID          uint64                         `json:"id"`
+	RemoteAddr  string                         `json:"remoteAddr"`
+	ConnectedAt time.Time                      `json:"connectedAt"`
+	Hostname    string                         `json:"hostname,omitempty"`
+	Version     string                         `json:"version,omitempty"`
+	Info        string                         `json:"info,omitempty"`
+	SyncerType  syncproto.SyncerType           `json:"syncerType,omitempty"`
+	Compression syncproto.CompressionAlgorithm `json:"compression,omitempty"`
+	NodeName    string                         `json:"nodeName,omitempty"`
+	// Streaming is false until the handshake is complete.
+	Streaming bool `json:"streaming"`
+	// FallBehindSecs is how far the client is behind the latest Breadcrumb in the cache.
+	FallBehindSecs float64 `json:"fallBehindSecs"`
+	// BytesSent is the number of bytes sent to the client, after compression.
+	BytesSent uint64 `json:"bytesSent"`
+	// CompressionRatio is the ratio of uncompressed to compressed bytes for the compressed part of the
+	// stream, or 0 if the stream isn't compressed.
+	CompressionRatio float64 `json:"compressionRatio,omitempty"`
+}
+
+// Info returns a snapshot of the connection's state.  It may be called from any goroutine.
+func (h *connection) Info() ConnectionInfo {
+	info := ConnectionInfo{
+		ID:          h.ID,
+		RemoteAddr:  h.conn.RemoteAddr().String(),
+		ConnectedAt: h.connectedAt,
+		BytesSent:   h.bytesSent.Load(),
+	}
+	details := h.clientDetails.Load()
+	if details == nil {
+		return info
+	}
+	info.Hostname = details.hostname
+	info.Version = details.version
+	info.Info = details.info
+	info.SyncerType = details.syncerType
+	info.Compression = details.compression
+	info.NodeName = details.nodeName
+	if sent := h.sentBreadcrumb.Load(); sent != nil {
+		info.Streaming = true
+		latest := h.allCaches[details.syncerType].CurrentBreadcrumb()
+		info.FallBehindSecs = latest.Timestamp.Sub(sent.Timestamp).Seconds()
+	}
+	if stats := h.compressionStats.Load(); stats != nil {
+		info.CompressionRatio = stats.Ratio()
+	}
+	return info
+}
+
 type snapshotCache interface {
 	SendSnapshot(ctx context.Context, w io.Writer, conn WriteDeadlineSetter) (*snapcache.Breadcrumb, error)
 }
@@ -712,6 +923,9 @@ func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 		// Wait for the background threads to shut down.
 		h.shutDownWG.Wait()
 		gaugeNumConnections.Dec()
+		if stats := h.compressionStats.Load(); stats != nil && stats.Ratio() > 0 {
+			h.summaryCompressionRatio.Observe(stats.Ratio())
+		}
 		h.logCxt.Info("Client connection shut down.")
 		finishedWG.Done()
 	}()
@@ -729,11 +943,18 @@ func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 	}
 	h.gaugeNumConnectionsStreaming.Inc()
 	defer h.gaugeNumConnectionsStreaming.Dec()
+	if h.nodeFilter != nil {
+		h.nodeFilter.gaugeConnections.Inc()
+		defer h.nodeFilter.gaugeConnections.Dec()
+	}
 
 	// Figure out if we should restart the decoder with new settings.
 	var binSnapCache snapshotCache
 	if h.clientSupportsDecoderRestart {
-		binSnapCache = h.allSnapshotters[h.chosenCompression][h.syncerType]
+		if h.resumeFrom == nil && h.nodeFilter == nil {
+			// The binary snapshot is shared by all clients so it can't be used for a filtered client.
+			binSnapCache = h.allSnapshotters[h.chosenCompression][h.syncerType]
+		}
 		var reasonsToRestart []string
 		if h.chosenCompression != "" {
 			reasonsToRestart = append(reasonsToRestart, fmt.Sprintf("enable compression: %v", h.chosenCompression))
@@ -753,7 +974,12 @@ func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 	}
 
 	var breadcrumb *snapcache.Breadcrumb
-	if binSnapCache != nil {
+	if h.resumeFrom != nil {
+		// Client already has the state up to this breadcrumb, skip the snapshot and just send the deltas.
+		h.logCxt.WithField("seqNo", h.resumeFrom.SequenceNumber).Info("Resuming client session, skipping snapshot.")
+		breadcrumb = h.resumeFrom
+		h.counterConnectionsResumed.Inc()
+	} else if binSnapCache != nil {
 		// We have a binary snapshot cache that supports this compression mode; send the compressed
 		// binary snapshot instead of a streamed snapshot.
 		snapStart := time.Now()
@@ -783,6 +1009,8 @@ func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 		}
 	}
 
+	h.sentBreadcrumb.Store(breadcrumb)
+
 	// Start a goroutine to stream deltas to the client.
 	h.shutDownWG.Add(1)
 	go h.sendDeltaUpdatesToClient(h.logCxt.WithField("thread", "kv-sender"), breadcrumb)
@@ -921,10 +1149,11 @@ func (h *connection) doHandshake() error {
 	}
 	h.cache = desiredSyncerCache
 
+	// The client lists its algorithms in order of preference, pick the first one that we support.
 	for _, alg := range hello.SupportedCompressionAlgorithms {
-		switch alg {
-		case syncproto.CompressionSnappy:
-			h.chosenCompression = syncproto.CompressionSnappy
+		if alg == syncproto.CompressionSnappy || (alg == syncproto.CompressionZstd && !h.config.DisableZstd) {
+			h.chosenCompression = alg
+			break
 		}
 	}
 	h.clientSupportsDecoderRestart = hello.SupportsDecoderRestart
@@ -933,6 +1162,23 @@ func (h *connection) doHandshake() error {
 		h.chosenCompression = ""
 	}
 
+	if resumableCache, ok := desiredSyncerCache.(ResumableBreadcrumbProvider); ok && hello.SupportsResume {
+		h.clientSupportsResume = true
+		h.cacheID = resumableCache.ID()
+		h.resumeFrom = h.findResumeBreadcrumb(resumableCache, hello)
+	}
+
+	if hello.NodeName != "" {
+		if f := h.allNodeFilters[syncerType]; f != nil {
+			h.logCxt.WithField("nodeName", hello.NodeName).Info("Client asked for node filtering.")
+			h.nodeFilter = f
+			h.nodeName = hello.NodeName
+		} else {
+			h.logCxt.Info("Client asked for node filtering but syncer type has no filtering policy; " +
+				"sending all KVs.")
+		}
+	}
+
 	// Respond to client's hello.
 	err = h.sendMsg(syncproto.MsgServerHello{
 		Version: buildinfo.GitVersion,
@@ -941,24 +1187,65 @@ func (h *connection) doHandshake() error {
 		SyncerType:                  syncerType,
 		SupportsNodeResourceUpdates: true,
 		ServerConnID:                h.ID,
+		SupportsResume:              h.clientSupportsResume,
+		Resumed:                     h.resumeFrom != nil,
+		FilteringByNode:             h.nodeFilter != nil,
 	})
 	if err != nil {
 		log.WithError(err).Warning("Failed to send hello to client")
 		return err
 	}
+	h.clientDetails.Store(&clientDetails{
+		hostname:    hello.Hostname,
+		version:     hello.Version,
+		info:        hello.Info,
+		syncerType:  syncerType,
+		compression: h.chosenCompression,
+		nodeName:    h.nodeName,
+	})
 	return nil
 }
 
+// findResumeBreadcrumb returns the Breadcrumb that the client asked to resume from in its hello or nil if
+// the client didn't ask to resume or the Breadcrumb is no longer available.
+func (h *connection) findResumeBreadcrumb(cache ResumableBreadcrumbProvider, hello syncproto.MsgClientHello) *snapcache.Breadcrumb {
+	if hello.ResumeCacheID == "" {
+		return nil
+	}
+	logCxt := h.logCxt.WithFields(log.Fields{
+		"clientCacheID": hello.ResumeCacheID,
+		"clientSeqNo":   hello.ResumeSequenceNumber,
+	})
+	if hello.ResumeCacheID != h.cacheID {
+		logCxt.WithField("cacheID", h.cacheID).Info(
+			"Client asked to resume from a different cache (probably a different Typha); sending snapshot.")
+		return nil
+	}
+	breadcrumb := cache.BreadcrumbBySequenceNumber(hello.ResumeSequenceNumber)
+	if breadcrumb == nil {
+		logCxt.Info("Client asked to resume from a breadcrumb that is no longer in the history; sending snapshot.")
+		return nil
+	}
+	return breadcrumb
+}
+
 func (h *connection) restartEncodingIfSupported(message string) error {
 	if !h.clientSupportsDecoderRestart {
 		log.Debug("Can't restart decoder, client doesn't support it.")
 		return nil
 	}
 
+	if h.chosenCompression == syncproto.CompressionZstd {
+		if d := h.allZstdDictionaries[h.syncerType]; d != nil {
+			h.zstdDictionary = d.Get(h.cache)
+		}
+	}
+
 	// Signal for the client to restart its decoder (possibly) with compression enabled.
 	err := h.sendMsg(syncproto.MsgDecoderRestart{
-		Message:              message,
-		CompressionAlgorithm: h.chosenCompression,
+		Message:               message,
+		CompressionAlgorithm:  h.chosenCompression,
+		CompressionDictionary: h.zstdDictionary,
 	})
 	if err != nil {
 		log.WithError(err).Warning("Failed to send DecoderRestart to client")
@@ -988,21 +1275,18 @@ func (h *connection) waitForAckAndRestartEncoder() error {
 
 	// Upgrade to compressed connection if required.
 	bw := bufio.NewWriter(h.connW)
-	switch h.chosenCompression {
-	case syncproto.CompressionSnappy:
-		w := snappy.NewBufferedWriter(bw)
-		h.encoder = gob.NewEncoder(w) // Need a new Encoder, there's no way to change out the Writer.
-		h.flushWriter = func() error {
-			err := w.Flush()
-			if err != nil {
-				return err
-			}
-			return bw.Flush()
-		}
-	default:
-		h.encoder = gob.NewEncoder(bw) // Need a new Encoder, there's no way to change out the Writer.
-		h.flushWriter = bw.Flush
+	stats := h.compressionStats.Load()
+	if h.chosenCompression != "" && stats == nil {
+		stats = newCompressionStats(h.syncerType, h.chosenCompression)
+		h.compressionStats.Store(stats)
+	}
+	w, flush, err := newCompressingWriter(h.chosenCompression, bw, h.config.ZstdLevel, h.zstdDictionary, stats)
+	if err != nil {
+		h.logCxt.WithError(err).Error("Failed to create compressor.")
+		return err
 	}
+	h.encoder = gob.NewEncoder(w) // Need a new Encoder, there's no way to change out the Writer.
+	h.flushWriter = flush
 	return nil
 }
 
@@ -1090,6 +1374,30 @@ func (h *connection) sendDeltaUpdatesToClient(logCxt *log.Entry, breadcrumb *sna
 		return
 	}
 
+	// For clients that support resume, track the position that we've sent so that the client can resume
+	// from there if it reconnects.
+	lastSentSeqNo := uint64(math.MaxUint64)
+	maybeSendPosition := func() (err error) {
+		if !h.clientSupportsResume || lastSentSeqNo == breadcrumb.SequenceNumber {
+			return
+		}
+		err = h.sendMsg(syncproto.MsgSyncPosition{
+			CacheID:        h.cacheID,
+			SequenceNumber: breadcrumb.SequenceNumber,
+		})
+		if err != nil {
+			logCxt.WithError(err).Info("Failed to send position to client")
+			return
+		}
+		lastSentSeqNo = breadcrumb.SequenceNumber
+		return
+	}
+
+	// Send the position before the status so that a client that is reconciling its state after a
+	// snapshot can do so before it's told that it's in sync.
+	if err := maybeSendPosition(); err != nil {
+		return
+	}
 	// The first Breadcrumb may have changed the status.  Send an update if so.
 	if err := maybeSendStatus(); err != nil {
 		return
@@ -1110,6 +1418,7 @@ func (h *connection) sendDeltaUpdatesToClient(logCxt *log.Entry, breadcrumb *sna
 				return
 			}
 			timeSpentInNext := time.Since(nextStartTime)
+			h.sentBreadcrumb.Store(breadcrumb)
 
 			// Take a peek at the very latest breadcrumb to see how far behind we are...
 			latestCrumb := h.cache.CurrentBreadcrumb()
@@ -1181,6 +1490,7 @@ func (h *connection) sendDeltaUpdatesToClient(logCxt *log.Entry, breadcrumb *sna
 			}
 		}
 
+		deltas = h.filterDeltas(deltas)
 		if len(deltas) > 0 {
 			// Send the deltas relative to the previous snapshot.
 			logCxt.WithField("num", len(deltas)).Debug("Sending deltas")
@@ -1194,6 +1504,9 @@ func (h *connection) sendDeltaUpdatesToClient(logCxt *log.Entry, breadcrumb *sna
 			}
 		}
 
+		if err := maybeSendPosition(); err != nil {
+			return
+		}
 		// Newest breadcrumb may have updated the sync status, send an update if so.
 		if err := maybeSendStatus(); err != nil {
 			return
@@ -1208,6 +1521,7 @@ func (h *connection) streamSnapshotToClient(logCxt *log.Entry, breadcrumb *snapc
 		h.cxt,
 		h.logCxt.WithField("destination", "direct to client"),
 		breadcrumb,
+		h.filterKV,
 		h.sendMsg,
 		h.config.MaxMessageSize,
 	)
@@ -1219,11 +1533,37 @@ func (h *connection) streamSnapshotToClient(logCxt *log.Entry, breadcrumb *snapc
 	return nil
 }
 
+// filterKV applies the connection's node filter (if any) to a single KV.  It returns the KV to send and
+// whether it should be sent at all.
+func (h *connection) filterKV(upd syncproto.SerializedUpdate) (syncproto.SerializedUpdate, bool) {
+	if h.nodeFilter == nil {
+		return upd, true
+	}
+	return h.nodeFilter.Filter(h.nodeName, upd)
+}
+
+// filterDeltas applies the connection's node filter (if any) to a batch of deltas.  The input slice may be
+// shared with other connections so it is never modified.
+func (h *connection) filterDeltas(deltas []syncproto.SerializedUpdate) []syncproto.SerializedUpdate {
+	if h.nodeFilter == nil {
+		return deltas
+	}
+	filtered := make([]syncproto.SerializedUpdate, 0, len(deltas))
+	for _, upd := range deltas {
+		if upd, keep := h.nodeFilter.Filter(h.nodeName, upd); keep {
+			filtered = append(filtered, upd)
+		}
+	}
+	return filtered
+}
+
 // writeSnapshotMessages chunks the given breadcrumb up into syncproto.MsgKVs objects and calls writeMsg for each one.
+// If filter is non-nil, it is applied to each KV first.
 func writeSnapshotMessages(
 	ctx context.Context,
 	logCxt *log.Entry,
 	breadcrumb *snapcache.Breadcrumb,
+	filter func(syncproto.SerializedUpdate) (syncproto.SerializedUpdate, bool),
 	writeMsg func(any) error,
 	maxMsgSize int,
 ) (err error) {
@@ -1257,6 +1597,12 @@ func writeSnapshotMessages(
 			err = ctx.Err()
 			return false
 		}
+		if filter != nil {
+			var keep bool
+			if entry, keep = filter(entry); !keep {
+				return true
+			}
+		}
 		kvs = append(kvs, entry)
 		if len(kvs) >= maxMsgSize {
 			// Buffer is full, send the next batch.
@@ -1313,12 +1659,14 @@ func (h *connection) sendPingsToClient(logCxt *log.Entry) {
 // set per syncer type.
 type perSyncerConnMetrics struct {
 	counterGracePeriodUsed       prometheus.Counter
+	counterConnectionsResumed    prometheus.Counter
 	summarySnapshotSendTime      prometheus.Summary
 	summaryClientLatency         prometheus.Summary
 	summaryWriteLatency          prometheus.Summary
 	summaryNextCatchupLatency    prometheus.Summary
 	summaryPingLatency           prometheus.Summary
 	summaryNumKVsPerMsg          prometheus.Summary
+	summaryCompressionRatio      prometheus.Summary
 	gaugeNumConnectionsStreaming prometheus.Gauge
 }
 
@@ -1360,7 +1708,13 @@ func makePerSyncerConnMetrics(syncerType syncproto.SyncerType) perSyncerConnMetr
 		Help:        "Number of KV pairs sent in each message.",
 		ConstLabels: syncerLabels,
 	}))
+	c.summaryCompressionRatio = promutils.GetOrRegister(cprometheus.NewSummary(prometheus.SummaryOpts{
+		Name:        "typha_client_compression_ratio",
+		Help:        "Ratio of uncompressed to compressed bytes sent to each client, recorded when its connection closes.",
+		ConstLabels: syncerLabels,
+	}))
 	c.counterGracePeriodUsed = counterVecGracePeriodUsed.WithLabelValues(string(syncerType))
+	c.counterConnectionsResumed = counterVecConnectionsResumed.WithLabelValues(string(syncerType))
 	c.gaugeNumConnectionsStreaming = gaugeVecNumConnectionsStreaming.WithLabelValues(string(syncerType))
 	return c
 }